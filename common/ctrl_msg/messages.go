@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"github.com/openziti/channel/v2"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -53,9 +54,10 @@ const (
 	CreateCircuitReqTerminatorInstanceIdHeader = 13
 	CreateCircuitReqApiSessionTokenHeader      = 14
 
-	CreateCircuitRespCircuitId  = 11
-	CreateCircuitRespAddress    = 12
-	CreateCircuitRespTagsHeader = 13
+	CreateCircuitRespCircuitId        = 11
+	CreateCircuitRespAddress          = 12
+	CreateCircuitRespTagsHeader       = 13
+	CreateCircuitRespRateLimitsHeader = 14
 
 	HeaderResultErrorCode = 10
 
@@ -154,19 +156,27 @@ func DecodeCreateCircuitRequest(m *channel.Message) (*CreateCircuitRequest, erro
 }
 
 type CreateCircuitResponse struct {
-	CircuitId string
-	Address   string
-	PeerData  map[uint32][]byte
-	Tags      map[string]string
+	CircuitId  string
+	Address    string
+	PeerData   map[uint32][]byte
+	Tags       map[string]string
+	RateLimits *edge_ctrl_pb.CircuitRateLimits
 }
 
-func (self *CreateCircuitResponse) ToMessage() *channel.Message {
+func (self *CreateCircuitResponse) ToMessage() (*channel.Message, error) {
 	msg := channel.NewMessage(int32(edge_ctrl_pb.ContentType_CreateCircuitV2ResponseType), nil)
 	msg.PutStringHeader(CreateCircuitRespCircuitId, self.CircuitId)
 	msg.PutStringHeader(CreateCircuitRespAddress, self.Address)
 	msg.PutU32ToBytesMapHeader(CreateCircuitPeerDataHeader, self.PeerData)
 	msg.PutStringToStringMapHeader(CreateCircuitRespTagsHeader, self.Tags)
-	return msg
+	if self.RateLimits != nil {
+		rateLimits, err := proto.Marshal(self.RateLimits)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal create circuit response rate limits (%w)", err)
+		}
+		msg.Headers[CreateCircuitRespRateLimitsHeader] = rateLimits
+	}
+	return msg, nil
 }
 
 func DecodeCreateCircuitResponse(m *channel.Message) (*CreateCircuitResponse, error) {
@@ -182,10 +192,19 @@ func DecodeCreateCircuitResponse(m *channel.Message) (*CreateCircuitResponse, er
 		return nil, fmt.Errorf("unable to get create circuit response tags (%w)", err)
 	}
 
+	var rateLimits *edge_ctrl_pb.CircuitRateLimits
+	if val, found := m.Headers[CreateCircuitRespRateLimitsHeader]; found {
+		rateLimits = &edge_ctrl_pb.CircuitRateLimits{}
+		if err = proto.Unmarshal(val, rateLimits); err != nil {
+			return nil, fmt.Errorf("unable to get create circuit response rate limits (%w)", err)
+		}
+	}
+
 	return &CreateCircuitResponse{
-		CircuitId: circuitId,
-		Address:   address,
-		PeerData:  peerData,
-		Tags:      tags,
+		CircuitId:  circuitId,
+		Address:    address,
+		PeerData:   peerData,
+		Tags:       tags,
+		RateLimits: rateLimits,
 	}, nil
 }
@@ -46,6 +46,8 @@ type XgressDetail struct {
 }
 
 type XgressSendBufferDetail struct {
+	CongestionControl     string  `json:"congestionControl"`
+	CongestionState       string  `json:"congestionState,omitempty"`
 	WindowSize            uint32  `json:"windowSize"`
 	LinkSendBufferSize    uint32  `json:"linkSendBufferSize"`
 	LinkRecvBufferSize    uint32  `json:"linkRecvBufferSize"`
@@ -56,8 +58,18 @@ type XgressSendBufferDetail struct {
 	Closed                bool    `json:"closed"`
 	BlockedByLocalWindow  bool    `json:"blockedByLocalWindow"`
 	BlockedByRemoteWindow bool    `json:"blockedByRemoteWindow"`
+	BlockedByPacing       bool    `json:"blockedByPacing"`
+	SelectiveAcks         bool    `json:"selectiveAcks"`
+	CumulativeAck         int32   `json:"cumulativeAck"`
+	SackDupAcks           uint32  `json:"sackDupAcks"`
+	FastRetransmits       uint32  `json:"fastRetransmits"`
 	RetxScale             float64 `json:"retxScale"`
 	RetxThreshold         uint32  `json:"retxThreshold"`
+	Rtt                   string  `json:"rtt"`
+	RttVariance           string  `json:"rttVariance"`
+	MinRtt                string  `json:"minRtt,omitempty"`
+	BandwidthEstimate     uint64  `json:"bandwidthEstimate"`
+	PacingRate            uint64  `json:"pacingRate"`
 	TimeSinceLastRetx     string  `json:"timeSinceLastRetx"`
 	CloseWhenEmpty        bool    `json:"closeWhenEmpty"`
 	AcquiredSafely        bool    `json:"acquiredSafely"`
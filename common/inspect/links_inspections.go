@@ -36,6 +36,8 @@ type LinkInspectDetail struct {
 	Dest        string `json:"dest"`
 	DestVersion string `json:"destVersion"`
 	Dialed      bool   `json:"dialed"`
+	// QueueDepths is the number of payloads waiting to be written to the link, by traffic class
+	QueueDepths map[string]int64 `json:"queueDepths,omitempty"`
 }
 
 type LinkDest struct {
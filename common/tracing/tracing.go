@@ -0,0 +1,252 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+// Package tracing provides optional OpenTelemetry span export for the controller and routers. Until Start
+// is called with an exporter configured, the global no-op tracer provider is used, spans cost next to
+// nothing and no trace context is propagated.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterOtlp = "otlp"
+	ExporterFile = "file"
+
+	TracerName = "github.com/openziti/ziti"
+
+	DefaultSampleRatio = 1.0
+	shutdownTimeout    = 5 * time.Second
+)
+
+// Config describes where spans are exported to. It is loaded from the `tracing` section of the controller
+// and router configuration files:
+//
+//	tracing:
+//	  exporter: otlp            # otlp or file
+//	  endpoint: localhost:4318  # otlp http endpoint
+//	  insecure: true
+//	  headers:
+//	    x-api-key: secret
+//	  path: /tmp/spans.json     # file exporter output
+//	  sampleRatio: 0.1
+type Config struct {
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	Headers     map[string]string
+	Path        string
+	SampleRatio float64
+}
+
+func LoadConfig(src map[interface{}]interface{}) (*Config, error) {
+	result := &Config{
+		SampleRatio: DefaultSampleRatio,
+	}
+
+	if value, found := src["exporter"]; found {
+		result.Exporter = fmt.Sprintf("%v", value)
+	}
+
+	if value, found := src["endpoint"]; found {
+		result.Endpoint = fmt.Sprintf("%v", value)
+	}
+
+	if value, found := src["insecure"]; found {
+		insecure, ok := value.(bool)
+		if !ok {
+			return nil, errors.Errorf("invalid tracing.insecure value '%v', must be a boolean", value)
+		}
+		result.Insecure = insecure
+	}
+
+	if value, found := src["headers"]; found {
+		headers, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid tracing.headers value '%v', must be a map", value)
+		}
+		result.Headers = map[string]string{}
+		for k, v := range headers {
+			result.Headers[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	if value, found := src["path"]; found {
+		result.Path = fmt.Sprintf("%v", value)
+	}
+
+	if value, found := src["sampleRatio"]; found {
+		switch v := value.(type) {
+		case int:
+			result.SampleRatio = float64(v)
+		case float64:
+			result.SampleRatio = v
+		default:
+			return nil, errors.Errorf("invalid tracing.sampleRatio value '%v', must be a number", value)
+		}
+		if result.SampleRatio < 0 || result.SampleRatio > 1 {
+			return nil, errors.Errorf("invalid tracing.sampleRatio value '%v', must be between 0 and 1", value)
+		}
+	}
+
+	switch result.Exporter {
+	case ExporterOtlp:
+		if result.Endpoint == "" {
+			return nil, errors.New("tracing.endpoint is required for the otlp exporter")
+		}
+	case ExporterFile:
+		if result.Path == "" {
+			return nil, errors.New("tracing.path is required for the file exporter")
+		}
+	default:
+		return nil, errors.Errorf("invalid tracing.exporter '%v', must be one of ['%v', '%v']", result.Exporter, ExporterOtlp, ExporterFile)
+	}
+
+	return result, nil
+}
+
+// Start installs a global tracer provider which exports spans as configured. The provider is flushed and
+// shut down when closeNotify is closed.
+func Start(config *Config, serviceName string, instanceId string, closeNotify <-chan struct{}) error {
+	var exporter sdktrace.SpanExporter
+	var closer func()
+
+	switch config.Exporter {
+	case ExporterOtlp:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(config.Endpoint)}
+		if config.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(config.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(config.Headers))
+		}
+		var err error
+		if exporter, err = otlptracehttp.New(context.Background(), opts...); err != nil {
+			return errors.Wrap(err, "unable to create otlp span exporter")
+		}
+	case ExporterFile:
+		if err := os.MkdirAll(filepath.Dir(config.Path), 0700); err != nil {
+			return errors.Wrapf(err, "unable to create directory for span file '%v'", config.Path)
+		}
+		f, err := os.OpenFile(config.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return errors.Wrapf(err, "unable to open span file '%v'", config.Path)
+		}
+		if exporter, err = stdouttrace.New(stdouttrace.WithWriter(f)); err != nil {
+			_ = f.Close()
+			return errors.Wrap(err, "unable to create file span exporter")
+		}
+		closer = func() { _ = f.Close() }
+	default:
+		return errors.Errorf("invalid tracing exporter '%v'", config.Exporter)
+	}
+
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceInstanceID(instanceId),
+	)
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	pfxlog.Logger().WithField("exporter", config.Exporter).Info("opentelemetry span export enabled")
+
+	go func() {
+		<-closeNotify
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			pfxlog.Logger().WithError(err).Error("error shutting down opentelemetry tracer provider")
+		}
+		if closer != nil {
+			closer()
+		}
+	}()
+
+	return nil
+}
+
+// Tracer returns the tracer used for all ziti spans
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// Inject returns the trace context of the span in ctx, suitable for carrying in control messages. If the
+// span isn't being recorded, nil is returned
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// Extract returns a context carrying the remote span described by traceContext, which may be empty
+func Extract(traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return context.Background()
+	}
+	return otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(traceContext))
+}
+
+// EndSpan records err on the span, if it's not nil, and ends the span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Circuit attribute keys
+var (
+	CircuitIdKey    = attribute.Key("ziti.circuit.id")
+	ServiceIdKey    = attribute.Key("ziti.service.id")
+	TerminatorIdKey = attribute.Key("ziti.terminator.id")
+	RouterIdKey     = attribute.Key("ziti.router.id")
+	AttemptKey      = attribute.Key("ziti.circuit.attempt")
+	StrategyKey     = attribute.Key("ziti.terminator.strategy")
+	PathKey         = attribute.Key("ziti.circuit.path")
+	BindingKey      = attribute.Key("ziti.xgress.binding")
+)
@@ -0,0 +1,90 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLoadConfig(t *testing.T) {
+	req := require.New(t)
+
+	cfg, err := LoadConfig(map[interface{}]interface{}{
+		"exporter":    "otlp",
+		"endpoint":    "localhost:4318",
+		"insecure":    true,
+		"headers":     map[interface{}]interface{}{"x-api-key": "secret"},
+		"sampleRatio": 0.25,
+	})
+	req.NoError(err)
+	req.Equal(ExporterOtlp, cfg.Exporter)
+	req.Equal("localhost:4318", cfg.Endpoint)
+	req.True(cfg.Insecure)
+	req.Equal(map[string]string{"x-api-key": "secret"}, cfg.Headers)
+	req.Equal(0.25, cfg.SampleRatio)
+
+	_, err = LoadConfig(map[interface{}]interface{}{"exporter": "otlp"})
+	req.Error(err)
+
+	_, err = LoadConfig(map[interface{}]interface{}{"exporter": "file"})
+	req.Error(err)
+
+	_, err = LoadConfig(map[interface{}]interface{}{"exporter": "file", "path": "/tmp/spans.json", "sampleRatio": 2})
+	req.Error(err)
+
+	_, err = LoadConfig(map[interface{}]interface{}{"exporter": "zipkin"})
+	req.Error(err)
+}
+
+func TestFileExportAndPropagation(t *testing.T) {
+	req := require.New(t)
+
+	defaultProvider := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(defaultProvider)
+
+	req.Nil(Inject(context.Background()))
+
+	path := filepath.Join(t.TempDir(), "spans.json")
+	closeNotify := make(chan struct{})
+	req.NoError(Start(&Config{Exporter: ExporterFile, Path: path, SampleRatio: 1}, "test", "test1", closeNotify))
+
+	ctx, parent := Tracer().Start(context.Background(), "circuit.create")
+	traceContext := Inject(ctx)
+	req.NotEmpty(traceContext["traceparent"])
+
+	_, child := Tracer().Start(Extract(traceContext), "router.route")
+	req.Equal(parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+	req.True(trace.SpanContextFromContext(Extract(traceContext)).IsRemote())
+	child.End()
+	parent.End()
+
+	close(closeNotify)
+
+	req.Eventually(func() bool {
+		data, err := os.ReadFile(path)
+		return err == nil && strings.Contains(string(data), "router.route") && strings.Contains(string(data), "circuit.create")
+	}, 5*time.Second, 50*time.Millisecond)
+}
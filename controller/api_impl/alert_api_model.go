@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+const (
+	EntityNameAlert     = "alerts"
+	EntityNameAlertRule = "alert-rules"
+)
+
+func MapAlertsToRestModel(alerts []*network.Alert) rest_model.AlertList {
+	result := rest_model.AlertList{}
+	for _, alert := range alerts {
+		result = append(result, MapAlertToRestModel(alert))
+	}
+	return result
+}
+
+func MapAlertToRestModel(alert *network.Alert) *rest_model.AlertDetail {
+	firedAt := strfmt.DateTime(alert.FiredAt)
+	return &rest_model.AlertDetail{
+		RuleID:     &alert.RuleId,
+		RuleType:   &alert.RuleType,
+		EntityType: &alert.EntityType,
+		EntityID:   &alert.EntityId,
+		Value:      &alert.Value,
+		Threshold:  &alert.Threshold,
+		FiredAt:    &firedAt,
+		Message:    &alert.Message,
+	}
+}
+
+func MapAlertRulesToRestModel(rules []*network.AlertRule) rest_model.AlertRuleList {
+	result := rest_model.AlertRuleList{}
+	for _, rule := range rules {
+		result = append(result, MapAlertRuleToRestModel(rule))
+	}
+	return result
+}
+
+func MapAlertRuleToRestModel(rule *network.AlertRule) *rest_model.AlertRuleDetail {
+	forDuration := rule.For.String()
+	window := rule.Window.String()
+	minCircuits := int64(rule.MinCircuits)
+
+	return &rest_model.AlertRuleDetail{
+		ID:          &rule.Id,
+		Type:        &rule.Type,
+		For:         &forDuration,
+		Threshold:   &rule.Threshold,
+		Window:      &window,
+		MinCircuits: &minCircuits,
+		Source:      &rule.Source,
+	}
+}
+
+func MapCreateAlertRuleToModel(rule *rest_model.AlertRuleCreate) (*network.AlertRule, error) {
+	result := &network.AlertRule{
+		BaseEntity:  models.BaseEntity{Id: rule.ID},
+		Type:        stringz.OrEmpty(rule.Type),
+		Threshold:   rule.Threshold,
+		MinCircuits: int(rule.MinCircuits),
+	}
+
+	if rule.For != "" {
+		d, err := time.ParseDuration(rule.For)
+		if err != nil {
+			return nil, errorz.NewFieldError("for must be a duration, such as 5m", "for", rule.For)
+		}
+		result.For = d
+	}
+
+	if rule.Window != "" {
+		d, err := time.ParseDuration(rule.Window)
+		if err != nil {
+			return nil, errorz.NewFieldError("window must be a duration, such as 5m", "window", rule.Window)
+		}
+		result.Window = d
+	}
+
+	return result, nil
+}
@@ -0,0 +1,94 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/alert"
+)
+
+func init() {
+	r := NewAlertRouter()
+	AddRouter(r)
+}
+
+type AlertRouter struct {
+	BasePath string
+}
+
+func NewAlertRouter() *AlertRouter {
+	return &AlertRouter{
+		BasePath: "/" + EntityNameAlert,
+	}
+}
+
+func (r *AlertRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.AlertListAlertsHandler = alert.ListAlertsHandlerFunc(func(params alert.ListAlertsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListAlerts, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.AlertListAlertRulesHandler = alert.ListAlertRulesHandlerFunc(func(params alert.ListAlertRulesParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListAlertRules, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.AlertCreateAlertRuleHandler = alert.CreateAlertRuleHandlerFunc(func(params alert.CreateAlertRuleParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.CreateAlertRule(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.AlertDeleteAlertRuleHandler = alert.DeleteAlertRuleHandlerFunc(func(params alert.DeleteAlertRuleParams) middleware.Responder {
+		return wrapper.WrapRequest(r.DeleteAlertRule, params.HTTPRequest, params.ID, "")
+	})
+}
+
+func (r *AlertRouter) ListAlerts(n *network.Network, rc api.RequestContext) {
+	rc.Respond(&rest_model.ListAlertsEnvelope{
+		Data: MapAlertsToRestModel(n.Managers.Alerts.ListAlerts()),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *AlertRouter) ListAlertRules(n *network.Network, rc api.RequestContext) {
+	rc.Respond(&rest_model.ListAlertRulesEnvelope{
+		Data: MapAlertRulesToRestModel(n.Managers.Alerts.ListRules()),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *AlertRouter) CreateAlertRule(n *network.Network, rc api.RequestContext, params alert.CreateAlertRuleParams) {
+	Create(rc, NewBasicLinkFactory(EntityNameAlertRule), func() (string, error) {
+		rule, err := MapCreateAlertRuleToModel(params.AlertRule)
+		if err != nil {
+			return "", err
+		}
+		if err = n.Managers.Alerts.CreateRule(rule, rc.NewChangeContext()); err != nil {
+			return "", err
+		}
+		return rule.Id, nil
+	})
+}
+
+func (r *AlertRouter) DeleteAlertRule(n *network.Network, rc api.RequestContext) {
+	Delete(rc, func(rc api.RequestContext, id string) error {
+		return n.Managers.Alerts.DeleteRule(id, rc.NewChangeContext())
+	})
+}
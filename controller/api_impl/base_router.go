@@ -252,6 +252,8 @@ func Delete(rc api.RequestContext, deleteF ModelDeleteF) {
 	if err != nil {
 		if boltz.IsErrNotFoundErr(err) {
 			rc.RespondWithNotFoundWithCause(err)
+		} else if fe, ok := err.(*errorz.FieldError); ok {
+			rc.RespondWithFieldError(fe)
 		} else {
 			rc.RespondWithError(err)
 		}
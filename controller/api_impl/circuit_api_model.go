@@ -17,6 +17,7 @@
 package api_impl
 
 import (
+	"github.com/go-openapi/swag"
 	"github.com/openziti/ziti/controller/api"
 	"github.com/openziti/ziti/controller/network"
 
@@ -71,6 +72,57 @@ func MapCircuitToRestModel(n *network.Network, _ api.RequestContext, circuit *ne
 	return ret, nil
 }
 
+func MapCircuitDryRunToRestModel(result *network.DryRunCircuitResult) *rest_model.CircuitDryRunDetail {
+	ret := &rest_model.CircuitDryRunDetail{
+		ServiceID:   &result.ServiceId,
+		ServiceName: &result.ServiceName,
+		Strategy:    &result.Strategy,
+		IdentityID:  result.IdentityId,
+		Error:       result.Error,
+		Ingresses:   []*rest_model.CircuitDryRunIngress{},
+	}
+
+	for _, ingress := range result.Ingresses {
+		restIngress := &rest_model.CircuitDryRunIngress{
+			RouterID:     swag.String(ingress.RouterId),
+			TerminatorID: ingress.TerminatorId,
+			PathCost:     ingress.PathCost,
+			Error:        ingress.Error,
+			Terminators:  []*rest_model.CircuitDryRunTerminator{},
+		}
+
+		for _, hop := range ingress.Path {
+			restIngress.Path = append(restIngress.Path, &rest_model.CircuitDryRunHop{
+				RouterID:   swag.String(hop.RouterId),
+				RouterCost: swag.Int64(hop.RouterCost),
+				LinkID:     hop.LinkId,
+				LinkCost:   hop.LinkCost,
+			})
+		}
+
+		for _, t := range ingress.Terminators {
+			restIngress.Terminators = append(restIngress.Terminators, &rest_model.CircuitDryRunTerminator{
+				ID:          swag.String(t.Id),
+				RouterID:    swag.String(t.RouterId),
+				Binding:     swag.String(t.Binding),
+				Address:     swag.String(t.Address),
+				InstanceID:  t.InstanceId,
+				Precedence:  swag.String(t.Precedence),
+				StaticCost:  swag.Int64(int64(t.StaticCost)),
+				DynamicCost: swag.Int64(int64(t.DynamicCost)),
+				PathCost:    int64(t.PathCost),
+				RouteCost:   int64(t.RouteCost),
+				Selected:    swag.Bool(t.Selected),
+				Rejected:    t.Rejected,
+			})
+		}
+
+		ret.Ingresses = append(ret.Ingresses, restIngress)
+	}
+
+	return ret
+}
+
 type deletedEntity string
 
 func (self deletedEntity) GetId() string {
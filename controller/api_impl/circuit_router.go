@@ -17,7 +17,11 @@
 package api_impl
 
 import (
+	"net/http"
+
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/controller/api"
 	"github.com/openziti/ziti/controller/change"
@@ -54,6 +58,10 @@ func (r *CircuitRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper Re
 	fabricApi.CircuitDeleteCircuitHandler = circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
 		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Delete(n, rc, params) }, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.CircuitDryRunCircuitHandler = circuit.DryRunCircuitHandlerFunc(func(params circuit.DryRunCircuitParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.DryRun(n, rc, params.Request) }, params.HTTPRequest, "", "")
+	})
 }
 
 func (r *CircuitRouter) ListCircuits(n *network.Network, rc api.RequestContext) {
@@ -110,3 +118,30 @@ func (r *CircuitRouter) Delete(network *network.Network, rc api.RequestContext,
 		return network.RemoveCircuit(id, p.Options.Immediate)
 	}))
 }
+
+func (r *CircuitRouter) DryRun(n *network.Network, rc api.RequestContext, request *rest_model.CircuitDryRunRequest) {
+	result, err := n.DryRunCircuit(&network.DryRunCircuitParams{
+		ServiceId:  stringz.OrEmpty(request.Service),
+		IdentityId: request.IdentityID,
+		RouterId:   request.RouterID,
+		InstanceId: request.InstanceID,
+	})
+
+	if fe, ok := err.(*errorz.FieldError); ok {
+		rc.RespondWithFieldError(fe)
+		return
+	}
+	if boltz.IsErrNotFoundErr(err) {
+		rc.RespondWithNotFoundWithCause(err)
+		return
+	}
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.CircuitDryRunEnvelope{
+		Data: MapCircuitDryRunToRestModel(result),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
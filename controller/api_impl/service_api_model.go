@@ -54,6 +54,9 @@ func MapCreateServiceToModel(service *rest_model.ServiceCreate) *network.Service
 		},
 		Name:               stringz.OrEmpty(service.Name),
 		TerminatorStrategy: service.TerminatorStrategy,
+		AvoidRouters:       service.AvoidRouters,
+		EgressRouters:      service.EgressRouters,
+		MaxHops:            int32(Int64OrDefault(service.MaxHops)),
 	}
 
 	if ret.Id == "" {
@@ -75,6 +78,9 @@ func MapUpdateServiceToModel(id string, service *rest_model.ServiceUpdate) *netw
 		},
 		Name:               stringz.OrEmpty(service.Name),
 		TerminatorStrategy: service.TerminatorStrategy,
+		AvoidRouters:       service.AvoidRouters,
+		EgressRouters:      service.EgressRouters,
+		MaxHops:            int32(Int64OrDefault(service.MaxHops)),
 	}
 
 	return ret
@@ -88,6 +94,9 @@ func MapPatchServiceToModel(id string, service *rest_model.ServicePatch) *networ
 		},
 		Name:               service.Name,
 		TerminatorStrategy: service.TerminatorStrategy,
+		AvoidRouters:       service.AvoidRouters,
+		EgressRouters:      service.EgressRouters,
+		MaxHops:            int32(Int64OrDefault(service.MaxHops)),
 	}
 
 	return ret
@@ -100,5 +109,8 @@ func (ServiceModelMapper) ToApi(_ *network.Network, _ api.RequestContext, servic
 		BaseEntity:         BaseEntityToRestModel(service, ServiceLinkFactory),
 		Name:               &service.Name,
 		TerminatorStrategy: &service.TerminatorStrategy,
+		AvoidRouters:       service.AvoidRouters,
+		EgressRouters:      service.EgressRouters,
+		MaxHops:            int64(service.MaxHops),
 	}, nil
 }
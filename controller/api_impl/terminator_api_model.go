@@ -18,13 +18,15 @@ package api_impl
 
 import (
 	"fmt"
+	"github.com/go-openapi/strfmt"
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/ziti/controller/api"
 	"github.com/openziti/ziti/controller/models"
 	"github.com/openziti/ziti/controller/network"
-	"github.com/openziti/ziti/controller/xt"
 	"github.com/openziti/ziti/controller/rest_model"
-	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/xt"
+	"time"
 )
 
 const EntityNameTerminator = "terminators"
@@ -59,12 +61,13 @@ func MapUpdateTerminatorToModel(id string, terminator *rest_model.TerminatorUpda
 			Tags: TagsOrDefault(terminator.Tags),
 			Id:   id,
 		},
-		Service:    stringz.OrEmpty(terminator.Service),
-		Router:     stringz.OrEmpty(terminator.Router),
-		Binding:    stringz.OrEmpty(terminator.Binding),
-		Address:    stringz.OrEmpty(terminator.Address),
-		Precedence: xt.GetPrecedenceForName(string(terminator.Precedence)),
-		HostId:     terminator.HostID,
+		Service:       stringz.OrEmpty(terminator.Service),
+		Router:        stringz.OrEmpty(terminator.Router),
+		Binding:       stringz.OrEmpty(terminator.Binding),
+		Address:       stringz.OrEmpty(terminator.Address),
+		Precedence:    xt.GetPrecedenceForName(string(terminator.Precedence)),
+		HostId:        terminator.HostID,
+		DrainDeadline: toDrainDeadline(terminator.DrainTimeoutSeconds),
 	}
 
 	if terminator.Cost != nil {
@@ -80,12 +83,13 @@ func MapPatchTerminatorToModel(id string, terminator *rest_model.TerminatorPatch
 			Tags: TagsOrDefault(terminator.Tags),
 			Id:   id,
 		},
-		Service:    terminator.Service,
-		Router:     terminator.Router,
-		Binding:    terminator.Binding,
-		Address:    terminator.Address,
-		Precedence: xt.GetPrecedenceForName(string(terminator.Precedence)),
-		HostId:     terminator.HostID,
+		Service:       terminator.Service,
+		Router:        terminator.Router,
+		Binding:       terminator.Binding,
+		Address:       terminator.Address,
+		Precedence:    xt.GetPrecedenceForName(string(terminator.Precedence)),
+		HostId:        terminator.HostID,
+		DrainDeadline: toDrainDeadline(terminator.DrainTimeoutSeconds),
 	}
 
 	if terminator.Cost != nil {
@@ -95,6 +99,16 @@ func MapPatchTerminatorToModel(id string, terminator *rest_model.TerminatorPatch
 	return ret
 }
 
+// toDrainDeadline converts a drain timeout to a deadline. If no timeout is given, the terminator manager applies
+// the default when the terminator is set to draining
+func toDrainDeadline(drainTimeoutSeconds *int64) *time.Time {
+	if drainTimeoutSeconds == nil {
+		return nil
+	}
+	deadline := time.Now().Add(time.Duration(*drainTimeoutSeconds) * time.Second)
+	return &deadline
+}
+
 type TerminatorModelMapper struct{}
 
 func (TerminatorModelMapper) ToApi(n *network.Network, _ api.RequestContext, terminator *network.Terminator) (interface{}, error) {
@@ -145,9 +159,15 @@ func MapTerminatorToRestModel(n *network.Network, terminator *network.Terminator
 		resultPrecedence = rest_model.TerminatorPrecedenceRequired
 	} else if precedence.IsFailed() {
 		resultPrecedence = rest_model.TerminatorPrecedenceFailed
+	} else if precedence.IsDraining() {
+		resultPrecedence = rest_model.TerminatorPrecedenceDraining
 	}
 
 	ret.Precedence = &resultPrecedence
 
+	if terminator.DrainDeadline != nil {
+		ret.DrainDeadline = strfmt.DateTime(*terminator.DrainDeadline)
+	}
+
 	return ret, nil
 }
@@ -0,0 +1,55 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+const EntityNameUsage = "usage"
+
+func MapUsageRollupsToRestModel(rollups []*network.UsageRollup) rest_model.UsageRollupList {
+	result := rest_model.UsageRollupList{}
+	for _, rollup := range rollups {
+		result = append(result, MapUsageRollupToRestModel(rollup))
+	}
+	return result
+}
+
+func MapUsageRollupToRestModel(rollup *network.UsageRollup) *rest_model.UsageRollupDetail {
+	intervalStart := strfmt.DateTime(rollup.IntervalStart)
+	toInt64 := func(v uint64) *int64 {
+		result := int64(v)
+		return &result
+	}
+
+	return &rest_model.UsageRollupDetail{
+		Interval:      &rollup.Interval,
+		GroupBy:       &rollup.GroupBy,
+		EntityID:      &rollup.EntityId,
+		IntervalStart: &intervalStart,
+		IngressRx:     toInt64(rollup.IngressRx),
+		IngressTx:     toInt64(rollup.IngressTx),
+		EgressRx:      toInt64(rollup.EgressRx),
+		EgressTx:      toInt64(rollup.EgressTx),
+		FabricRx:      toInt64(rollup.FabricRx),
+		FabricTx:      toInt64(rollup.FabricTx),
+		Circuits:      toInt64(rollup.Circuits),
+	}
+}
@@ -0,0 +1,85 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/api"
+	"github.com/openziti/ziti/controller/network"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/usage"
+)
+
+func init() {
+	r := NewUsageRouter()
+	AddRouter(r)
+}
+
+type UsageRouter struct {
+	BasePath string
+}
+
+func NewUsageRouter() *UsageRouter {
+	return &UsageRouter{
+		BasePath: "/" + EntityNameUsage,
+	}
+}
+
+func (r *UsageRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.UsageListUsageHandler = usage.ListUsageHandlerFunc(func(params usage.ListUsageParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.ListUsage(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+}
+
+func (r *UsageRouter) ListUsage(n *network.Network, rc api.RequestContext, params usage.ListUsageParams) {
+	query := &network.UsageRollupQuery{
+		Interval: stringz.OrEmpty(params.Interval),
+		GroupBy:  stringz.OrEmpty(params.GroupBy),
+		EntityId: stringz.OrEmpty(params.EntityID),
+	}
+
+	if params.Since != nil {
+		since := time.Time(*params.Since)
+		query.Since = &since
+	}
+
+	if params.Until != nil {
+		until := time.Time(*params.Until)
+		query.Until = &until
+	}
+
+	rollups, err := n.Managers.UsageRollups.List(query)
+	if fe, ok := err.(*errorz.FieldError); ok {
+		rc.RespondWithFieldError(fe)
+		return
+	}
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.ListUsageEnvelope{
+		Data: MapUsageRollupsToRestModel(rollups),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
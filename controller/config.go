@@ -31,6 +31,7 @@ import (
 	"github.com/openziti/ziti/common/config"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/common/tracing"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/network"
@@ -93,7 +94,8 @@ type Config struct {
 			Path string
 		}
 	}
-	Ctrl struct {
+	Tracing *tracing.Config
+	Ctrl    struct {
 		Listener transport.Address
 		Options  *CtrlOptions
 	}
@@ -349,6 +351,16 @@ func LoadConfig(path string) (*Config, error) {
 		}
 	}
 
+	if value, found := cfgmap["tracing"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if controllerConfig.Tracing, err = tracing.LoadConfig(submap); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, errors.New("invalid tracing configuration, must be a map")
+		}
+	}
+
 	if value, found := cfgmap["ctrl"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
 			if value, found := submap["listener"]; found {
@@ -51,6 +51,7 @@ import (
 	fabricMetrics "github.com/openziti/ziti/common/metrics"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/common/profiler"
+	"github.com/openziti/ziti/common/tracing"
 	"github.com/openziti/ziti/controller/api_impl"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/handler_ctrl"
@@ -60,6 +61,10 @@ import (
 	"github.com/openziti/ziti/controller/xctrl"
 	"github.com/openziti/ziti/controller/xmgmt"
 	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_consistent_hash"
+	"github.com/openziti/ziti/controller/xt_least_latency"
+	"github.com/openziti/ziti/controller/xt_least_outstanding"
+	"github.com/openziti/ziti/controller/xt_locality"
 	"github.com/openziti/ziti/controller/xt_random"
 	"github.com/openziti/ziti/controller/xt_smartrouting"
 	"github.com/openziti/ziti/controller/xt_weighted"
@@ -287,6 +292,10 @@ func (c *Controller) initWeb() {
 		logrus.WithError(err).Fatalf("failed to create metrics api factory")
 	}
 
+	if err := c.xweb.GetRegistry().Add(events.NewEventMetricsApiFactory(c.eventDispatcher)); err != nil {
+		logrus.WithError(err).Fatalf("failed to create event metrics api factory")
+	}
+
 	if err := c.xweb.GetRegistry().Add(zac.NewZitiAdminConsoleFactory()); err != nil {
 		logrus.WithError(err).Fatalf("failed to create single page application factory")
 	}
@@ -295,6 +304,7 @@ func (c *Controller) initWeb() {
 
 func (c *Controller) Run() error {
 	c.startProfiling()
+	c.startTracing()
 
 	if err := c.registerComponents(); err != nil {
 		return fmt.Errorf("error registering component: %s", err)
@@ -367,6 +377,34 @@ func (c *Controller) Run() error {
 		panic(err)
 	}
 
+	if journalConfig, ok := c.config.src["eventJournal"].(map[interface{}]interface{}); ok {
+		if err := c.eventDispatcher.WireEventJournal(journalConfig); err != nil {
+			panic(err)
+		}
+	}
+
+	if rollupConfig, ok := c.config.src["usageRollups"].(map[interface{}]interface{}); ok {
+		config, err := network.ParseUsageRollupConfig(rollupConfig)
+		if err != nil {
+			panic(err)
+		}
+		if err = c.network.Managers.UsageRollups.Enable(config); err != nil {
+			panic(err)
+		}
+	}
+
+	alertConfig := &network.AlertConfig{EvaluationInterval: network.DefaultAlertEvaluationInterval}
+	if alertsConfig, ok := c.config.src["alerts"].(map[interface{}]interface{}); ok {
+		config, err := network.ParseAlertConfig(alertsConfig)
+		if err != nil {
+			panic(err)
+		}
+		alertConfig = config
+	}
+	if err := c.network.Managers.Alerts.Start(alertConfig); err != nil {
+		panic(err)
+	}
+
 	c.network.Run()
 
 	return nil
@@ -442,10 +480,22 @@ func (c *Controller) startProfiling() {
 	}
 }
 
+func (c *Controller) startTracing() {
+	if c.config.Tracing != nil {
+		if err := tracing.Start(c.config.Tracing, "ziti-controller", c.config.Id.Token, c.shutdownC); err != nil {
+			logrus.WithError(err).Error("unable to start opentelemetry span export")
+		}
+	}
+}
+
 func (c *Controller) registerXts() {
 	xt.GlobalRegistry().RegisterFactory(xt_smartrouting.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_random.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_weighted.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_least_outstanding.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_least_latency.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_consistent_hash.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_locality.NewFactory())
 }
 
 func (c *Controller) registerComponents() error {
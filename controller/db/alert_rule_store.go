@@ -0,0 +1,102 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"time"
+
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+)
+
+const (
+	EntityTypeAlertRules      = "alertRules"
+	FieldAlertRuleType        = "type"
+	FieldAlertRuleFor         = "for"
+	FieldAlertRuleThreshold   = "threshold"
+	FieldAlertRuleWindow      = "window"
+	FieldAlertRuleMinCircuits = "minCircuits"
+)
+
+// AlertRule is an alert rule created through the management API. Rules from the controller config aren't stored
+type AlertRule struct {
+	boltz.BaseExtEntity
+	Type        string        `json:"type"`
+	For         time.Duration `json:"for"`
+	Threshold   float64       `json:"threshold"`
+	Window      time.Duration `json:"window"`
+	MinCircuits int32         `json:"minCircuits"`
+}
+
+func (entity *AlertRule) GetEntityType() string {
+	return EntityTypeAlertRules
+}
+
+type AlertRuleStore interface {
+	boltz.EntityStore[*AlertRule]
+	boltz.EntityStrategy[*AlertRule]
+}
+
+func newAlertRuleStore(stores *stores) *alertRuleStoreImpl {
+	store := &alertRuleStoreImpl{}
+	store.baseStore = baseStore[*AlertRule]{
+		stores:    stores,
+		BaseStore: boltz.NewBaseStore(NewStoreDefinition[*AlertRule](store)),
+	}
+	store.InitImpl(store)
+	return store
+}
+
+type alertRuleStoreImpl struct {
+	baseStore[*AlertRule]
+}
+
+func (store *alertRuleStoreImpl) initializeLocal() {
+	store.AddExtEntitySymbols()
+	store.AddSymbol(FieldAlertRuleType, ast.NodeTypeString)
+	store.AddSymbol(FieldAlertRuleFor, ast.NodeTypeInt64)
+	store.AddSymbol(FieldAlertRuleThreshold, ast.NodeTypeFloat64)
+	store.AddSymbol(FieldAlertRuleWindow, ast.NodeTypeInt64)
+	store.AddSymbol(FieldAlertRuleMinCircuits, ast.NodeTypeInt64)
+}
+
+func (store *alertRuleStoreImpl) initializeLinked() {
+}
+
+func (self *alertRuleStoreImpl) NewEntity() *AlertRule {
+	return &AlertRule{}
+}
+
+func (self *alertRuleStoreImpl) FillEntity(entity *AlertRule, bucket *boltz.TypedBucket) {
+	entity.LoadBaseValues(bucket)
+	entity.Type = bucket.GetStringOrError(FieldAlertRuleType)
+	entity.For = time.Duration(bucket.GetInt64WithDefault(FieldAlertRuleFor, 0))
+	if threshold := bucket.GetFloat64(FieldAlertRuleThreshold); threshold != nil {
+		entity.Threshold = *threshold
+	}
+	entity.Window = time.Duration(bucket.GetInt64WithDefault(FieldAlertRuleWindow, 0))
+	entity.MinCircuits = bucket.GetInt32WithDefault(FieldAlertRuleMinCircuits, 0)
+}
+
+func (self *alertRuleStoreImpl) PersistEntity(entity *AlertRule, ctx *boltz.PersistContext) {
+	entity.SetBaseValues(ctx)
+	ctx.SetRequiredString(FieldAlertRuleType, entity.Type)
+	ctx.SetInt64(FieldAlertRuleFor, int64(entity.For))
+	ctx.Bucket.SetFloat64(FieldAlertRuleThreshold, entity.Threshold, ctx.FieldChecker)
+	ctx.SetInt64(FieldAlertRuleWindow, int64(entity.Window))
+	ctx.SetInt32(FieldAlertRuleMinCircuits, entity.MinCircuits)
+}
@@ -17,6 +17,10 @@
 package db
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"github.com/openziti/ziti/controller/xt"
@@ -29,6 +33,9 @@ const (
 	EntityTypeServices             = "services"
 	FieldServiceTerminatorStrategy = "terminatorStrategy"
 	FieldServiceMaxIdleTime        = "maxIdleTime"
+	FieldServiceAvoidRouters       = "avoidRouters"
+	FieldServiceEgressRouters      = "egressRouters"
+	FieldServiceMaxHops            = "maxHops"
 )
 
 type Service struct {
@@ -36,6 +43,48 @@ type Service struct {
 	Name               string        `json:"name"`
 	MaxIdleTime        time.Duration `json:"maxIdleTime"`
 	TerminatorStrategy string        `json:"terminatorStrategy"`
+	AvoidRouters       []string      `json:"avoidRouters"`
+	EgressRouters      []string      `json:"egressRouters"`
+	MaxHops            int32         `json:"maxHops"`
+}
+
+// RouterSelector picks out routers for service path constraints. Selectors are written as #attribute, matching edge
+// router role attributes, @id, matching a router by id, or key=value, matching a router tag
+type RouterSelector struct {
+	Attribute string
+	Id        string
+	TagKey    string
+	TagValue  string
+}
+
+func ParseRouterSelector(val string) (*RouterSelector, error) {
+	if len(val) > 1 && strings.HasPrefix(val, "#") {
+		return &RouterSelector{Attribute: val[1:]}, nil
+	}
+	if len(val) > 1 && strings.HasPrefix(val, "@") {
+		return &RouterSelector{Id: val[1:]}, nil
+	}
+	if key, value, found := strings.Cut(val, "="); found && key != "" {
+		return &RouterSelector{TagKey: key, TagValue: value}, nil
+	}
+	return nil, fmt.Errorf("invalid router selector '%v', must be one of #attribute, @id or key=value", val)
+}
+
+// Matches returns true if the selector matches a router with the given id, tags and role attributes
+func (self *RouterSelector) Matches(id string, tags map[string]interface{}, roleAttributes []string) bool {
+	if self.Id != "" {
+		return self.Id == id
+	}
+	if self.Attribute != "" {
+		for _, attr := range roleAttributes {
+			if attr == self.Attribute {
+				return true
+			}
+		}
+		return false
+	}
+	val, found := tags[self.TagKey]
+	return found && fmt.Sprint(val) == self.TagValue
 }
 
 func (entity *Service) GetEntityType() string {
@@ -76,6 +125,9 @@ func (store *serviceStoreImpl) initializeLocal() {
 	store.indexName = store.AddUniqueIndex(symbolName)
 
 	store.AddSymbol(FieldServiceTerminatorStrategy, ast.NodeTypeString)
+	store.AddSetSymbol(FieldServiceAvoidRouters, ast.NodeTypeString)
+	store.AddSetSymbol(FieldServiceEgressRouters, ast.NodeTypeString)
+	store.AddSymbol(FieldServiceMaxHops, ast.NodeTypeInt64)
 	store.terminatorsSymbol = store.AddFkSetSymbol(EntityTypeTerminators, store.stores.terminator)
 }
 
@@ -95,6 +147,9 @@ func (store *serviceStoreImpl) FillEntity(entity *Service, bucket *boltz.TypedBu
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.TerminatorStrategy = bucket.GetStringWithDefault(FieldServiceTerminatorStrategy, "")
 	entity.MaxIdleTime = time.Duration(bucket.GetInt64WithDefault(FieldServiceMaxIdleTime, 0))
+	entity.AvoidRouters = bucket.GetStringList(FieldServiceAvoidRouters)
+	entity.EgressRouters = bucket.GetStringList(FieldServiceEgressRouters)
+	entity.MaxHops = bucket.GetInt32WithDefault(FieldServiceMaxHops, 0)
 }
 
 func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.PersistContext) {
@@ -102,6 +157,17 @@ func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.Persist
 	ctx.SetString(FieldName, entity.Name)
 	ctx.SetInt64(FieldServiceMaxIdleTime, int64(entity.MaxIdleTime))
 
+	validateRouterSelectors(ctx, FieldServiceAvoidRouters, entity.AvoidRouters)
+	validateRouterSelectors(ctx, FieldServiceEgressRouters, entity.EgressRouters)
+	ctx.SetStringList(FieldServiceAvoidRouters, entity.AvoidRouters)
+	ctx.SetStringList(FieldServiceEgressRouters, entity.EgressRouters)
+
+	if entity.MaxHops < 0 {
+		ctx.Bucket.SetError(errorz.NewFieldError("maxHops must not be negative", FieldServiceMaxHops, entity.MaxHops))
+		return
+	}
+	ctx.SetInt32(FieldServiceMaxHops, entity.MaxHops)
+
 	if entity.TerminatorStrategy == "" {
 		entity.TerminatorStrategy = xt_smartrouting.Name
 	}
@@ -124,6 +190,18 @@ func (store *serviceStoreImpl) PersistEntity(entity *Service, ctx *boltz.Persist
 	}
 }
 
+func validateRouterSelectors(ctx *boltz.PersistContext, field string, selectors []string) {
+	if !ctx.ProceedWithSet(field) {
+		return
+	}
+	for _, selector := range selectors {
+		if _, err := ParseRouterSelector(selector); err != nil {
+			ctx.Bucket.SetError(errorz.NewFieldError(err.Error(), field, selector))
+			return
+		}
+	}
+}
+
 func (store *serviceStoreImpl) FindByName(tx *bbolt.Tx, name string) (*Service, error) {
 	id := store.indexName.Read(tx, []byte(name))
 	if id != nil {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/openziti/storage/boltz"
+	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
 )
 
@@ -62,6 +63,16 @@ func (ctx *TestContext) testCreateInvalidServices(t *testing.T) {
 	service.TerminatorStrategy = uuid.New().String()
 	err = boltztest.Create(ctx, service)
 	ctx.EqualError(err, fmt.Sprintf("terminatorStrategy with name %v not found", service.TerminatorStrategy))
+
+	service.TerminatorStrategy = ""
+	service.AvoidRouters = []string{"jurisdiction=cn", "dmz"}
+	err = boltztest.Create(ctx, service)
+	ctx.ErrorContains(err, "invalid router selector 'dmz'")
+
+	service.AvoidRouters = nil
+	service.MaxHops = -1
+	err = boltztest.Create(ctx, service)
+	ctx.ErrorContains(err, "maxHops must not be negative")
 }
 
 func (ctx *TestContext) testCreateServices(t *testing.T) {
@@ -74,6 +85,40 @@ func (ctx *TestContext) testCreateServices(t *testing.T) {
 	}
 	boltztest.RequireCreate(ctx, service)
 	boltztest.ValidateBaseline(ctx, service)
+
+	service = &Service{
+		BaseExtEntity: boltz.BaseExtEntity{Id: uuid.New().String()},
+		Name:          uuid.New().String(),
+		AvoidRouters:  []string{"@router1", "jurisdiction=cn"},
+		EgressRouters: []string{"#dmz"},
+		MaxHops:       3,
+	}
+	boltztest.RequireCreate(ctx, service)
+	boltztest.ValidateBaseline(ctx, service)
+}
+
+func TestParseRouterSelector(t *testing.T) {
+	req := require.New(t)
+
+	selector, err := ParseRouterSelector("#dmz")
+	req.NoError(err)
+	req.True(selector.Matches("r1", nil, []string{"public", "dmz"}))
+	req.False(selector.Matches("r1", map[string]interface{}{"dmz": "true"}, nil))
+
+	selector, err = ParseRouterSelector("@r1")
+	req.NoError(err)
+	req.True(selector.Matches("r1", nil, nil))
+	req.False(selector.Matches("r2", nil, nil))
+
+	selector, err = ParseRouterSelector("jurisdiction=cn")
+	req.NoError(err)
+	req.True(selector.Matches("r1", map[string]interface{}{"jurisdiction": "cn"}, nil))
+	req.False(selector.Matches("r1", map[string]interface{}{"jurisdiction": "us"}, nil))
+
+	for _, invalid := range []string{"", "#", "@", "=cn", "dmz"} {
+		_, err = ParseRouterSelector(invalid)
+		req.Error(err, invalid)
+	}
 }
 
 type serviceTestEntities struct {
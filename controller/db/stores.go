@@ -94,6 +94,7 @@ type Stores struct {
 
 	Router                  RouterStore
 	Service                 ServiceStore
+	AlertRule               AlertRuleStore
 	Terminator              TerminatorStore
 	ApiSession              ApiSessionStore
 	ApiSessionCertificate   ApiSessionCertificateStore
@@ -196,6 +197,7 @@ type stores struct {
 	EventualEventer EventualEventer
 
 	terminator              *terminatorStoreImpl
+	alertRule               *alertRuleStoreImpl
 	router                  *routerStoreImpl
 	service                 *serviceStoreImpl
 	apiSession              *apiSessionStoreImpl
@@ -252,6 +254,7 @@ func InitStores(db boltz.Db, rateLimiter rate.RateLimiter) (*Stores, error) {
 	internalStores.router = newRouterStore(internalStores)
 	internalStores.service = newServiceStore(internalStores)
 	internalStores.terminator = newTerminatorStore(internalStores)
+	internalStores.alertRule = newAlertRuleStore(internalStores)
 
 	internalStores.apiSession = newApiSessionStore(internalStores)
 	internalStores.apiSessionCertificate = newApiSessionCertificateStore(internalStores)
@@ -283,6 +286,7 @@ func InitStores(db boltz.Db, rateLimiter rate.RateLimiter) (*Stores, error) {
 		Terminator: internalStores.terminator,
 		Router:     internalStores.router,
 		Service:    internalStores.service,
+		AlertRule:  internalStores.alertRule,
 
 		ApiSession:              internalStores.apiSession,
 		ApiSessionCertificate:   internalStores.apiSessionCertificate,
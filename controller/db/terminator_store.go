@@ -24,6 +24,7 @@ import (
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"go.etcd.io/bbolt"
+	"time"
 )
 
 const (
@@ -39,6 +40,7 @@ const (
 	FieldServerPeerData            = "peerData"
 	FieldTerminatorHostId          = "hostId"
 	FieldTerminatorSavedPrecedence = "savedPrecedence"
+	FieldTerminatorDrainDeadline   = "drainDeadline"
 )
 
 type Terminator struct {
@@ -54,6 +56,7 @@ type Terminator struct {
 	PeerData        xt.PeerData `json:"peerData"`
 	HostId          string      `json:"hostId"`
 	SavedPrecedence *string     `json:"savedPrecedence"`
+	DrainDeadline   *time.Time  `json:"drainDeadline"`
 }
 
 func (entity *Terminator) GetCost() uint16 {
@@ -133,6 +136,7 @@ func (store *terminatorStoreImpl) initializeLocal() {
 	store.AddSymbol(FieldTerminatorAddress, ast.NodeTypeString)
 	store.AddSymbol(FieldTerminatorInstanceId, ast.NodeTypeString)
 	store.AddSymbol(FieldTerminatorHostId, ast.NodeTypeString)
+	store.AddSymbol(FieldTerminatorPrecedence, ast.NodeTypeString)
 
 	store.serviceSymbol = store.AddFkSymbol(FieldTerminatorService, store.stores.service)
 	store.routerSymbol = store.AddFkSymbol(FieldTerminatorRouter, store.stores.router)
@@ -164,6 +168,7 @@ func (store *terminatorStoreImpl) FillEntity(entity *Terminator, bucket *boltz.T
 	entity.Precedence = bucket.GetStringWithDefault(FieldTerminatorPrecedence, xt.Precedences.Default.String())
 	entity.HostId = bucket.GetStringWithDefault(FieldTerminatorHostId, "")
 	entity.SavedPrecedence = bucket.GetString(FieldTerminatorSavedPrecedence)
+	entity.DrainDeadline = bucket.GetTime(FieldTerminatorDrainDeadline)
 
 	data := bucket.GetBucket(FieldServerPeerData)
 	if data != nil {
@@ -202,6 +207,14 @@ func (store *terminatorStoreImpl) PersistEntity(entity *Terminator, ctx *boltz.P
 	ctx.SetString(FieldTerminatorHostId, entity.HostId)
 	ctx.SetStringP(FieldTerminatorSavedPrecedence, entity.SavedPrecedence)
 
+	// the drain deadline only applies while draining, so it's cleared whenever the precedence changes
+	if ctx.ProceedWithSet(FieldTerminatorPrecedence) {
+		if entity.Precedence != xt.Precedences.Draining.String() {
+			entity.DrainDeadline = nil
+		}
+		ctx.Bucket.SetTimeP(FieldTerminatorDrainDeadline, entity.DrainDeadline, nil)
+	}
+
 	if ctx.ProceedWithSet(FieldServerPeerData) {
 		_ = ctx.Bucket.DeleteBucket([]byte(FieldServerPeerData))
 		if entity.PeerData != nil {
@@ -236,9 +249,9 @@ func (store *terminatorStoreImpl) PersistEntity(entity *Terminator, ctx *boltz.P
 	terminators, err := store.stores.service.getTerminators(ctx.Bucket.Tx(), serviceId)
 	ctx.Bucket.SetError(err)
 	if ctx.IsCreate {
-		event = xt.NewStrategyChangeEvent(entity.Id, terminators, xt.TList(entity), nil, nil)
+		event = xt.NewStrategyChangeEvent(serviceId, terminators, xt.TList(entity), nil, nil)
 	} else {
-		event = xt.NewStrategyChangeEvent(entity.Id, terminators, nil, xt.TList(entity), nil)
+		event = xt.NewStrategyChangeEvent(serviceId, terminators, nil, xt.TList(entity), nil)
 	}
 	ctx.Bucket.SetError(strategy.HandleTerminatorChange(event))
 }
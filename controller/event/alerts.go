@@ -0,0 +1,86 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+const AlertEventsNs = "fabric.alerts"
+
+const (
+	AlertEventTypeFired    = "fired"
+	AlertEventTypeResolved = "resolved"
+)
+
+var AlertEventTypes = []string{
+	AlertEventTypeFired,
+	AlertEventTypeResolved,
+}
+
+// An AlertEvent is emitted when an alert rule starts or stops matching an entity. The value is the measurement
+// which caused the transition, such as the number of seconds a router has been offline or the p95 latency of a
+// link in milliseconds.
+//
+// Valid event types are:
+//   - fired
+//   - resolved
+//
+// Example: Router offline alert firing
+//
+//	{
+//	  "namespace": "fabric.alerts",
+//	  "event_type": "fired",
+//	  "timestamp": "2024-05-01T10:20:30.123456789-04:00",
+//	  "rule_id": "router-offline",
+//	  "rule_type": "routerOffline",
+//	  "entity_type": "router",
+//	  "entity_id": "5g2QrZxFcw",
+//	  "value": 312.5,
+//	  "threshold": 300,
+//	  "fired_at": "2024-05-01T10:20:30.123456789-04:00",
+//	  "message": "router 5g2QrZxFcw has been offline for 5m12s"
+//	}
+type AlertEvent struct {
+	Namespace  string    `json:"namespace"`
+	EventType  string    `json:"event_type"`
+	Timestamp  time.Time `json:"timestamp"`
+	RuleId     string    `json:"rule_id"`
+	RuleType   string    `json:"rule_type"`
+	EntityType string    `json:"entity_type"`
+	EntityId   string    `json:"entity_id"`
+	Value      float64   `json:"value"`
+	Threshold  float64   `json:"threshold"`
+	FiredAt    time.Time `json:"fired_at"`
+	Message    string    `json:"message"`
+}
+
+func (event *AlertEvent) String() string {
+	return fmt.Sprintf("%v.%v timestamp=%v ruleId=%v ruleType=%v entityType=%v entityId=%v value=%v threshold=%v firedAt=%v message=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.RuleId, event.RuleType, event.EntityType, event.EntityId,
+		event.Value, event.Threshold, event.FiredAt, event.Message)
+}
+
+type AlertEventHandler interface {
+	AcceptAlertEvent(event *AlertEvent)
+}
+
+type AlertEventHandlerWrapper interface {
+	AlertEventHandler
+	IsWrapping(value AlertEventHandler) bool
+}
@@ -0,0 +1,91 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+const AuthenticationEventNS = "edge.authentications"
+
+const (
+	AuthenticationEventTypeSuccess    = "success"
+	AuthenticationEventTypeFailure    = "failure"
+	AuthenticationEventTypeLockout    = "lockout"
+	AuthenticationEventTypeMfaSuccess = "mfaSuccess"
+	AuthenticationEventTypeMfaFailure = "mfaFailure"
+)
+
+var AuthenticationEventTypes = []string{
+	AuthenticationEventTypeSuccess,
+	AuthenticationEventTypeFailure,
+	AuthenticationEventTypeLockout,
+	AuthenticationEventTypeMfaSuccess,
+	AuthenticationEventTypeMfaFailure,
+}
+
+// An AuthenticationEvent is emitted for each primary authentication attempt, for each MFA challenge
+// response and when an identity is locked out after too many failed attempts. The identity, authenticator
+// and auth policy are only set if they could be resolved before the attempt was accepted or rejected.
+//
+// Valid event types are:
+//   - success
+//   - failure
+//   - lockout
+//   - mfaSuccess
+//   - mfaFailure
+//
+// Example: Failed password authentication
+//
+//	{
+//	  "namespace": "edge.authentications",
+//	  "event_type": "failure",
+//	  "timestamp": "2024-05-01T10:20:30.123456789-04:00",
+//	  "auth_method": "password",
+//	  "identity_id": "hZkPLmQ9S",
+//	  "authenticator_id": "1a2b3c4d5",
+//	  "auth_policy_id": "default",
+//	  "remote_address": "10.0.0.5:51234",
+//	  "failure_reason": "INVALID_CREDENTIALS"
+//	}
+type AuthenticationEvent struct {
+	Namespace       string    `json:"namespace"`
+	EventType       string    `json:"event_type"`
+	Timestamp       time.Time `json:"timestamp"`
+	AuthMethod      string    `json:"auth_method"`
+	IdentityId      string    `json:"identity_id,omitempty"`
+	AuthenticatorId string    `json:"authenticator_id,omitempty"`
+	AuthPolicyId    string    `json:"auth_policy_id,omitempty"`
+	RemoteAddress   string    `json:"remote_address,omitempty"`
+	FailureReason   string    `json:"failure_reason,omitempty"`
+}
+
+func (event *AuthenticationEvent) String() string {
+	return fmt.Sprintf("%v.%v timestamp=%v authMethod=%v identityId=%v authenticatorId=%v authPolicyId=%v remoteAddress=%v failureReason=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.AuthMethod, event.IdentityId, event.AuthenticatorId,
+		event.AuthPolicyId, event.RemoteAddress, event.FailureReason)
+}
+
+type AuthenticationEventHandler interface {
+	AcceptAuthenticationEvent(event *AuthenticationEvent)
+}
+
+type AuthenticationEventHandlerWrapper interface {
+	AuthenticationEventHandler
+	IsWrapping(value AuthenticationEventHandler) bool
+}
@@ -30,9 +30,10 @@ const (
 	CircuitUpdated       CircuitEventType = "pathUpdated"
 	CircuitDeleted       CircuitEventType = "deleted"
 	CircuitFailed        CircuitEventType = "failed"
+	CircuitRerouted      CircuitEventType = "rerouted"
 )
 
-var CircuitEventTypes = []CircuitEventType{CircuitCreated, CircuitUpdated, CircuitDeleted, CircuitFailed}
+var CircuitEventTypes = []CircuitEventType{CircuitCreated, CircuitUpdated, CircuitDeleted, CircuitFailed, CircuitRerouted}
 
 type CircuitPath struct {
 	Nodes                []string `json:"nodes"`
@@ -77,6 +78,8 @@ type CircuitEvent struct {
 	FailureCause     *string           `json:"failure_cause,omitempty"`
 	Duration         *time.Duration    `json:"duration,omitempty"`
 	Tags             map[string]string `json:"tags"`
+	OldPath          *CircuitPath      `json:"old_path,omitempty"`
+	RerouteReason    *string           `json:"reroute_reason,omitempty"`
 }
 
 func (event *CircuitEvent) String() string {
@@ -88,6 +91,12 @@ func (event *CircuitEvent) String() string {
 			if event.CreationTimespan != nil {
 				out = fmt.Sprintf("%s creationTimespan=%s", out, *event.CreationTimespan)
 			}
+			if event.OldPath != nil {
+				out = fmt.Sprintf("%s oldPath=%v", out, event.OldPath)
+			}
+			if event.RerouteReason != nil {
+				out = fmt.Sprintf("%s rerouteReason=%s", out, *event.RerouteReason)
+			}
 			return
 		}())
 }
@@ -76,6 +76,9 @@ type Dispatcher interface {
 
 	GetFormatterFactory(formatterType string) FormatterFactory
 
+	// GetEventJournal returns the event journal, or nil if journaling isn't configured
+	GetEventJournal() EventJournal
+
 	ProcessSubscriptions(handler interface{}, subscriptions []*Subscription) error
 	RemoveAllSubscriptions(handler interface{})
 
@@ -105,6 +108,8 @@ type Dispatcher interface {
 
 	AddUsageEventHandler(handler UsageEventHandler)
 	RemoveUsageEventHandler(handler UsageEventHandler)
+	AddUsageEventV3Handler(handler UsageEventV3Handler)
+	RemoveUsageEventV3Handler(handler UsageEventV3Handler)
 
 	AddClusterEventHandler(handler ClusterEventHandler)
 	RemoveClusterEventHandler(handler ClusterEventHandler)
@@ -121,9 +126,17 @@ type Dispatcher interface {
 	AddSessionEventHandler(handler SessionEventHandler)
 	RemoveSessionEventHandler(handler SessionEventHandler)
 
+	AddAuthenticationEventHandler(handler AuthenticationEventHandler)
+	RemoveAuthenticationEventHandler(handler AuthenticationEventHandler)
+
+	AddAlertEventHandler(handler AlertEventHandler)
+	RemoveAlertEventHandler(handler AlertEventHandler)
+
 	AddEntityCountEventHandler(handler EntityCountEventHandler, interval time.Duration, onlyLeaderEvents bool)
 	RemoveEntityCountEventHandler(handler EntityCountEventHandler)
 
+	AlertEventHandler
+	AuthenticationEventHandler
 	CircuitEventHandler
 	EntityChangeEventHandler
 	LinkEventHandler
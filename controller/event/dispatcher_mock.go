@@ -31,6 +31,18 @@ func (d DispatcherMock) AddApiSessionEventHandler(handler ApiSessionEventHandler
 
 func (d DispatcherMock) RemoveApiSessionEventHandler(handler ApiSessionEventHandler) {}
 
+func (d DispatcherMock) AddAuthenticationEventHandler(handler AuthenticationEventHandler) {}
+
+func (d DispatcherMock) RemoveAuthenticationEventHandler(handler AuthenticationEventHandler) {}
+
+func (d DispatcherMock) AcceptAuthenticationEvent(*AuthenticationEvent) {}
+
+func (d DispatcherMock) AddAlertEventHandler(handler AlertEventHandler) {}
+
+func (d DispatcherMock) RemoveAlertEventHandler(handler AlertEventHandler) {}
+
+func (d DispatcherMock) AcceptAlertEvent(*AlertEvent) {}
+
 func (d DispatcherMock) AddSessionEventHandler(handler SessionEventHandler) {}
 
 func (d DispatcherMock) RemoveSessionEventHandler(handler SessionEventHandler) {}
@@ -50,6 +62,10 @@ func (d DispatcherMock) RemoveEntityChangeEventHandler(handler EntityChangeEvent
 
 func (d DispatcherMock) AcceptEntityChangeEvent(event *EntityChangeEvent) {}
 
+func (d DispatcherMock) GetEventJournal() EventJournal {
+	return nil
+}
+
 func (d DispatcherMock) GetFormatterFactory(formatterType string) FormatterFactory {
 	return nil
 }
@@ -107,6 +123,10 @@ func (d DispatcherMock) AddUsageEventHandler(UsageEventHandler) {}
 
 func (d DispatcherMock) RemoveUsageEventHandler(UsageEventHandler) {}
 
+func (d DispatcherMock) AddUsageEventV3Handler(UsageEventV3Handler) {}
+
+func (d DispatcherMock) RemoveUsageEventV3Handler(UsageEventV3Handler) {}
+
 func (d DispatcherMock) AcceptCircuitEvent(*CircuitEvent) {}
 
 func (d DispatcherMock) AcceptLinkEvent(*LinkEvent) {}
@@ -0,0 +1,95 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	JournalEventNs      = "journal"
+	JournalEventTypeGap = "gap"
+
+	// JournalGapEventType is the event type header value used when a gap is reported to a stream consumer
+	JournalGapEventType = "journal.gap"
+)
+
+// A JournalEntry is a single event retained by the EventJournal. Data holds the event formatted as json.
+type JournalEntry struct {
+	Sequence  uint64          `json:"sequence"`
+	Timestamp time.Time       `json:"timestamp"`
+	Namespace string          `json:"namespace"`
+	EventType string          `json:"eventType"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// A JournalPosition identifies where a consumer wants to resume from. If FromTimestamp is set, it takes
+// precedence over FromSequence
+type JournalPosition struct {
+	FromSequence  uint64
+	FromTimestamp *time.Time
+}
+
+// A JournalGap is reported to consumers when some of the history they asked for is no longer available,
+// either because it was truncated or because the journal was reset
+type JournalGap struct {
+	Namespace          string     `json:"namespace"`
+	EventType          string     `json:"event_type"`
+	Timestamp          time.Time  `json:"timestamp"`
+	RequestedSequence  uint64     `json:"requested_sequence,omitempty"`
+	RequestedTimestamp *time.Time `json:"requested_timestamp,omitempty"`
+	FirstSequence      uint64     `json:"first_sequence"`
+}
+
+// A JournalListener is notified of entries as they are added to the journal. Listeners must not block.
+type JournalListener interface {
+	AcceptJournalEntry(entry *JournalEntry)
+}
+
+// An EventJournal retains a bounded history of events so that consumers can resume after a disconnect
+type EventJournal interface {
+	// Namespaces returns the event types being journaled
+	Namespaces() []string
+
+	// Follow returns the retained entries starting at the given position and registers the listener to receive
+	// all subsequent entries. Nothing is journaled between the replay being collected and the listener being
+	// added. If part of the requested history is unavailable, a non-nil gap is returned.
+	Follow(position JournalPosition, listener JournalListener) ([]*JournalEntry, *JournalGap)
+
+	// Unfollow removes the given listener
+	Unfollow(listener JournalListener)
+
+	// NewReplayer returns a JournalReplayer which applies the given subscriptions, including their options, and the
+	// given format to journal entries, the same way they're applied to live events
+	NewReplayer(format string, subscriptions []*Subscription, sink JournalReplaySink) (JournalReplayer, error)
+}
+
+// A JournalReplayer filters and formats journal entries for a stream consumer
+type JournalReplayer interface {
+	// Replay passes the entry to the sink if it's selected by the replayer's subscriptions. Entries are passed to the
+	// sink in the order they're replayed, but may be delivered asynchronously
+	Replay(entry *JournalEntry)
+
+	// Close releases the replayer. Entries replayed after Close are dropped
+	Close() error
+}
+
+// A JournalReplaySink receives formatted journal entries from a JournalReplayer
+type JournalReplaySink interface {
+	AcceptJournaledEvent(sequence uint64, eventType string, formattedEvent []byte)
+}
@@ -31,6 +31,8 @@ const (
 	TerminatorDeleted       TerminatorEventType = "deleted"
 	TerminatorRouterOnline  TerminatorEventType = "router-online"
 	TerminatorRouterOffline TerminatorEventType = "router-offline"
+	TerminatorDraining      TerminatorEventType = "draining"
+	TerminatorDrained       TerminatorEventType = "drained"
 )
 
 type TerminatorEvent struct {
@@ -54,7 +56,9 @@ type TerminatorEvent struct {
 func (event *TerminatorEvent) IsModelEvent() bool {
 	return event.EventType == TerminatorCreated ||
 		event.EventType == TerminatorUpdated ||
-		event.EventType == TerminatorDeleted
+		event.EventType == TerminatorDeleted ||
+		event.EventType == TerminatorDraining ||
+		event.EventType == TerminatorDrained
 }
 
 func (event *TerminatorEvent) String() string {
@@ -53,7 +53,9 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 		},
 	}
 	result.entityChangeEventsDispatcher.dispatcher = result
+	result.filterEntities = newFilterEntityCache(result)
 
+	result.RegisterEventTypeFunctions(event.AlertEventsNs, result.registerAlertEventHandler, result.unregisterAlertEventHandler)
 	result.RegisterEventTypeFunctions(event.CircuitEventsNs, result.registerCircuitEventHandler, result.unregisterCircuitEventHandler)
 	result.RegisterEventTypeFunctions(event.EntityChangeEventsNs, result.registerEntityChangeEventHandler, result.unregisterEntityChangeEventHandler)
 	result.RegisterEventTypeFunctions(event.LinkEventsNs, result.registerLinkEventHandler, result.unregisterLinkEventHandler)
@@ -65,16 +67,23 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 	result.RegisterEventTypeFunctions(event.ClusterEventsNs, result.registerClusterEventHandler, result.unregisterClusterEventHandler)
 
 	result.RegisterEventTypeFunctions(event.ApiSessionEventNS, result.registerApiSessionEventHandler, result.unregisterApiSessionEventHandler)
+	result.RegisterEventTypeFunctions(event.AuthenticationEventNS, result.registerAuthenticationEventHandler, result.unregisterAuthenticationEventHandler)
 	result.RegisterEventTypeFunctions(event.EntityCountEventNS, result.registerEntityCountEventHandler, result.unregisterEntityCountEventHandler)
 	result.RegisterEventTypeFunctions(event.SessionEventNS, result.registerSessionEventHandler, result.unregisterSessionEventHandler)
 
-	result.RegisterFormatterFactory("json", event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
-		return NewJsonFormatter(16, sink)
-	}))
+	for _, format := range BuiltinFormats {
+		format := format
+		result.RegisterFormatterFactory(format, event.FormatterFactoryF(func(sink event.FormattedEventSink) io.Closer {
+			return NewBuiltinFormatter(format, 16, sink)
+		}))
+	}
 
 	result.RegisterEventHandlerFactory("file", FileEventLoggerFactory{})
 	result.RegisterEventHandlerFactory("stdout", StdOutLoggerFactory{})
 	result.RegisterEventHandlerFactory("amqp", AMQPEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("webhook", WebhookEventLoggerFactory{})
+	result.RegisterEventHandlerFactory("kafka", KafkaEventLoggerFactory{dispatcher: result})
+	result.RegisterEventHandlerFactory("prometheus", PrometheusEventHandlerFactory{dispatcher: result})
 
 	return result
 }
@@ -82,6 +91,7 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 var _ event.Dispatcher = (*Dispatcher)(nil)
 
 type Dispatcher struct {
+	alertEventHandlers        concurrenz.CopyOnWriteSlice[event.AlertEventHandler]
 	circuitEventHandlers      concurrenz.CopyOnWriteSlice[event.CircuitEventHandler]
 	entityChangeEventHandlers concurrenz.CopyOnWriteSlice[event.EntityChangeEventHandler]
 	linkEventHandlers         concurrenz.CopyOnWriteSlice[event.LinkEventHandler]
@@ -94,12 +104,19 @@ type Dispatcher struct {
 	usageEventV3Handlers      concurrenz.CopyOnWriteSlice[event.UsageEventV3Handler]
 	clusterEventHandlers      concurrenz.CopyOnWriteSlice[event.ClusterEventHandler]
 
-	apiSessionEventHandlers  concurrenz.CopyOnWriteSlice[event.ApiSessionEventHandler]
-	entityCountEventHandlers concurrenz.CopyOnWriteSlice[*entityCountState]
-	sessionEventHandlers     concurrenz.CopyOnWriteSlice[event.SessionEventHandler]
+	apiSessionEventHandlers     concurrenz.CopyOnWriteSlice[event.ApiSessionEventHandler]
+	authenticationEventHandlers concurrenz.CopyOnWriteSlice[event.AuthenticationEventHandler]
+	entityCountEventHandlers    concurrenz.CopyOnWriteSlice[*entityCountState]
+	sessionEventHandlers        concurrenz.CopyOnWriteSlice[event.SessionEventHandler]
 
 	metricsMappers concurrenz.CopyOnWriteSlice[event.MetricsMapper]
 
+	filterEntities *filterEntityCache
+
+	prometheusEventHandlers concurrenz.CopyOnWriteSlice[*PrometheusEventHandler]
+
+	journal *EventJournal
+
 	registrationHandlers  concurrenz.CopyOnWriteMap[string, event.TypeRegistrar]
 	eventHandlerFactories concurrenz.CopyOnWriteMap[string, event.HandlerFactory]
 	formatterFactories    concurrenz.CopyOnWriteMap[string, event.FormatterFactory]
@@ -239,15 +256,23 @@ func (self *Dispatcher) createHandler(id interface{}, config map[interface{}]int
 }
 
 func (self *Dispatcher) processSubscriptions(handler interface{}, eventHandlerConfig *EventHandlerConfig) error {
-	subs, ok := eventHandlerConfig.Config["subscriptions"]
+	subscriptions, err := parseSubscriptions(eventHandlerConfig.Id, eventHandlerConfig.Config)
+	if err != nil {
+		return err
+	}
+	return self.ProcessSubscriptions(handler, subscriptions)
+}
+
+func parseSubscriptions(id interface{}, config map[interface{}]interface{}) ([]*event.Subscription, error) {
+	subs, ok := config["subscriptions"]
 
 	if !ok {
-		return errors.Errorf("event handler %v doesn't define any subscriptions", eventHandlerConfig.Id)
+		return nil, errors.Errorf("event handler %v doesn't define any subscriptions", id)
 	}
 
 	subscriptionList, ok := subs.([]interface{})
 	if !ok {
-		return errors.Errorf("event handler %v subscriptions is not a list", eventHandlerConfig.Id)
+		return nil, errors.Errorf("event handler %v subscriptions is not a list", id)
 	}
 
 	var subscriptions []*event.Subscription
@@ -255,7 +280,7 @@ func (self *Dispatcher) processSubscriptions(handler interface{}, eventHandlerCo
 	for idx, sub := range subscriptionList {
 		subMap, ok := sub.(map[interface{}]interface{})
 		if !ok {
-			return errors.Errorf("The subscription at index %v for event handler %v is not a map", idx, eventHandlerConfig.Id)
+			return nil, errors.Errorf("The subscription at index %v for event handler %v is not a map", idx, id)
 		}
 
 		var eventType string
@@ -273,7 +298,7 @@ func (self *Dispatcher) processSubscriptions(handler interface{}, eventHandlerCo
 		}
 
 		if eventType == "" {
-			return errors.Errorf("The subscription at index %v for event handler %v has no type", idx, eventHandlerConfig.Id)
+			return nil, errors.Errorf("The subscription at index %v for event handler %v has no type", idx, id)
 		}
 
 		subscriptions = append(subscriptions, &event.Subscription{
@@ -281,7 +306,7 @@ func (self *Dispatcher) processSubscriptions(handler interface{}, eventHandlerCo
 			Options: options,
 		})
 	}
-	return self.ProcessSubscriptions(handler, subscriptions)
+	return subscriptions, nil
 }
 
 func (self *Dispatcher) ProcessSubscriptions(handler interface{}, subscriptions []*event.Subscription) error {
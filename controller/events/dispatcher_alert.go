@@ -0,0 +1,112 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"reflect"
+)
+
+func (self *Dispatcher) AddAlertEventHandler(handler event.AlertEventHandler) {
+	self.alertEventHandlers.Append(handler)
+}
+
+func (self *Dispatcher) RemoveAlertEventHandler(handler event.AlertEventHandler) {
+	self.alertEventHandlers.DeleteIf(func(val event.AlertEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.AlertEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
+}
+
+func (self *Dispatcher) AcceptAlertEvent(evt *event.AlertEvent) {
+	for _, handler := range self.alertEventHandlers.Value() {
+		go handler.AcceptAlertEvent(evt)
+	}
+}
+
+func (self *Dispatcher) registerAlertEventHandler(val interface{}, config map[string]interface{}) error {
+	handler, ok := val.(event.AlertEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/AlertEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	var includeList []string
+	if includeVar, ok := config["include"]; ok {
+		if includeStr, ok := includeVar.(string); ok {
+			includeList = append(includeList, includeStr)
+		} else if includeIntfList, ok := includeVar.([]interface{}); ok {
+			for _, val := range includeIntfList {
+				includeList = append(includeList, fmt.Sprintf("%v", val))
+			}
+		} else {
+			return errors.Errorf("invalid type %v for %v include configuration", reflect.TypeOf(includeVar), event.AlertEventsNs)
+		}
+	}
+
+	if len(includeList) == 0 {
+		self.AddAlertEventHandler(handler)
+	} else {
+		for _, include := range includeList {
+			if !stringz.Contains(event.AlertEventTypes, include) {
+				return errors.Errorf("invalid include %v for %v. valid values are %+v", include, event.AlertEventsNs, event.AlertEventTypes)
+			}
+		}
+
+		self.AddAlertEventHandler(&alertEventAdapter{
+			wrapped:     handler,
+			includeList: includeList,
+		})
+	}
+
+	return nil
+}
+
+func (self *Dispatcher) unregisterAlertEventHandler(val interface{}) {
+	if handler, ok := val.(event.AlertEventHandler); ok {
+		self.RemoveAlertEventHandler(handler)
+	}
+}
+
+type alertEventAdapter struct {
+	wrapped     event.AlertEventHandler
+	includeList []string
+}
+
+func (adapter *alertEventAdapter) AcceptAlertEvent(evt *event.AlertEvent) {
+	if stringz.Contains(adapter.includeList, evt.EventType) {
+		adapter.wrapped.AcceptAlertEvent(evt)
+	}
+}
+
+func (adapter *alertEventAdapter) IsWrapping(value event.AlertEventHandler) bool {
+	if adapter.wrapped == value {
+		return true
+	}
+	if w, ok := adapter.wrapped.(event.AlertEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
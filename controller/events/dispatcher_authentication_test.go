@@ -0,0 +1,83 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+type testAuthenticationEventHandler struct {
+	events chan *event.AuthenticationEvent
+}
+
+func (self *testAuthenticationEventHandler) AcceptAuthenticationEvent(evt *event.AuthenticationEvent) {
+	self.events <- evt
+}
+
+func TestAuthenticationEventIncludes(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	dispatcher := NewDispatcher(closeNotify)
+	handler := &testAuthenticationEventHandler{events: make(chan *event.AuthenticationEvent, 10)}
+
+	err := dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{
+		Type:    event.AuthenticationEventNS,
+		Options: map[string]interface{}{"include": []interface{}{"failure", "lockout"}},
+	}})
+	req.NoError(err)
+
+	for _, eventType := range event.AuthenticationEventTypes {
+		dispatcher.AcceptAuthenticationEvent(&event.AuthenticationEvent{
+			Namespace:  event.AuthenticationEventNS,
+			EventType:  eventType,
+			AuthMethod: "password",
+		})
+	}
+
+	var received []string
+	for len(received) < 2 {
+		select {
+		case evt := <-handler.events:
+			received = append(received, evt.EventType)
+		case <-time.After(time.Second):
+			req.Fail("timed out waiting for authentication events")
+		}
+	}
+	req.ElementsMatch([]string{event.AuthenticationEventTypeFailure, event.AuthenticationEventTypeLockout}, received)
+
+	select {
+	case evt := <-handler.events:
+		req.Fail("unexpected event", "event type %v", evt.EventType)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	dispatcher.RemoveAllSubscriptions(handler)
+	req.Equal(0, len(dispatcher.authenticationEventHandlers.Value()))
+
+	err = dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{
+		Type:    event.AuthenticationEventNS,
+		Options: map[string]interface{}{"include": "bogus"},
+	}})
+	req.Error(err)
+}
@@ -67,6 +67,18 @@ func (self *Dispatcher) registerCircuitEventHandler(val interface{}, config map[
 		}
 	}
 
+	filter, err := self.parseEventFilter(event.CircuitEventsNs, circuitEventFilterSchema, config)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &exprFilteredCircuitEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	if len(includeList) == 0 {
 		self.AddCircuitEventHandler(handler)
 		return nil
@@ -120,3 +132,24 @@ func (self *filteredCircuitEventHandler) AcceptCircuitEvent(event *event.Circuit
 		self.wrapped.AcceptCircuitEvent(event)
 	}
 }
+
+type exprFilteredCircuitEventHandler struct {
+	filter  *eventFilter
+	wrapped event.CircuitEventHandler
+}
+
+func (self *exprFilteredCircuitEventHandler) IsWrapping(value event.CircuitEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.CircuitEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *exprFilteredCircuitEventHandler) AcceptCircuitEvent(event *event.CircuitEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptCircuitEvent(event)
+	}
+}
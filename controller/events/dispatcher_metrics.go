@@ -278,6 +278,24 @@ func (self *filteringMetricsMessageAdapter) IsWrapping(value event.MetricsEventH
 	return false
 }
 
+// AcceptMetricsEvent applies the adapter's filters to an already converted metrics event, such as one replayed
+// from the event journal
+func (self *filteringMetricsMessageAdapter) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	if self.sourceFilter != nil && !self.sourceFilter.Match([]byte(evt.SourceAppId)) {
+		return
+	}
+
+	filtered := *evt
+	filtered.Metrics = nil
+	for key, value := range evt.Metrics {
+		if key == "value" && (evt.MetricType == "intValue" || evt.MetricType == "floatValue") {
+			key = ""
+		}
+		self.dispatcher.filterMetric(self.metricFilter, key, value, &filtered)
+	}
+	self.dispatcher.finishEvent(&filtered, self.handler)
+}
+
 func (self *filteringMetricsMessageAdapter) AcceptMetricsMsg(msg *metrics_pb.MetricsMessage) {
 	if msg.DoNotPropagate {
 		return
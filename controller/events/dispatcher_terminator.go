@@ -72,6 +72,18 @@ func (self *Dispatcher) registerTerminatorEventHandler(val interface{}, options
 		}
 	}
 
+	filter, err := self.parseEventFilter(event.TerminatorEventsNs, terminatorEventFilterSchema, options)
+	if err != nil {
+		return err
+	}
+
+	if filter != nil {
+		handler = &exprFilteredTerminatorEventHandler{
+			filter:  filter,
+			wrapped: handler,
+		}
+	}
+
 	if propagateAlways {
 		self.AddTerminatorEventHandler(handler)
 	} else {
@@ -96,6 +108,7 @@ func (self *Dispatcher) initTerminatorEvents(n *network.Network) {
 	n.GetStores().Terminator.AddEntityEventListenerF(terminatorEvtAdapter.terminatorCreated, boltz.EntityCreated)
 	n.GetStores().Terminator.AddEntityEventListenerF(terminatorEvtAdapter.terminatorUpdated, boltz.EntityUpdated)
 	n.GetStores().Terminator.AddEntityEventListenerF(terminatorEvtAdapter.terminatorDeleted, boltz.EntityDeleted)
+	n.GetStores().Terminator.AddEntityConstraint(&terminatorDrainConstraint{adapter: terminatorEvtAdapter})
 
 	n.AddRouterPresenceHandler(terminatorEvtAdapter)
 }
@@ -120,6 +133,27 @@ func (self *terminatorEventFilter) AcceptTerminatorEvent(evt *event.TerminatorEv
 	}
 }
 
+type exprFilteredTerminatorEventHandler struct {
+	filter  *eventFilter
+	wrapped event.TerminatorEventHandler
+}
+
+func (self *exprFilteredTerminatorEventHandler) IsWrapping(value event.TerminatorEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.TerminatorEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *exprFilteredTerminatorEventHandler) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	if self.filter.matches(evt) {
+		self.wrapped.AcceptTerminatorEvent(evt)
+	}
+}
+
 // terminatorEventAdapter converts router presence online/offline events and terminator entity change events to
 // event.TerminatorEvent instances
 type terminatorEventAdapter struct {
@@ -179,6 +213,30 @@ func (self *terminatorEventAdapter) terminatorChanged(eventType event.Terminator
 	self.createTerminatorEvent(eventType, terminator)
 }
 
+// terminatorDrainConstraint generates draining events when a terminator is set to draining, and drained events when a
+// draining terminator is removed. It needs the terminator state from before the change, which entity event
+// listeners don't get
+type terminatorDrainConstraint struct {
+	adapter *terminatorEventAdapter
+}
+
+func (self *terminatorDrainConstraint) ProcessPreCommit(*boltz.EntityChangeState[*db.Terminator]) error {
+	return nil
+}
+
+func (self *terminatorDrainConstraint) ProcessPostCommit(state *boltz.EntityChangeState[*db.Terminator]) {
+	draining := xt.Precedences.Draining.String()
+	if state.ChangeType == boltz.EntityUpdated && state.InitialState != nil && state.FinalState != nil {
+		if state.FinalState.Precedence == draining && state.InitialState.Precedence != draining {
+			self.adapter.createTerminatorEvent(event.TerminatorDraining, state.FinalState)
+		}
+	} else if state.ChangeType == boltz.EntityDeleted && state.InitialState != nil {
+		if state.InitialState.Precedence == draining {
+			self.adapter.createTerminatorEvent(event.TerminatorDrained, state.InitialState)
+		}
+	}
+}
+
 func (self *terminatorEventAdapter) createTerminatorEvent(eventType event.TerminatorEventType, terminator *db.Terminator) {
 	service, _ := self.Network.Services.Read(terminator.Service)
 
@@ -29,7 +29,15 @@ func (self *Dispatcher) AddUsageEventHandler(handler event.UsageEventHandler) {
 }
 
 func (self *Dispatcher) RemoveUsageEventHandler(handler event.UsageEventHandler) {
-	self.usageEventHandlers.Delete(handler)
+	self.usageEventHandlers.DeleteIf(func(val event.UsageEventHandler) bool {
+		if val == handler {
+			return true
+		}
+		if w, ok := val.(event.UsageEventHandlerWrapper); ok {
+			return w.IsWrapping(handler)
+		}
+		return false
+	})
 }
 
 func (self *Dispatcher) AddUsageEventV3Handler(handler event.UsageEventV3Handler) {
@@ -83,6 +91,19 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 		if !ok {
 			return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/UsageEventHandler interface.", reflect.TypeOf(val))
 		}
+
+		filter, err := self.parseEventFilter(event.UsageEventsNs, usageEventFilterSchema, config)
+		if err != nil {
+			return err
+		}
+
+		if filter != nil {
+			handler = &exprFilteredUsageEventHandler{
+				filter:  filter,
+				wrapped: handler,
+			}
+		}
+
 		self.AddUsageEventHandler(handler)
 	} else if version == 3 {
 		handler, ok := val.(event.UsageEventV3Handler)
@@ -90,6 +111,18 @@ func (self *Dispatcher) registerUsageEventHandler(val interface{}, config map[st
 			return errors.Errorf("type %v doesn't implement github.com/openziti/ziti/controller/event/UsageEventV3Handler interface.", reflect.TypeOf(val))
 		}
 
+		filter, err := self.parseEventFilter(event.UsageEventsNs, usageEventV3FilterSchema, config)
+		if err != nil {
+			return err
+		}
+
+		if filter != nil {
+			handler = &exprFilteredUsageV3EventHandler{
+				filter:  filter,
+				wrapped: handler,
+			}
+		}
+
 		if includeListVal, found := config["include"]; found {
 			includes := map[string]struct{}{}
 			if list, ok := includeListVal.([]interface{}); ok {
@@ -259,3 +292,45 @@ func (self *filteredUsageV3EventHandler) AcceptUsageEventV3(event *event.UsageEv
 	newEvent.Usage = usage
 	self.wrapped.AcceptUsageEventV3(&newEvent)
 }
+
+type exprFilteredUsageEventHandler struct {
+	filter  *eventFilter
+	wrapped event.UsageEventHandler
+}
+
+func (self *exprFilteredUsageEventHandler) IsWrapping(value event.UsageEventHandler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventHandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *exprFilteredUsageEventHandler) AcceptUsageEvent(event *event.UsageEvent) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptUsageEvent(event)
+	}
+}
+
+type exprFilteredUsageV3EventHandler struct {
+	filter  *eventFilter
+	wrapped event.UsageEventV3Handler
+}
+
+func (self *exprFilteredUsageV3EventHandler) IsWrapping(value event.UsageEventV3Handler) bool {
+	if self.wrapped == value {
+		return true
+	}
+	if w, ok := self.wrapped.(event.UsageEventV3HandlerWrapper); ok {
+		return w.IsWrapping(value)
+	}
+	return false
+}
+
+func (self *exprFilteredUsageV3EventHandler) AcceptUsageEventV3(event *event.UsageEventV3) {
+	if self.filter.matches(event) {
+		self.wrapped.AcceptUsageEventV3(event)
+	}
+}
@@ -0,0 +1,715 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/storage/zitiql"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+// An eventFilter is a ZitiQL expression which is evaluated against events before they are passed on to a handler,
+// and so before they are formatted. It's configured using the `filter` option on a subscription, for example:
+//
+//	subscriptions:
+//	  - type: fabric.circuits
+//	    filter: 'service.name = "payroll" and path_cost > 5000'
+//	  - type: edge.sessions
+//	    filter: 'identity has attribute "contractors"'
+type eventFilter struct {
+	expression string
+	query      ast.Query
+	schema     *eventFilterSchema
+	dispatcher *Dispatcher
+}
+
+func (self *eventFilter) matches(evt interface{}) bool {
+	ctx := &eventFilterContext{
+		filter:  self,
+		evt:     evt,
+		cursors: map[string]*stringSetCursor{},
+	}
+	return self.query.EvalBool(ctx)
+}
+
+func (self *eventFilter) String() string {
+	return self.expression
+}
+
+// parseEventFilter returns the filter configured for a subscription to the given namespace, or nil if the
+// subscription doesn't have a filter
+func (self *Dispatcher) parseEventFilter(ns string, schema *eventFilterSchema, config map[string]interface{}) (*eventFilter, error) {
+	val, found := config["filter"]
+	if !found {
+		return nil, nil
+	}
+
+	expression, ok := val.(string)
+	if !ok {
+		return nil, errors.Errorf("invalid type %v for %v filter configuration, must be a string", reflect.TypeOf(val), ns)
+	}
+
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return nil, nil
+	}
+
+	query, err := ast.Parse(schema, rewriteHasAttribute(expression))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %v filter '%v'", ns, expression)
+	}
+
+	return &eventFilter{
+		expression: expression,
+		query:      query,
+		schema:     schema,
+		dispatcher: self,
+	}, nil
+}
+
+// rewriteHasAttribute rewrites the `<entity> has attribute "<attr>"` shorthand, which ZitiQL doesn't support, to
+// `anyOf(<entity>.roleAttributes) = "<attr>"`. The expression is split into ZitiQL tokens, so string values which
+// happen to contain the shorthand are left alone. Everything else is passed through unchanged, for the parser to
+// validate
+func rewriteHasAttribute(expression string) string {
+	lexer := zitiql.NewZitiQlLexer(antlr.NewInputStream(expression))
+	lexer.RemoveErrorListeners()
+
+	var tokens []antlr.Token
+	for token := lexer.NextToken(); token.GetTokenType() != antlr.TokenEOF; token = lexer.NextToken() {
+		if token.GetTokenType() != zitiql.ZitiQlLexerWS {
+			tokens = append(tokens, token)
+		}
+	}
+
+	isType := func(idx int, tokenType int) bool {
+		return idx < len(tokens) && tokens[idx].GetTokenType() == tokenType
+	}
+
+	isWord := func(idx int, word string) bool {
+		return isType(idx, zitiql.ZitiQlLexerIDENTIFIER) && strings.EqualFold(tokens[idx].GetText(), word)
+	}
+
+	// the lexer works in runes, so token positions are rune offsets
+	runes := []rune(expression)
+	result := strings.Builder{}
+	last := 0
+	for idx := 0; idx < len(tokens); idx++ {
+		if isType(idx, zitiql.ZitiQlLexerIDENTIFIER) && isWord(idx+1, "has") && isWord(idx+2, "attribute") &&
+			isType(idx+3, zitiql.ZitiQlLexerSTRING) {
+			result.WriteString(string(runes[last:tokens[idx].GetStart()]))
+			result.WriteString(fmt.Sprintf("anyOf(%v.roleAttributes) = %v", tokens[idx].GetText(), tokens[idx+3].GetText()))
+			last = tokens[idx+3].GetStop() + 1
+			idx += 3
+		}
+	}
+	result.WriteString(string(runes[last:]))
+
+	return result.String()
+}
+
+type filterEntity struct {
+	id             string
+	name           string
+	roleAttributes []string
+}
+
+func (self *Dispatcher) loadFilterService(id string) *filterEntity {
+	if id == "" {
+		return nil
+	}
+	return self.filterEntities.get(db.EntityTypeServices, id, self.readFilterService)
+}
+
+func (self *Dispatcher) loadFilterIdentity(id string) *filterEntity {
+	if id == "" || self.stores == nil {
+		return nil
+	}
+	return self.filterEntities.get(db.EntityTypeIdentities, id, self.readFilterIdentity)
+}
+
+func (self *Dispatcher) readFilterService(id string) *filterEntity {
+	if self.stores != nil {
+		var result *filterEntity
+		err := self.network.GetDb().View(func(tx *bbolt.Tx) error {
+			service, found, err := self.stores.EdgeService.FindById(tx, id)
+			if found && err == nil {
+				result = &filterEntity{id: id, name: service.Name, roleAttributes: service.RoleAttributes}
+			}
+			return err
+		})
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("serviceId", id).Error("unable to load service for event filter")
+		}
+		if result != nil {
+			return result
+		}
+	}
+
+	if self.network != nil {
+		if service, _ := self.network.Services.Read(id); service != nil {
+			return &filterEntity{id: id, name: service.Name}
+		}
+	}
+
+	return nil
+}
+
+func (self *Dispatcher) readFilterIdentity(id string) *filterEntity {
+	var result *filterEntity
+	err := self.network.GetDb().View(func(tx *bbolt.Tx) error {
+		identity, found, err := self.stores.Identity.FindById(tx, id)
+		if found && err == nil {
+			result = &filterEntity{id: id, name: identity.Name, roleAttributes: identity.RoleAttributes}
+		}
+		return err
+	})
+	if err != nil {
+		pfxlog.Logger().WithError(err).WithField("identityId", id).Error("unable to load identity for event filter")
+	}
+	return result
+}
+
+// filterEntityCache holds the services and identities referenced by event filters, so that filters don't read from
+// the datastore for each event. Entries are evicted by store listeners, which only run once the change which updates
+// or deletes them is committed, so changes which are rolled back leave the cache alone.
+type filterEntityCache struct {
+	dispatcher *Dispatcher
+	subscribe  sync.Once
+	lock       sync.Mutex
+	entries    map[string]*filterEntity
+	version    uint64
+}
+
+func newFilterEntityCache(dispatcher *Dispatcher) *filterEntityCache {
+	return &filterEntityCache{
+		dispatcher: dispatcher,
+		entries:    map[string]*filterEntity{},
+	}
+}
+
+func (self *filterEntityCache) get(entityType string, id string, read func(id string) *filterEntity) *filterEntity {
+	self.subscribe.Do(self.subscribeToChanges)
+
+	key := entityType + ":" + id
+
+	self.lock.Lock()
+	entity, found := self.entries[key]
+	version := self.version
+	self.lock.Unlock()
+
+	if found {
+		return entity
+	}
+
+	entity = read(id)
+
+	self.lock.Lock()
+	// if an entity changed while this one was being read, the read may be stale, so don't cache it
+	if self.version == version {
+		self.entries[key] = entity
+	}
+	self.lock.Unlock()
+
+	return entity
+}
+
+func (self *filterEntityCache) subscribeToChanges() {
+	if self.dispatcher.network == nil {
+		return
+	}
+
+	evictService := func(id string) {
+		self.evict(db.EntityTypeServices, id)
+	}
+	evictIdentity := func(id string) {
+		self.evict(db.EntityTypeIdentities, id)
+	}
+
+	stores := self.dispatcher.network.GetStores()
+	stores.Service.AddEntityIdListener(evictService, boltz.EntityUpdated, boltz.EntityDeleted)
+	stores.EdgeService.AddEntityIdListener(evictService, boltz.EntityUpdated, boltz.EntityDeleted)
+	stores.Identity.AddEntityIdListener(evictIdentity, boltz.EntityUpdated, boltz.EntityDeleted)
+
+	// restoring a snapshot replaces the database without generating entity events
+	self.dispatcher.network.GetDb().AddRestoreListener(self.clear)
+}
+
+func (self *filterEntityCache) evict(entityType string, id string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	delete(self.entries, entityType+":"+id)
+	self.version++
+}
+
+func (self *filterEntityCache) clear() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.entries = map[string]*filterEntity{}
+	self.version++
+}
+
+// filterFieldF returns the value of a field for the given event. Values must be one of string, int64, float64,
+// bool, time.Time or []string, or nil if the field has no value
+type filterFieldF func(ctx *eventFilterContext, name string) interface{}
+
+type filterField struct {
+	nodeType ast.NodeType
+	set      bool
+	value    filterFieldF
+}
+
+// eventFilterSchema describes the symbols available when filtering events of a given type
+type eventFilterSchema struct {
+	fields   map[string]*filterField
+	prefixes map[string]*filterField
+}
+
+func newEventFilterSchema() *eventFilterSchema {
+	return &eventFilterSchema{
+		fields:   map[string]*filterField{},
+		prefixes: map[string]*filterField{},
+	}
+}
+
+func (self *eventFilterSchema) add(name string, nodeType ast.NodeType, value filterFieldF) *eventFilterSchema {
+	self.fields[name] = &filterField{nodeType: nodeType, value: value}
+	return self
+}
+
+func (self *eventFilterSchema) addSet(name string, value filterFieldF) *eventFilterSchema {
+	self.fields[name] = &filterField{nodeType: ast.NodeTypeString, set: true, value: value}
+	return self
+}
+
+// addPrefix adds a family of fields, such as tags.<key>, which can't be enumerated up front
+func (self *eventFilterSchema) addPrefix(prefix string, nodeType ast.NodeType, value filterFieldF) *eventFilterSchema {
+	self.prefixes[prefix] = &filterField{nodeType: nodeType, value: value}
+	return self
+}
+
+// addEntity adds the id, name and roleAttributes fields of a related service or identity
+func (self *eventFilterSchema) addEntity(prefix string, load func(ctx *eventFilterContext) *filterEntity) *eventFilterSchema {
+	self.add(prefix+".id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		if entity := ctx.loadEntity(prefix, load); entity != nil {
+			return entity.id
+		}
+		return nil
+	})
+	self.add(prefix+".name", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		if entity := ctx.loadEntity(prefix, load); entity != nil {
+			return entity.name
+		}
+		return nil
+	})
+	self.addSet(prefix+".roleAttributes", func(ctx *eventFilterContext, _ string) interface{} {
+		if entity := ctx.loadEntity(prefix, load); entity != nil {
+			return entity.roleAttributes
+		}
+		return nil
+	})
+	return self
+}
+
+func (self *eventFilterSchema) getField(name string) *filterField {
+	if field, found := self.fields[name]; found {
+		return field
+	}
+	for prefix, field := range self.prefixes {
+		if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+			return field
+		}
+	}
+	return nil
+}
+
+func (self *eventFilterSchema) GetSymbolType(name string) (ast.NodeType, bool) {
+	if field := self.getField(name); field != nil {
+		return field.nodeType, true
+	}
+	return 0, false
+}
+
+func (self *eventFilterSchema) GetSetSymbolTypes(string) ast.SymbolTypes {
+	return nil
+}
+
+func (self *eventFilterSchema) IsSet(name string) (bool, bool) {
+	if field := self.getField(name); field != nil {
+		return field.set, true
+	}
+	return false, false
+}
+
+// eventFilterContext evaluates a filter against a single event. Related entities are only loaded if the filter
+// references them, and are loaded at most once per event.
+type eventFilterContext struct {
+	filter   *eventFilter
+	evt      interface{}
+	entities map[string]*filterEntity
+	cursors  map[string]*stringSetCursor
+}
+
+func (self *eventFilterContext) loadEntity(key string, load func(ctx *eventFilterContext) *filterEntity) *filterEntity {
+	if entity, found := self.entities[key]; found {
+		return entity
+	}
+	if self.entities == nil {
+		self.entities = map[string]*filterEntity{}
+	}
+	entity := load(self)
+	self.entities[key] = entity
+	return entity
+}
+
+func (self *eventFilterContext) eval(name string) interface{} {
+	if cursor, found := self.cursors[name]; found && cursor.IsValid() {
+		return string(cursor.Current())
+	}
+	if field := self.filter.schema.getField(name); field != nil {
+		return field.value(self, name)
+	}
+	return nil
+}
+
+func (self *eventFilterContext) GetSymbolType(name string) (ast.NodeType, bool) {
+	return self.filter.schema.GetSymbolType(name)
+}
+
+func (self *eventFilterContext) GetSetSymbolTypes(name string) ast.SymbolTypes {
+	return self.filter.schema.GetSetSymbolTypes(name)
+}
+
+func (self *eventFilterContext) IsSet(name string) (bool, bool) {
+	return self.filter.schema.IsSet(name)
+}
+
+func (self *eventFilterContext) EvalBool(name string) *bool {
+	if v, ok := self.eval(name).(bool); ok {
+		return &v
+	}
+	return nil
+}
+
+func (self *eventFilterContext) EvalString(name string) *string {
+	switch v := self.eval(name).(type) {
+	case string:
+		return &v
+	case int64, float64, bool:
+		result := fmt.Sprintf("%v", v)
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterContext) EvalInt64(name string) *int64 {
+	if v, ok := self.eval(name).(int64); ok {
+		return &v
+	}
+	return nil
+}
+
+func (self *eventFilterContext) EvalFloat64(name string) *float64 {
+	switch v := self.eval(name).(type) {
+	case float64:
+		return &v
+	case int64:
+		result := float64(v)
+		return &result
+	}
+	return nil
+}
+
+func (self *eventFilterContext) EvalDatetime(name string) *time.Time {
+	if v, ok := self.eval(name).(time.Time); ok {
+		return &v
+	}
+	return nil
+}
+
+func (self *eventFilterContext) IsNil(name string) bool {
+	return self.eval(name) == nil
+}
+
+func (self *eventFilterContext) OpenSetCursor(name string) ast.SetCursor {
+	var values []string
+	if field := self.filter.schema.getField(name); field != nil {
+		values, _ = field.value(self, name).([]string)
+	}
+	cursor := &stringSetCursor{values: values}
+	self.cursors[name] = cursor
+	return cursor
+}
+
+func (self *eventFilterContext) OpenSetCursorForQuery(name string, _ ast.Query) ast.SetCursor {
+	return self.OpenSetCursor(name)
+}
+
+type stringSetCursor struct {
+	values []string
+	index  int
+}
+
+func (self *stringSetCursor) Next() {
+	self.index++
+}
+
+func (self *stringSetCursor) IsValid() bool {
+	return self.index < len(self.values)
+}
+
+func (self *stringSetCursor) Current() []byte {
+	return []byte(self.values[self.index])
+}
+
+func optionalInt64[T uint16 | uint32 | uint64 | int](v *T) interface{} {
+	if v == nil {
+		return nil
+	}
+	return int64(*v)
+}
+
+func optionalString(v *string) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+func optionalDuration(v *time.Duration) interface{} {
+	if v == nil {
+		return nil
+	}
+	return v.Nanoseconds()
+}
+
+func tagValue(tags map[string]string, prefix string, name string) interface{} {
+	if v, found := tags[strings.TrimPrefix(name, prefix)]; found {
+		return v
+	}
+	return nil
+}
+
+var circuitEventFilterSchema = newEventFilterSchema().
+	add("event_type", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return string(ctx.evt.(*event.CircuitEvent).EventType)
+	}).
+	add("timestamp", ast.NodeTypeDatetime, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).Timestamp
+	}).
+	add("circuit_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).CircuitId
+	}).
+	add("client_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).ClientId
+	}).
+	add("service_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).ServiceId
+	}).
+	add("terminator_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).TerminatorId
+	}).
+	add("instance_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).InstanceId
+	}).
+	add("creation_timespan", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return optionalDuration(ctx.evt.(*event.CircuitEvent).CreationTimespan)
+	}).
+	add("duration", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return optionalDuration(ctx.evt.(*event.CircuitEvent).Duration)
+	}).
+	add("link_count", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.CircuitEvent).LinkCount)
+	}).
+	add("path_cost", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return optionalInt64(ctx.evt.(*event.CircuitEvent).Cost)
+	}).
+	add("failure_cause", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return optionalString(ctx.evt.(*event.CircuitEvent).FailureCause)
+	}).
+	add("reroute_reason", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return optionalString(ctx.evt.(*event.CircuitEvent).RerouteReason)
+	}).
+	add("path.ingress_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).Path.IngressId
+	}).
+	add("path.egress_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).Path.EgressId
+	}).
+	addSet("path.nodes", func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).Path.Nodes
+	}).
+	addSet("path.links", func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.CircuitEvent).Path.Links
+	}).
+	addPrefix("tags.", ast.NodeTypeString, func(ctx *eventFilterContext, name string) interface{} {
+		return tagValue(ctx.evt.(*event.CircuitEvent).Tags, "tags.", name)
+	}).
+	addEntity("service", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterService(ctx.evt.(*event.CircuitEvent).ServiceId)
+	}).
+	addEntity("identity", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterIdentity(ctx.evt.(*event.CircuitEvent).Tags["clientId"])
+	})
+
+var sessionEventFilterSchema = newEventFilterSchema().
+	add("event_type", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.SessionEvent).EventType
+	}).
+	add("session_type", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.SessionEvent).SessionType
+	}).
+	add("timestamp", ast.NodeTypeDatetime, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.SessionEvent).Timestamp
+	}).
+	add("id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.SessionEvent).Id
+	}).
+	add("api_session_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.SessionEvent).ApiSessionId
+	}).
+	add("identity_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.SessionEvent).IdentityId
+	}).
+	add("service_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.SessionEvent).ServiceId
+	}).
+	addEntity("service", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterService(ctx.evt.(*event.SessionEvent).ServiceId)
+	}).
+	addEntity("identity", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterIdentity(ctx.evt.(*event.SessionEvent).IdentityId)
+	})
+
+var terminatorEventFilterSchema = newEventFilterSchema().
+	add("event_type", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return string(ctx.evt.(*event.TerminatorEvent).EventType)
+	}).
+	add("timestamp", ast.NodeTypeDatetime, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.TerminatorEvent).Timestamp
+	}).
+	add("service_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.TerminatorEvent).ServiceId
+	}).
+	add("terminator_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.TerminatorEvent).TerminatorId
+	}).
+	add("router_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.TerminatorEvent).RouterId
+	}).
+	add("host_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.TerminatorEvent).HostId
+	}).
+	add("router_online", ast.NodeTypeBool, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.TerminatorEvent).RouterOnline
+	}).
+	add("precedence", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.TerminatorEvent).Precedence
+	}).
+	add("static_cost", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.TerminatorEvent).StaticCost)
+	}).
+	add("dynamic_cost", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.TerminatorEvent).DynamicCost)
+	}).
+	add("total_terminators", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.TerminatorEvent).TotalTerminators)
+	}).
+	add("usable_default_terminators", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.TerminatorEvent).UsableDefaultTerminators)
+	}).
+	add("usable_required_terminators", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.TerminatorEvent).UsableRequiredTerminators)
+	}).
+	addEntity("service", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterService(ctx.evt.(*event.TerminatorEvent).ServiceId)
+	}).
+	addEntity("identity", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterIdentity(ctx.evt.(*event.TerminatorEvent).HostId)
+	})
+
+var usageEventFilterSchema = newEventFilterSchema().
+	add("event_type", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.UsageEvent).EventType
+	}).
+	add("source_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.UsageEvent).SourceId
+	}).
+	add("circuit_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.UsageEvent).CircuitId
+	}).
+	add("usage", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.UsageEvent).Usage)
+	}).
+	add("interval_start_utc", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.UsageEvent).IntervalStartUTC
+	}).
+	add("interval_length", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.UsageEvent).IntervalLength)
+	}).
+	addPrefix("tags.", ast.NodeTypeString, func(ctx *eventFilterContext, name string) interface{} {
+		return tagValue(ctx.evt.(*event.UsageEvent).Tags, "tags.", name)
+	}).
+	addEntity("service", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterService(ctx.evt.(*event.UsageEvent).Tags["serviceId"])
+	}).
+	addEntity("identity", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterIdentity(ctx.evt.(*event.UsageEvent).Tags["clientId"])
+	})
+
+var usageEventV3FilterSchema = newEventFilterSchema().
+	add("source_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.UsageEventV3).SourceId
+	}).
+	add("circuit_id", ast.NodeTypeString, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.UsageEventV3).CircuitId
+	}).
+	add("interval_start_utc", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return ctx.evt.(*event.UsageEventV3).IntervalStartUTC
+	}).
+	add("interval_length", ast.NodeTypeInt64, func(ctx *eventFilterContext, _ string) interface{} {
+		return int64(ctx.evt.(*event.UsageEventV3).IntervalLength)
+	}).
+	addPrefix("usage.", ast.NodeTypeInt64, func(ctx *eventFilterContext, name string) interface{} {
+		if v, found := ctx.evt.(*event.UsageEventV3).Usage[strings.TrimPrefix(name, "usage.")]; found {
+			return int64(v)
+		}
+		return nil
+	}).
+	addPrefix("tags.", ast.NodeTypeString, func(ctx *eventFilterContext, name string) interface{} {
+		return tagValue(ctx.evt.(*event.UsageEventV3).Tags, "tags.", name)
+	}).
+	addEntity("service", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterService(ctx.evt.(*event.UsageEventV3).Tags["serviceId"])
+	}).
+	addEntity("identity", func(ctx *eventFilterContext) *filterEntity {
+		return ctx.filter.dispatcher.loadFilterIdentity(ctx.evt.(*event.UsageEventV3).Tags["clientId"])
+	})
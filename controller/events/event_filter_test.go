@@ -0,0 +1,198 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+type testCircuitEventHandler struct {
+	events []*event.CircuitEvent
+}
+
+func (self *testCircuitEventHandler) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	self.events = append(self.events, evt)
+}
+
+func evalWithEntities(filter *eventFilter, evt interface{}, service, identity *filterEntity) bool {
+	ctx := &eventFilterContext{
+		filter:   filter,
+		evt:      evt,
+		cursors:  map[string]*stringSetCursor{},
+		entities: map[string]*filterEntity{"service": service, "identity": identity},
+	}
+	return filter.query.EvalBool(ctx)
+}
+
+func TestCircuitEventFilter(t *testing.T) {
+	req := require.New(t)
+	dispatcher := NewDispatcher(nil)
+
+	cost := uint32(6000)
+	evt := &event.CircuitEvent{
+		EventType: event.CircuitCreated,
+		CircuitId: "c1",
+		ServiceId: "s1",
+		Cost:      &cost,
+		Path:      event.CircuitPath{Nodes: []string{"r1", "r2"}},
+		Tags:      map[string]string{"clientId": "i1"},
+	}
+
+	payroll := &filterEntity{id: "s1", name: "payroll"}
+	contractor := &filterEntity{id: "i1", name: "bob", roleAttributes: []string{"contractors", "finance"}}
+	employee := &filterEntity{id: "i1", name: "alice", roleAttributes: []string{"employees"}}
+
+	filter, err := dispatcher.parseEventFilter(event.CircuitEventsNs, circuitEventFilterSchema, map[string]interface{}{
+		"filter": `service.name = "payroll" and path_cost > 5000`,
+	})
+	req.NoError(err)
+	req.True(evalWithEntities(filter, evt, payroll, nil))
+	req.False(evalWithEntities(filter, evt, &filterEntity{id: "s1", name: "hr"}, nil))
+
+	cost = 10
+	req.False(evalWithEntities(filter, evt, payroll, nil))
+
+	filter, err = dispatcher.parseEventFilter(event.CircuitEventsNs, circuitEventFilterSchema, map[string]interface{}{
+		"filter": `identity has attribute "contractors"`,
+	})
+	req.NoError(err)
+	req.True(evalWithEntities(filter, evt, payroll, contractor))
+	req.False(evalWithEntities(filter, evt, payroll, employee))
+	req.False(evalWithEntities(filter, evt, payroll, nil))
+
+	filter, err = dispatcher.parseEventFilter(event.CircuitEventsNs, circuitEventFilterSchema, map[string]interface{}{
+		"filter": `anyOf(path.nodes) = "r2" and tags.clientId = "i1" and event_type = "created" and isEmpty(path.links)`,
+	})
+	req.NoError(err)
+	req.True(filter.matches(evt))
+
+	filter, err = dispatcher.parseEventFilter(event.CircuitEventsNs, circuitEventFilterSchema, map[string]interface{}{
+		"filter": `failure_cause != null`,
+	})
+	req.NoError(err)
+	req.False(filter.matches(evt))
+
+	_, err = dispatcher.parseEventFilter(event.CircuitEventsNs, circuitEventFilterSchema, map[string]interface{}{
+		"filter": `bogus = 1`,
+	})
+	req.Error(err)
+
+	_, err = dispatcher.parseEventFilter(event.CircuitEventsNs, circuitEventFilterSchema, map[string]interface{}{
+		"filter": 5,
+	})
+	req.Error(err)
+}
+
+func TestRewriteHasAttribute(t *testing.T) {
+	req := require.New(t)
+
+	req.Equal(`anyOf(identity.roleAttributes) = "contractors"`, rewriteHasAttribute(`identity has attribute "contractors"`))
+	req.Equal(`service.name = "payroll" and anyOf(identity.roleAttributes) = "a b" or anyOf(service.roleAttributes) = "x\"y"`,
+		rewriteHasAttribute(`service.name = "payroll" and identity  HAS  attribute "a b" or service has attribute "x\"y"`))
+
+	// the shorthand is only recognized outside of string values
+	req.Equal(`service.name = "identity has attribute \"x\""`, rewriteHasAttribute(`service.name = "identity has attribute \"x\""`))
+
+	// anything else is left for the parser to reject
+	req.Equal(`identity has attribute contractors`, rewriteHasAttribute(`identity has attribute contractors`))
+	req.Equal(`ïdentity % has`, rewriteHasAttribute(`ïdentity % has`))
+}
+
+func TestUsageEventV3Filter(t *testing.T) {
+	req := require.New(t)
+	dispatcher := NewDispatcher(nil)
+
+	filter, err := dispatcher.parseEventFilter(event.UsageEventsNs, usageEventV3FilterSchema, map[string]interface{}{
+		"filter": `usage.ingress.rx > 1000 and tags.serviceId = "s1"`,
+	})
+	req.NoError(err)
+
+	req.True(filter.matches(&event.UsageEventV3{
+		Usage: map[string]uint64{"ingress.rx": 2000},
+		Tags:  map[string]string{"serviceId": "s1"},
+	}))
+
+	req.False(filter.matches(&event.UsageEventV3{
+		Usage: map[string]uint64{"egress.rx": 2000},
+		Tags:  map[string]string{"serviceId": "s1"},
+	}))
+}
+
+func TestFilteredSubscription(t *testing.T) {
+	req := require.New(t)
+
+	dispatcher := NewDispatcher(nil)
+	handler := &testCircuitEventHandler{}
+
+	err := dispatcher.ProcessSubscriptions(handler, []*event.Subscription{{
+		Type: event.CircuitEventsNs,
+		Options: map[string]interface{}{
+			"include": []interface{}{"created"},
+			"filter":  `link_count >= 2`,
+		},
+	}})
+	req.NoError(err)
+
+	for _, h := range dispatcher.circuitEventHandlers.Value() {
+		h.AcceptCircuitEvent(&event.CircuitEvent{EventType: event.CircuitCreated, LinkCount: 1})
+		h.AcceptCircuitEvent(&event.CircuitEvent{EventType: event.CircuitCreated, LinkCount: 2})
+		h.AcceptCircuitEvent(&event.CircuitEvent{EventType: event.CircuitDeleted, LinkCount: 2})
+	}
+	req.Len(handler.events, 1)
+	req.Equal(2, handler.events[0].LinkCount)
+
+	dispatcher.RemoveAllSubscriptions(handler)
+	req.Equal(0, len(dispatcher.circuitEventHandlers.Value()))
+}
+
+func TestFilterEntityCache(t *testing.T) {
+	req := require.New(t)
+
+	dispatcher := NewDispatcher(nil)
+	cache := dispatcher.filterEntities
+
+	reads := 0
+	read := func(id string) *filterEntity {
+		reads++
+		return &filterEntity{id: id, name: "name" + string(rune('0'+reads))}
+	}
+
+	entity := cache.get(db.EntityTypeIdentities, "i1", read)
+	req.Equal("name1", entity.name)
+	req.Equal("name1", cache.get(db.EntityTypeIdentities, "i1", read).name)
+	req.Equal(1, reads)
+
+	// entries are evicted when a change to the entity is committed
+	cache.evict(db.EntityTypeIdentities, "i1")
+	req.Equal("name2", cache.get(db.EntityTypeIdentities, "i1", read).name)
+	req.Equal(2, reads)
+
+	// changes to other entities don't affect the cache
+	cache.evict(db.EntityTypeServices, "i1")
+	cache.evict(db.EntityTypeIdentities, "i2")
+	req.Equal("name2", cache.get(db.EntityTypeIdentities, "i1", read).name)
+	req.Equal(2, reads)
+
+	// a snapshot restore clears everything
+	cache.clear()
+	req.Equal("name3", cache.get(db.EntityTypeIdentities, "i1", read).name)
+	req.Equal(3, reads)
+}
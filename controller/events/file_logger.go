@@ -22,14 +22,13 @@ import (
 	"github.com/pkg/errors"
 	"io"
 	"os"
-	"strings"
 )
 
 type fabricFormatterFactory struct{}
 
 func (f fabricFormatterFactory) NewLoggingHandler(format string, buffer int, out io.WriteCloser) (interface{}, error) {
-	if strings.EqualFold(format, "json") {
-		return NewJsonFormatter(buffer, NewWriterEventSink(out)), nil
+	if formatter := NewBuiltinFormatter(format, buffer, NewWriterEventSink(out)); formatter != nil {
+		return formatter, nil
 	}
 
 	return nil, errors.Errorf("invalid 'format' for event log output file: %v", format)
@@ -93,17 +92,18 @@ func NewFileEventLogger(formatterFactory LoggingHandlerFactory, stdout bool, con
 			return nil, errors.New("missing required 'path' config for events FileLogger handler")
 		}
 
-		output = &newlineWriter{
-			out: &lumberjack.Logger{
-				Filename:   filepath,
-				MaxSize:    maxsize,
-				MaxBackups: maxBackupFiles,
-			},
+		output = &lumberjack.Logger{
+			Filename:   filepath,
+			MaxSize:    maxsize,
+			MaxBackups: maxBackupFiles,
 		}
 	}
 
 	if value, found := config["format"]; found {
 		if format, ok := value.(string); ok {
+			if !stdout && !IsBinaryFormat(format) {
+				output = &newlineWriter{out: output}
+			}
 			return formatterFactory.NewLoggingHandler(format, bufferSize, output)
 		}
 		return nil, errors.New("invalid 'format' for event log output file")
@@ -28,6 +28,40 @@ import (
 	"sync/atomic"
 )
 
+const (
+	JsonFormat     = "json"
+	CefFormat      = "cef"
+	LeefFormat     = "leef"
+	LogfmtFormat   = "logfmt"
+	ProtobufFormat = "protobuf"
+)
+
+var BuiltinFormats = []string{JsonFormat, CefFormat, LeefFormat, LogfmtFormat, ProtobufFormat}
+
+// NewBuiltinFormatter returns a formatter for the given format, or nil if the format isn't one of the
+// built-in formats. Format names are case-insensitive.
+func NewBuiltinFormatter(format string, queueDepth int, sink event.FormattedEventSink) io.Closer {
+	switch strings.ToLower(format) {
+	case JsonFormat:
+		return NewJsonFormatter(queueDepth, sink)
+	case CefFormat:
+		return NewCefFormatter(queueDepth, sink)
+	case LeefFormat:
+		return NewLeefFormatter(queueDepth, sink)
+	case LogfmtFormat:
+		return NewLogfmtFormatter(queueDepth, sink)
+	case ProtobufFormat:
+		return NewProtobufFormatter(queueDepth, sink)
+	}
+	return nil
+}
+
+// IsBinaryFormat returns true if events in the given format are self-delimiting binary records, which
+// shouldn't be newline separated
+func IsBinaryFormat(format string) bool {
+	return strings.EqualFold(format, ProtobufFormat)
+}
+
 type LoggingHandlerFactory interface {
 	NewLoggingHandler(format string, buffer int, out io.WriteCloser) (interface{}, error)
 }
@@ -51,6 +85,14 @@ type FormatterEvent interface {
 	Format() ([]byte, error)
 }
 
+// A SourceAwareEventSink is a FormattedEventSink which also needs access to the unformatted event, for example
+// to route events based on their contents. Formatters will use AcceptFormattedEventWithSource in preference to
+// AcceptFormattedEvent if the sink implements this interface.
+type SourceAwareEventSink interface {
+	event.FormattedEventSink
+	AcceptFormattedEventWithSource(source FormatterEvent, formattedEvent []byte)
+}
+
 type BaseFormatter struct {
 	closed      atomic.Bool
 	closeNotify chan struct{}
@@ -64,6 +106,8 @@ func (f *BaseFormatter) Run() {
 		case evt := <-f.events:
 			if formattedEvent, err := evt.Format(); err != nil {
 				pfxlog.Logger().WithError(err).Errorf("failed to output event of type %v", reflect.TypeOf(evt))
+			} else if sink, ok := f.sink.(SourceAwareEventSink); ok {
+				sink.AcceptFormattedEventWithSource(evt, formattedEvent)
 			} else {
 				f.sink.AcceptFormattedEvent(evt.GetEventType(), formattedEvent)
 			}
@@ -215,6 +259,26 @@ func (event *JsonApiSessionEvent) Format() ([]byte, error) {
 	return MarshalJson(event)
 }
 
+type JsonAuthenticationEvent event.AuthenticationEvent
+
+func (event *JsonAuthenticationEvent) GetEventType() string {
+	return "authentication"
+}
+
+func (event *JsonAuthenticationEvent) Format() ([]byte, error) {
+	return MarshalJson(event)
+}
+
+type JsonAlertEvent event.AlertEvent
+
+func (event *JsonAlertEvent) GetEventType() string {
+	return "alert"
+}
+
+func (event *JsonAlertEvent) Format() ([]byte, error) {
+	return MarshalJson(event)
+}
+
 type JsonEntityCountEvent event.EntityCountEvent
 
 func (event *JsonEntityCountEvent) GetEventType() string {
@@ -285,6 +349,14 @@ func (formatter *JsonFormatter) AcceptApiSessionEvent(event *event.ApiSessionEve
 	formatter.AcceptLoggingEvent((*JsonApiSessionEvent)(event))
 }
 
+func (formatter *JsonFormatter) AcceptAuthenticationEvent(event *event.AuthenticationEvent) {
+	formatter.AcceptLoggingEvent((*JsonAuthenticationEvent)(event))
+}
+
+func (formatter *JsonFormatter) AcceptAlertEvent(event *event.AlertEvent) {
+	formatter.AcceptLoggingEvent((*JsonAlertEvent)(event))
+}
+
 func (formatter *JsonFormatter) AcceptSessionEvent(event *event.SessionEvent) {
 	formatter.AcceptLoggingEvent((*JsonSessionEvent)(event))
 }
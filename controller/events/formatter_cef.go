@@ -0,0 +1,193 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/openziti/ziti/common/version"
+	"github.com/openziti/ziti/controller/event"
+)
+
+const (
+	siemVendor  = "NetFoundry"
+	siemProduct = "Ziti"
+)
+
+type siemFieldMapping struct {
+	key   string
+	label string
+	time  bool
+}
+
+// cefFieldMappings maps event json fields to ArcSight CEF extension keys. Fields without a mapping are
+// output using the camel-cased field name, e.g. path.nodes becomes pathNodes. If more than one field maps
+// to the same key, the first one, in event field order, wins and the others use their camel-cased names.
+var cefFieldMappings = map[string]siemFieldMapping{
+	"namespace":          {key: "cat"},
+	"event_type":         {key: "act"},
+	"eventType":          {key: "act"},
+	"timestamp":          {key: "rt", time: true},
+	"interval_start_utc": {key: "start", time: true},
+	"ip_address":         {key: "src"},
+	"remote_address":     {key: "src"},
+	"identity_id":        {key: "suid"},
+	"client_id":          {key: "suid"},
+	"id":                 {key: "externalId"},
+	"failure_cause":      {key: "reason"},
+	"failure_reason":     {key: "reason"},
+	"service_id":         {key: "cs1", label: "serviceId"},
+	"terminator_id":      {key: "cs2", label: "terminatorId"},
+	"circuit_id":         {key: "cs3", label: "circuitId"},
+	"api_session_id":     {key: "cs4", label: "apiSessionId"},
+	"router_id":          {key: "cs5", label: "routerId"},
+	"source_id":          {key: "cs6", label: "sourceId"},
+}
+
+// NewCefFormatter returns a formatter which outputs events in ArcSight Common Event Format
+func NewCefFormatter(queueDepth int, sink event.FormattedEventSink) *FieldFormatter {
+	return NewFieldFormatter(queueDepth, sink, FormatCef)
+}
+
+// FormatCef formats an event as CEF. The header signature id is <namespace>.<event type>, the name is
+// the formatter event type followed by the event's event type, e.g. `circuit created`.
+func FormatCef(eventType string, evt interface{}) ([]byte, error) {
+	fields, err := eventFields(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureId, name := siemEventIds(eventType, fields)
+
+	buf := &strings.Builder{}
+	buf.WriteString("CEF:0|")
+	buf.WriteString(cefHeaderEscape(siemVendor))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscape(siemProduct))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscape(version.GetVersion()))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscape(signatureId))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscape(name))
+	buf.WriteByte('|')
+	buf.WriteString(strconv.Itoa(siemSeverity(fields)))
+	buf.WriteByte('|')
+
+	usedKeys := map[string]bool{}
+	first := true
+	writeExt := func(key, value string) {
+		if !first {
+			buf.WriteByte(' ')
+		}
+		first = false
+		buf.WriteString(key)
+		buf.WriteByte('=')
+		buf.WriteString(cefExtensionEscape(value))
+	}
+
+	for _, field := range fields {
+		key := siemCamelCase(field.name)
+		value := field.value
+		if mapping, found := cefFieldMappings[field.name]; found && !usedKeys[mapping.key] {
+			key = mapping.key
+			if mapping.time {
+				value = siemEpochMillis(field.name, value)
+			}
+			if mapping.label != "" {
+				writeExt(mapping.key+"Label", mapping.label)
+			}
+		}
+		usedKeys[key] = true
+		writeExt(key, value)
+	}
+
+	return []byte(buf.String()), nil
+}
+
+func siemEventIds(eventType string, fields []eventField) (string, string) {
+	namespace := getEventFieldValue(fields, "namespace")
+	subType := getEventFieldValue(fields, "event_type", "eventType")
+
+	signatureId := namespace
+	name := eventType
+	if subType != "" {
+		if signatureId != "" {
+			signatureId += "."
+		}
+		signatureId += subType
+		name += " " + subType
+	}
+	if signatureId == "" {
+		signatureId = eventType
+	}
+	return signatureId, name
+}
+
+// siemSeverity returns 7 for events which indicate a failure or a firing alert, 3 otherwise
+func siemSeverity(fields []eventField) int {
+	subType := strings.ToLower(getEventFieldValue(fields, "event_type", "eventType"))
+	if strings.Contains(subType, "fail") || subType == event.AlertEventTypeFired || getEventFieldValue(fields, "failure_cause", "failure_reason") != "" {
+		return 7
+	}
+	return 3
+}
+
+func siemEpochMillis(name, value string) string {
+	if name == "interval_start_utc" {
+		if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return strconv.FormatInt(secs*1000, 10)
+		}
+		return value
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	}
+	return value
+}
+
+func siemCamelCase(name string) string {
+	buf := &strings.Builder{}
+	upperNext := false
+	for _, c := range name {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			upperNext = buf.Len() > 0
+			continue
+		}
+		if upperNext {
+			c = unicode.ToUpper(c)
+			upperNext = false
+		}
+		buf.WriteRune(c)
+	}
+	return buf.String()
+}
+
+func cefHeaderEscape(val string) string {
+	val = strings.ReplaceAll(val, `\`, `\\`)
+	return strings.ReplaceAll(val, `|`, `\|`)
+}
+
+func cefExtensionEscape(val string) string {
+	val = strings.ReplaceAll(val, `\`, `\\`)
+	val = strings.ReplaceAll(val, `=`, `\=`)
+	val = strings.ReplaceAll(val, "\r", `\r`)
+	return strings.ReplaceAll(val, "\n", `\n`)
+}
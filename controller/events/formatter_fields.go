@@ -0,0 +1,226 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openziti/ziti/controller/event"
+)
+
+// NewFieldFormatter returns a formatter which passes events, along with their type, to the given function
+// for formatting. It's used to implement formats other than json.
+func NewFieldFormatter(queueDepth int, sink event.FormattedEventSink, formatF FieldFormatF) *FieldFormatter {
+	result := &FieldFormatter{
+		BaseFormatter: BaseFormatter{
+			events:      make(chan FormatterEvent, queueDepth),
+			closeNotify: make(chan struct{}),
+			sink:        sink,
+		},
+		formatF: formatF,
+	}
+	go result.Run()
+	return result
+}
+
+// FieldFormatF formats the given event. The event type is the same as returned by the json formatter
+// GetEventType implementations, e.g. circuit, usage.v3, apiSession
+type FieldFormatF func(eventType string, evt interface{}) ([]byte, error)
+
+type FieldFormatter struct {
+	BaseFormatter
+	formatF FieldFormatF
+}
+
+func (formatter *FieldFormatter) accept(eventType string, evt interface{}) {
+	formatter.AcceptLoggingEvent(&fieldFormatterEvent{
+		eventType: eventType,
+		event:     evt,
+		formatF:   formatter.formatF,
+	})
+}
+
+func (formatter *FieldFormatter) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	formatter.accept("circuit", evt)
+}
+
+func (formatter *FieldFormatter) AcceptLinkEvent(evt *event.LinkEvent) {
+	formatter.accept("link", evt)
+}
+
+func (formatter *FieldFormatter) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	formatter.accept("metrics", evt)
+}
+
+func (formatter *FieldFormatter) AcceptServiceEvent(evt *event.ServiceEvent) {
+	formatter.accept("service", evt)
+}
+
+func (formatter *FieldFormatter) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	formatter.accept("terminator", evt)
+}
+
+func (formatter *FieldFormatter) AcceptRouterEvent(evt *event.RouterEvent) {
+	formatter.accept("router", evt)
+}
+
+func (formatter *FieldFormatter) AcceptUsageEvent(evt *event.UsageEvent) {
+	formatter.accept("usage", evt)
+}
+
+func (formatter *FieldFormatter) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	formatter.accept("usage.v3", evt)
+}
+
+func (formatter *FieldFormatter) AcceptClusterEvent(evt *event.ClusterEvent) {
+	formatter.accept("cluster", evt)
+}
+
+func (formatter *FieldFormatter) AcceptEntityChangeEvent(evt *event.EntityChangeEvent) {
+	formatter.accept("entity.change", evt)
+}
+
+func (formatter *FieldFormatter) AcceptApiSessionEvent(evt *event.ApiSessionEvent) {
+	formatter.accept("apiSession", evt)
+}
+
+func (formatter *FieldFormatter) AcceptAuthenticationEvent(evt *event.AuthenticationEvent) {
+	formatter.accept("authentication", evt)
+}
+
+func (formatter *FieldFormatter) AcceptAlertEvent(evt *event.AlertEvent) {
+	formatter.accept("alert", evt)
+}
+
+func (formatter *FieldFormatter) AcceptSessionEvent(evt *event.SessionEvent) {
+	formatter.accept("session", evt)
+}
+
+func (formatter *FieldFormatter) AcceptEntityCountEvent(evt *event.EntityCountEvent) {
+	formatter.accept("entityCount", evt)
+}
+
+type fieldFormatterEvent struct {
+	eventType string
+	event     interface{}
+	formatF   FieldFormatF
+}
+
+func (self *fieldFormatterEvent) GetEventType() string {
+	return self.eventType
+}
+
+func (self *fieldFormatterEvent) Format() ([]byte, error) {
+	return self.formatF(self.eventType, self.event)
+}
+
+// MarshalJSON allows sinks which route on event contents to inspect the source event, regardless of output format
+func (self *fieldFormatterEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(self.event)
+}
+
+type eventField struct {
+	name  string
+	value string
+}
+
+// eventFields flattens an event into a list of name/value pairs. Field names are the event json field names.
+// Nested objects are flattened using dots, so circuit path nodes become path.nodes, and lists are comma
+// separated. The namespace, event type and timestamp come first, the remaining fields are sorted by name.
+func eventFields(evt interface{}) ([]eventField, error) {
+	buf, err := json.Marshal(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
+
+	var m map[string]interface{}
+	if err = decoder.Decode(&m); err != nil {
+		return nil, err
+	}
+
+	flattened := map[string]string{}
+	flattenEventField("", m, flattened)
+
+	var result []eventField
+	for _, name := range []string{"namespace", "event_type", "eventType", "timestamp"} {
+		if val, found := flattened[name]; found {
+			result = append(result, eventField{name: name, value: val})
+			delete(flattened, name)
+		}
+	}
+
+	var names []string
+	for k := range flattened {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		result = append(result, eventField{name: name, value: flattened[name]})
+	}
+
+	return result, nil
+}
+
+func flattenEventField(prefix string, val interface{}, result map[string]string) {
+	switch v := val.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		for k, child := range v {
+			name := k
+			if prefix != "" {
+				name = prefix + "." + k
+			}
+			flattenEventField(name, child, result)
+		}
+	case []interface{}:
+		var values []string
+		for _, child := range v {
+			switch c := child.(type) {
+			case map[string]interface{}, []interface{}:
+				buf, _ := json.Marshal(c)
+				values = append(values, string(buf))
+			default:
+				values = append(values, fmt.Sprintf("%v", c))
+			}
+		}
+		if len(values) > 0 {
+			result[prefix] = strings.Join(values, ",")
+		}
+	default:
+		result[prefix] = fmt.Sprintf("%v", v)
+	}
+}
+
+func getEventFieldValue(fields []eventField, names ...string) string {
+	for _, name := range names {
+		for _, field := range fields {
+			if field.name == name {
+				return field.value
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openziti/ziti/common/version"
+	"github.com/openziti/ziti/controller/event"
+)
+
+const leefTimeFormat = "yyyy-MM-dd'T'HH:mm:ss.SSSXXX"
+
+// leefFieldMappings maps event json fields to QRadar LEEF predefined attributes. Fields without a mapping
+// are output using their flattened json names
+var leefFieldMappings = map[string]siemFieldMapping{
+	"namespace":      {key: "cat"},
+	"timestamp":      {key: "devTime"},
+	"ip_address":     {key: "src"},
+	"remote_address": {key: "src"},
+	"identity_id":    {key: "usrName"},
+	"client_id":      {key: "usrName"},
+}
+
+// NewLeefFormatter returns a formatter which outputs events in QRadar Log Event Extended Format 1.0
+func NewLeefFormatter(queueDepth int, sink event.FormattedEventSink) *FieldFormatter {
+	return NewFieldFormatter(queueDepth, sink, FormatLeef)
+}
+
+// FormatLeef formats an event as LEEF 1.0, with tab separated attributes. The event id is
+// <namespace>.<event type>
+func FormatLeef(eventType string, evt interface{}) ([]byte, error) {
+	fields, err := eventFields(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	eventId, _ := siemEventIds(eventType, fields)
+
+	buf := &strings.Builder{}
+	buf.WriteString("LEEF:1.0|")
+	buf.WriteString(cefHeaderEscape(siemVendor))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscape(siemProduct))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscape(version.GetVersion()))
+	buf.WriteByte('|')
+	buf.WriteString(cefHeaderEscape(eventId))
+	buf.WriteByte('|')
+
+	buf.WriteString("sev=")
+	buf.WriteString(strconv.Itoa(siemSeverity(fields)))
+
+	writeAttr := func(key, value string) {
+		buf.WriteByte('\t')
+		buf.WriteString(key)
+		buf.WriteByte('=')
+		buf.WriteString(leefEscape(value))
+	}
+
+	usedKeys := map[string]bool{}
+	for _, field := range fields {
+		key := field.name
+		if mapping, found := leefFieldMappings[field.name]; found && !usedKeys[mapping.key] {
+			key = mapping.key
+		}
+		usedKeys[key] = true
+		if key == "devTime" {
+			writeAttr("devTimeFormat", leefTimeFormat)
+			writeAttr(key, leefTime(field.value))
+		} else {
+			writeAttr(key, field.value)
+		}
+	}
+
+	return []byte(buf.String()), nil
+}
+
+func leefTime(val string) string {
+	if t, err := time.Parse(time.RFC3339Nano, val); err == nil {
+		return t.Format("2006-01-02T15:04:05.000Z07:00")
+	}
+	return val
+}
+
+func leefEscape(val string) string {
+	return strings.NewReplacer("\t", " ", "\r", " ", "\n", " ").Replace(val)
+}
@@ -0,0 +1,55 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/openziti/ziti/controller/event"
+)
+
+// NewLogfmtFormatter returns a formatter which outputs events as logfmt key=value pairs, using the
+// flattened json field names as keys
+func NewLogfmtFormatter(queueDepth int, sink event.FormattedEventSink) *FieldFormatter {
+	return NewFieldFormatter(queueDepth, sink, FormatLogfmt)
+}
+
+func FormatLogfmt(_ string, evt interface{}) ([]byte, error) {
+	fields, err := eventFields(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &strings.Builder{}
+	for idx, field := range fields {
+		if idx > 0 {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(field.name)
+		buf.WriteByte('=')
+		buf.WriteString(logfmtValue(field.value))
+	}
+	return []byte(buf.String()), nil
+}
+
+func logfmtValue(val string) string {
+	if val == "" || strings.ContainsAny(val, " =\"\t\r\n\\") {
+		return strconv.Quote(val)
+	}
+	return val
+}
@@ -0,0 +1,309 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/openziti/ziti/common/pb/event_pb"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewProtobufFormatter returns a formatter which outputs events as length-delimited event_pb.Event messages
+func NewProtobufFormatter(queueDepth int, sink event.FormattedEventSink) *FieldFormatter {
+	return NewFieldFormatter(queueDepth, sink, FormatProtobuf)
+}
+
+func FormatProtobuf(_ string, evt interface{}) ([]byte, error) {
+	msg, err := toEventPb(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err = protodelim.MarshalTo(buf, msg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func toEventPb(evt interface{}) (*event_pb.Event, error) {
+	switch e := evt.(type) {
+	case *event.CircuitEvent:
+		result := &event_pb.CircuitEvent{
+			Namespace:     e.Namespace,
+			Version:       e.Version,
+			EventType:     string(e.EventType),
+			CircuitId:     e.CircuitId,
+			Timestamp:     timestamppb.New(e.Timestamp),
+			ClientId:      e.ClientId,
+			ServiceId:     e.ServiceId,
+			TerminatorId:  e.TerminatorId,
+			InstanceId:    e.InstanceId,
+			Path:          toCircuitPathPb(&e.Path),
+			LinkCount:     int32(e.LinkCount),
+			PathCost:      e.Cost,
+			FailureCause:  e.FailureCause,
+			Tags:          e.Tags,
+			RerouteReason: e.RerouteReason,
+		}
+		result.CreationTimespanNanos = durationNanos(e.CreationTimespan)
+		result.DurationNanos = durationNanos(e.Duration)
+		if e.OldPath != nil {
+			result.OldPath = toCircuitPathPb(e.OldPath)
+		}
+		return &event_pb.Event{Event: &event_pb.Event_Circuit{Circuit: result}}, nil
+
+	case *event.UsageEvent:
+		return &event_pb.Event{Event: &event_pb.Event_Usage{Usage: &event_pb.UsageEvent{
+			Namespace:        e.Namespace,
+			Version:          e.Version,
+			EventType:        e.EventType,
+			SourceId:         e.SourceId,
+			CircuitId:        e.CircuitId,
+			Usage:            e.Usage,
+			IntervalStartUTC: e.IntervalStartUTC,
+			IntervalLength:   e.IntervalLength,
+			Tags:             e.Tags,
+		}}}, nil
+
+	case *event.UsageEventV3:
+		return &event_pb.Event{Event: &event_pb.Event_UsageV3{UsageV3: &event_pb.UsageEventV3{
+			Namespace:        e.Namespace,
+			Version:          e.Version,
+			SourceId:         e.SourceId,
+			CircuitId:        e.CircuitId,
+			Usage:            e.Usage,
+			IntervalStartUTC: e.IntervalStartUTC,
+			IntervalLength:   e.IntervalLength,
+			Tags:             e.Tags,
+		}}}, nil
+
+	case *event.ApiSessionEvent:
+		return &event_pb.Event{Event: &event_pb.Event_ApiSession{ApiSession: &event_pb.ApiSessionEvent{
+			Namespace:  e.Namespace,
+			EventType:  e.EventType,
+			Id:         e.Id,
+			Timestamp:  timestamppb.New(e.Timestamp),
+			Token:      e.Token,
+			IdentityId: e.IdentityId,
+			IpAddress:  e.IpAddress,
+		}}}, nil
+
+	case *event.AuthenticationEvent:
+		return &event_pb.Event{Event: &event_pb.Event_Authentication{Authentication: &event_pb.AuthenticationEvent{
+			Namespace:       e.Namespace,
+			EventType:       e.EventType,
+			Timestamp:       timestamppb.New(e.Timestamp),
+			AuthMethod:      e.AuthMethod,
+			IdentityId:      e.IdentityId,
+			AuthenticatorId: e.AuthenticatorId,
+			AuthPolicyId:    e.AuthPolicyId,
+			RemoteAddress:   e.RemoteAddress,
+			FailureReason:   e.FailureReason,
+		}}}, nil
+
+	case *event.SessionEvent:
+		return &event_pb.Event{Event: &event_pb.Event_Session{Session: &event_pb.SessionEvent{
+			Namespace:    e.Namespace,
+			EventType:    e.EventType,
+			SessionType:  e.SessionType,
+			Id:           e.Id,
+			Timestamp:    timestamppb.New(e.Timestamp),
+			Token:        e.Token,
+			ApiSessionId: e.ApiSessionId,
+			IdentityId:   e.IdentityId,
+			ServiceId:    e.ServiceId,
+		}}}, nil
+
+	case *event.RouterEvent:
+		return &event_pb.Event{Event: &event_pb.Event_Router{Router: &event_pb.RouterEvent{
+			Namespace:    e.Namespace,
+			EventType:    string(e.EventType),
+			Timestamp:    timestamppb.New(e.Timestamp),
+			RouterId:     e.RouterId,
+			RouterOnline: e.RouterOnline,
+		}}}, nil
+
+	case *event.LinkEvent:
+		result := &event_pb.LinkEvent{
+			Namespace:   e.Namespace,
+			EventType:   string(e.EventType),
+			Timestamp:   timestamppb.New(e.Timestamp),
+			LinkId:      e.LinkId,
+			SrcRouterId: e.SrcRouterId,
+			DstRouterId: e.DstRouterId,
+			Protocol:    e.Protocol,
+			DialAddress: e.DialAddress,
+			Cost:        e.Cost,
+		}
+		for _, conn := range e.Connections {
+			result.Connections = append(result.Connections, &event_pb.LinkConnection{
+				Id:         conn.Id,
+				LocalAddr:  conn.LocalAddr,
+				RemoteAddr: conn.RemoteAddr,
+			})
+		}
+		return &event_pb.Event{Event: &event_pb.Event_Link{Link: result}}, nil
+
+	case *event.TerminatorEvent:
+		return &event_pb.Event{Event: &event_pb.Event_Terminator{Terminator: &event_pb.TerminatorEvent{
+			Namespace:                 e.Namespace,
+			EventType:                 string(e.EventType),
+			Timestamp:                 timestamppb.New(e.Timestamp),
+			ServiceId:                 e.ServiceId,
+			TerminatorId:              e.TerminatorId,
+			RouterId:                  e.RouterId,
+			HostId:                    e.HostId,
+			RouterOnline:              e.RouterOnline,
+			Precedence:                e.Precedence,
+			StaticCost:                uint32(e.StaticCost),
+			DynamicCost:               uint32(e.DynamicCost),
+			TotalTerminators:          int32(e.TotalTerminators),
+			UsableDefaultTerminators:  int32(e.UsableDefaultTerminators),
+			UsableRequiredTerminators: int32(e.UsableRequiredTerminators),
+		}}}, nil
+
+	case *event.ServiceEvent:
+		return &event_pb.Event{Event: &event_pb.Event_Service{Service: &event_pb.ServiceEvent{
+			Namespace:        e.Namespace,
+			Version:          e.Version,
+			EventType:        e.EventType,
+			ServiceId:        e.ServiceId,
+			TerminatorId:     e.TerminatorId,
+			Count:            e.Count,
+			IntervalStartUTC: e.IntervalStartUTC,
+			IntervalLength:   e.IntervalLength,
+		}}}, nil
+
+	case *event.MetricsEvent:
+		result := &event_pb.MetricsEvent{
+			MetricType:     e.MetricType,
+			Namespace:      e.Namespace,
+			SourceId:       e.SourceAppId,
+			SourceEntityId: e.SourceEntityId,
+			Version:        e.Version,
+			Timestamp:      timestamppb.New(e.Timestamp),
+			Metric:         e.Metric,
+			Metrics:        map[string]float64{},
+			Tags:           e.Tags,
+			SourceEventId:  e.SourceEventId,
+		}
+		for k, v := range e.Metrics {
+			if f, ok := promValue(v); ok {
+				result.Metrics[k] = f
+			}
+		}
+		return &event_pb.Event{Event: &event_pb.Event_Metrics{Metrics: result}}, nil
+
+	case *event.EntityChangeEvent:
+		result := &event_pb.EntityChangeEvent{
+			Namespace:     e.Namespace,
+			EventId:       e.EventId,
+			EventType:     string(e.EventType),
+			Timestamp:     timestamppb.New(e.Timestamp),
+			EntityType:    e.EntityType,
+			IsParentEvent: e.IsParentEvent,
+		}
+		var err error
+		if result.MetadataJson, err = optionalJson(e.Metadata); err != nil {
+			return nil, err
+		}
+		if result.InitialStateJson, err = optionalJson(e.InitialState); err != nil {
+			return nil, err
+		}
+		if result.FinalStateJson, err = optionalJson(e.FinalState); err != nil {
+			return nil, err
+		}
+		return &event_pb.Event{Event: &event_pb.Event_EntityChange{EntityChange: result}}, nil
+
+	case *event.EntityCountEvent:
+		return &event_pb.Event{Event: &event_pb.Event_EntityCount{EntityCount: &event_pb.EntityCountEvent{
+			Namespace: e.Namespace,
+			Timestamp: timestamppb.New(e.Timestamp),
+			Counts:    e.Counts,
+			Error:     e.Error,
+		}}}, nil
+
+	case *event.ClusterEvent:
+		result := &event_pb.ClusterEvent{
+			Namespace: e.Namespace,
+			EventType: string(e.EventType),
+			Timestamp: timestamppb.New(e.Timestamp),
+			Index:     e.Index,
+		}
+		for _, peer := range e.Peers {
+			result.Peers = append(result.Peers, &event_pb.ClusterPeer{
+				Id:      peer.Id,
+				Addr:    peer.Addr,
+				Version: peer.Version,
+			})
+		}
+		return &event_pb.Event{Event: &event_pb.Event_Cluster{Cluster: result}}, nil
+
+	case *event.AlertEvent:
+		return &event_pb.Event{Event: &event_pb.Event_Alert{Alert: &event_pb.AlertEvent{
+			Namespace:  e.Namespace,
+			EventType:  e.EventType,
+			Timestamp:  timestamppb.New(e.Timestamp),
+			RuleId:     e.RuleId,
+			RuleType:   e.RuleType,
+			EntityType: e.EntityType,
+			EntityId:   e.EntityId,
+			Value:      e.Value,
+			Threshold:  e.Threshold,
+			FiredAt:    timestamppb.New(e.FiredAt),
+			Message:    e.Message,
+		}}}, nil
+	}
+
+	return nil, errors.Errorf("unsupported event type %v for protobuf formatter", reflect.TypeOf(evt))
+}
+
+func toCircuitPathPb(p *event.CircuitPath) *event_pb.CircuitPath {
+	return &event_pb.CircuitPath{
+		Nodes:                p.Nodes,
+		Links:                p.Links,
+		IngressId:            p.IngressId,
+		EgressId:             p.EgressId,
+		InitiatorLocalAddr:   p.InitiatorLocalAddr,
+		InitiatorRemoteAddr:  p.InitiatorRemoteAddr,
+		TerminatorLocalAddr:  p.TerminatorLocalAddr,
+		TerminatorRemoteAddr: p.TerminatorRemoteAddr,
+	}
+}
+
+func durationNanos(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+	result := d.Nanoseconds()
+	return &result
+}
+
+func optionalJson(v interface{}) ([]byte, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Map && reflect.ValueOf(v).Len() == 0) {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
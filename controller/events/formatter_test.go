@@ -0,0 +1,160 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/common/pb/event_pb"
+	"github.com/openziti/ziti/common/version"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+func newTestCircuitEvent() *event.CircuitEvent {
+	cost := uint32(42)
+	cause := "NO_TERMINATORS"
+	return &event.CircuitEvent{
+		Namespace:    event.CircuitEventsNs,
+		Version:      2,
+		EventType:    event.CircuitFailed,
+		CircuitId:    "circuit1",
+		Timestamp:    time.Date(2024, 5, 1, 10, 20, 30, 123456789, time.UTC),
+		ClientId:     "identity1",
+		ServiceId:    "service1",
+		Path:         event.CircuitPath{Nodes: []string{"r1", "r2"}, Links: []string{"l1"}},
+		LinkCount:    1,
+		Cost:         &cost,
+		FailureCause: &cause,
+		Tags:         map[string]string{"clientId": "identity1"},
+	}
+}
+
+func Test_FormatCef(t *testing.T) {
+	req := require.New(t)
+
+	buf, err := FormatCef("circuit", newTestCircuitEvent())
+	req.NoError(err)
+
+	output := string(buf)
+	req.True(strings.HasPrefix(output, "CEF:0|NetFoundry|Ziti|"+version.GetVersion()+"|fabric.circuits.failed|circuit failed|7|"), output)
+	req.Contains(output, "cat=fabric.circuits act=failed rt=1714558830123 ")
+	req.Contains(output, " cs3Label=circuitId cs3=circuit1 ")
+	req.Contains(output, " suid=identity1 ")
+	req.Contains(output, " reason=NO_TERMINATORS ")
+	req.Contains(output, " pathNodes=r1,r2 ")
+	req.Contains(output, " tagsClientId=identity1")
+}
+
+func Test_FormatLeef(t *testing.T) {
+	req := require.New(t)
+
+	buf, err := FormatLeef("apiSession", &event.ApiSessionEvent{
+		Namespace:  event.ApiSessionEventNS,
+		EventType:  "created",
+		Id:         "as1",
+		Timestamp:  time.Date(2024, 5, 1, 10, 20, 30, 0, time.UTC),
+		IdentityId: "identity1",
+		IpAddress:  "10.0.0.1",
+	})
+	req.NoError(err)
+
+	output := string(buf)
+	req.True(strings.HasPrefix(output, "LEEF:1.0|NetFoundry|Ziti|"+version.GetVersion()+"|edge.apiSessions.created|sev=3\t"), output)
+	req.Contains(output, "\tcat=edge.apiSessions\t")
+	req.Contains(output, "\tdevTimeFormat="+leefTimeFormat+"\tdevTime=2024-05-01T10:20:30.000Z\t")
+	req.Contains(output, "\tsrc=10.0.0.1")
+	req.Contains(output, "\tusrName=identity1")
+}
+
+func Test_FormatLogfmt(t *testing.T) {
+	req := require.New(t)
+
+	buf, err := FormatLogfmt("usage.v3", &event.UsageEventV3{
+		Namespace:        event.UsageEventsNs,
+		Version:          3,
+		SourceId:         "router1",
+		CircuitId:        "circuit1",
+		Usage:            map[string]uint64{"ingress.rx": 100},
+		IntervalStartUTC: 1714558800,
+		IntervalLength:   60,
+		Tags:             map[string]string{"serviceId": "service 1"},
+	})
+	req.NoError(err)
+	req.Equal(`namespace=fabric.usage circuit_id=circuit1 interval_length=60 interval_start_utc=1714558800 `+
+		`source_id=router1 tags.serviceId="service 1" usage.ingress.rx=100 version=3`, string(buf))
+}
+
+func Test_FormatProtobuf(t *testing.T) {
+	req := require.New(t)
+
+	first, err := FormatProtobuf("circuit", newTestCircuitEvent())
+	req.NoError(err)
+
+	second, err := FormatProtobuf("router", &event.RouterEvent{
+		Namespace:    event.RouterEventsNs,
+		EventType:    event.RouterOnline,
+		RouterId:     "router1",
+		RouterOnline: true,
+	})
+	req.NoError(err)
+
+	reader := bufio.NewReader(bytes.NewReader(append(first, second...)))
+
+	msg := &event_pb.Event{}
+	req.NoError(protodelim.UnmarshalFrom(reader, msg))
+	circuit := msg.GetCircuit()
+	req.NotNil(circuit)
+	req.Equal("circuit1", circuit.CircuitId)
+	req.Equal(uint32(42), circuit.GetPathCost())
+	req.Equal([]string{"r1", "r2"}, circuit.Path.Nodes)
+	req.Equal("NO_TERMINATORS", circuit.GetFailureCause())
+
+	msg = &event_pb.Event{}
+	req.NoError(protodelim.UnmarshalFrom(reader, msg))
+	req.Equal("router1", msg.GetRouter().RouterId)
+	req.True(msg.GetRouter().RouterOnline)
+}
+
+func Test_FormatProtobufCircuitRerouted(t *testing.T) {
+	req := require.New(t)
+
+	reason := "linkFailed"
+	evt := newTestCircuitEvent()
+	evt.EventType = event.CircuitRerouted
+	evt.FailureCause = nil
+	evt.OldPath = &event.CircuitPath{Nodes: []string{"r1", "r3", "r2"}, Links: []string{"l2", "l3"}}
+	evt.RerouteReason = &reason
+
+	buf, err := FormatProtobuf("circuit", evt)
+	req.NoError(err)
+
+	msg := &event_pb.Event{}
+	req.NoError(protodelim.UnmarshalFrom(bufio.NewReader(bytes.NewReader(buf)), msg))
+	circuit := msg.GetCircuit()
+	req.NotNil(circuit)
+	req.Equal("rerouted", circuit.EventType)
+	req.Equal([]string{"r1", "r2"}, circuit.Path.Nodes)
+	req.Equal([]string{"r1", "r3", "r2"}, circuit.OldPath.Nodes)
+	req.Equal([]string{"l2", "l3"}, circuit.OldPath.Links)
+	req.Equal("linkFailed", circuit.GetRerouteReason())
+}
@@ -0,0 +1,510 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	DefaultJournalMaxEntries    = 10000
+	DefaultJournalFlushInterval = time.Second
+
+	journalEntriesBucket = "entries"
+	journalMetaBucket    = "meta"
+
+	journalNextSequenceKey  = "nextSequence"
+	journalRetainedSinceKey = "retainedSince"
+)
+
+// journalNamespaces maps the event types emitted by the json formatter to the subscription types they belong to
+var journalNamespaces = map[string]string{
+	"circuit":        event.CircuitEventsNs,
+	"link":           event.LinkEventsNs,
+	"metrics":        event.MetricsEventsNs,
+	"router":         event.RouterEventsNs,
+	"service":        event.ServiceEventsNs,
+	"terminator":     event.TerminatorEventsNs,
+	"usage":          event.UsageEventsNs,
+	"usage.v3":       event.UsageEventsNs,
+	"cluster":        event.ClusterEventsNs,
+	"entity.change":  event.EntityChangeEventsNs,
+	"session":        event.SessionEventNS,
+	"apiSession":     event.ApiSessionEventNS,
+	"authentication": event.AuthenticationEventNS,
+	"alert":          event.AlertEventsNs,
+	"entityCount":    event.EntityCountEventNS,
+}
+
+// WireEventJournal creates the event journal from the given configuration and subscribes it to the configured
+// event types.
+/**
+Example configuration:
+eventJournal:
+  path: /var/lib/ziti/event-journal.db
+  maxEntries: 100000
+  flushInterval: 1s
+  subscriptions:
+    - type: fabric.circuits
+    - type: fabric.usage
+      version: 3
+*/
+func (self *Dispatcher) WireEventJournal(config map[interface{}]interface{}) error {
+	subscriptions, err := parseSubscriptions("eventJournal", config)
+	if err != nil {
+		return err
+	}
+
+	journalConfig, err := parseJournalConfig(config)
+	if err != nil {
+		return err
+	}
+
+	journal, err := NewEventJournal(journalConfig, subscriptions, self.closeNotify)
+	if err != nil {
+		return err
+	}
+
+	journal.dispatcher = self
+	formatter := NewJsonFormatter(16, journal)
+	if err = self.ProcessSubscriptions(formatter, subscriptions); err != nil {
+		_ = formatter.Close()
+		return err
+	}
+	journal.setFormatter(formatter)
+
+	self.journal = journal
+	return nil
+}
+
+func (self *Dispatcher) GetEventJournal() event.EventJournal {
+	if self.journal == nil {
+		return nil
+	}
+	return self.journal
+}
+
+type journalConfig struct {
+	path          string
+	maxEntries    uint64
+	flushInterval time.Duration
+}
+
+func parseJournalConfig(config map[interface{}]interface{}) (*journalConfig, error) {
+	ret := &journalConfig{
+		maxEntries:    DefaultJournalMaxEntries,
+		flushInterval: DefaultJournalFlushInterval,
+	}
+
+	if value, found := config["path"]; found {
+		if s, ok := value.(string); ok && s != "" {
+			ret.path = s
+		} else {
+			return nil, errors.Errorf("invalid event journal path %v", value)
+		}
+	}
+
+	if value, found := config["maxEntries"]; found {
+		if v, ok := value.(int); ok && v > 0 {
+			ret.maxEntries = uint64(v)
+		} else {
+			return nil, errors.Errorf("invalid event journal maxEntries %v, must be a positive integer", value)
+		}
+	}
+
+	if value, found := config["flushInterval"]; found {
+		s, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid event journal flushInterval %v, must be a duration", value)
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			return nil, errors.Errorf("invalid event journal flushInterval %v, must be a positive duration", value)
+		}
+		ret.flushInterval = d
+	}
+
+	return ret, nil
+}
+
+// EventJournal retains the most recent events in a ring, in the same way as common.LoggingEventCache, so that
+// stream consumers can resume from a sequence number or timestamp. If a path is configured, entries are also
+// persisted to a bolt db, so history survives controller restarts. Persistence happens on an interval, so the
+// most recent entries may be lost if the controller exits uncleanly. Consumers holding a cursor past the end of
+// the journal will be told of the gap.
+type EventJournal struct {
+	lock          sync.Mutex
+	namespaces    []string
+	headLogIndex  uint64
+	logSize       uint64
+	log           []uint64
+	entries       map[uint64]*event.JournalEntry
+	firstSequence uint64
+	nextSequence  uint64
+	retainedSince time.Time
+	listeners     []event.JournalListener
+
+	db             *bbolt.DB
+	pending        []*event.JournalEntry
+	evictedThrough uint64
+	formatter      io.Closer
+	dispatcher     *Dispatcher
+}
+
+func NewEventJournal(config *journalConfig, subscriptions []*event.Subscription, closeNotify <-chan struct{}) (*EventJournal, error) {
+	result := &EventJournal{
+		logSize:       config.maxEntries,
+		log:           make([]uint64, config.maxEntries),
+		entries:       map[uint64]*event.JournalEntry{},
+		firstSequence: 1,
+		nextSequence:  1,
+		retainedSince: time.Now(),
+	}
+
+	namespaces := map[string]struct{}{}
+	for _, sub := range subscriptions {
+		namespaces[sub.Type] = struct{}{}
+	}
+	for ns := range namespaces {
+		result.namespaces = append(result.namespaces, ns)
+	}
+	sort.Strings(result.namespaces)
+
+	if config.path != "" {
+		if err := result.open(config.path); err != nil {
+			return nil, err
+		}
+	}
+
+	go result.run(config.flushInterval, closeNotify)
+
+	return result, nil
+}
+
+// setFormatter sets the formatter feeding the journal, so that it can be closed when the controller shuts down
+func (self *EventJournal) setFormatter(formatter io.Closer) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.formatter = formatter
+}
+
+func (self *EventJournal) Namespaces() []string {
+	return self.namespaces
+}
+
+func (self *EventJournal) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	namespace, found := journalNamespaces[eventType]
+	if !found {
+		namespace = eventType
+	}
+
+	self.Store(&event.JournalEntry{
+		Timestamp: time.Now(),
+		Namespace: namespace,
+		EventType: eventType,
+		Data:      formattedEvent,
+	})
+}
+
+// Store assigns the next sequence number to the entry, adds it to the journal, evicting the oldest entry if the
+// journal is full, and notifies any listeners
+func (self *EventJournal) Store(entry *event.JournalEntry) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	entry.Sequence = self.nextSequence
+	self.nextSequence++
+
+	self.append(entry)
+
+	if self.db != nil {
+		self.pending = append(self.pending, entry)
+	}
+
+	for _, listener := range self.listeners {
+		listener.AcceptJournalEntry(entry)
+	}
+}
+
+func (self *EventJournal) append(entry *event.JournalEntry) {
+	targetLogIndex := (self.headLogIndex + 1) % self.logSize
+
+	// evict the oldest entry if we have looped
+	if prevKey := self.log[targetLogIndex]; prevKey != 0 {
+		self.retainedSince = self.entries[prevKey].Timestamp.Add(time.Nanosecond)
+		self.firstSequence = prevKey + 1
+		self.evictedThrough = prevKey
+		delete(self.entries, prevKey)
+	}
+
+	self.log[targetLogIndex] = entry.Sequence
+	self.entries[entry.Sequence] = entry
+	self.headLogIndex = targetLogIndex
+}
+
+func (self *EventJournal) Follow(position event.JournalPosition, listener event.JournalListener) ([]*event.JournalEntry, *event.JournalGap) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*event.JournalEntry
+	var gap *event.JournalGap
+
+	if position.FromTimestamp != nil {
+		if position.FromTimestamp.Before(self.retainedSince) {
+			gap = self.newGap()
+			gap.RequestedTimestamp = position.FromTimestamp
+		}
+		for _, entry := range self.replayFrom(self.firstSequence) {
+			if !entry.Timestamp.Before(*position.FromTimestamp) {
+				result = append(result, entry)
+			}
+		}
+	} else {
+		from := position.FromSequence
+		if from != 0 && (from < self.firstSequence || from > self.nextSequence) {
+			gap = self.newGap()
+			gap.RequestedSequence = from
+		}
+		if from < self.firstSequence || from > self.nextSequence {
+			from = self.firstSequence
+		}
+		result = self.replayFrom(from)
+	}
+
+	if listener != nil {
+		self.listeners = append(self.listeners, listener)
+	}
+
+	return result, gap
+}
+
+func (self *EventJournal) newGap() *event.JournalGap {
+	return &event.JournalGap{
+		Namespace:     event.JournalEventNs,
+		EventType:     event.JournalEventTypeGap,
+		Timestamp:     time.Now(),
+		FirstSequence: self.firstSequence,
+	}
+}
+
+// replayFrom returns the retained entries from the given sequence number, which must be between firstSequence and
+// nextSequence
+func (self *EventJournal) replayFrom(startSequence uint64) []*event.JournalEntry {
+	lastSequence := self.nextSequence - 1
+	if startSequence > lastSequence {
+		return nil
+	}
+
+	count := lastSequence - startSequence + 1
+	logIndex := (self.headLogIndex + self.logSize - (count - 1)) % self.logSize
+
+	result := make([]*event.JournalEntry, 0, count)
+	for i := uint64(0); i < count; i++ {
+		result = append(result, self.entries[self.log[(logIndex+i)%self.logSize]])
+	}
+	return result
+}
+
+func (self *EventJournal) Unfollow(listener event.JournalListener) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for idx, l := range self.listeners {
+		if l == listener {
+			self.listeners = append(self.listeners[:idx], self.listeners[idx+1:]...)
+			return
+		}
+	}
+}
+
+func (self *EventJournal) open(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "unable to create directory for event journal '%v'", path)
+	}
+
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return errors.Wrapf(err, "unable to open event journal '%v'", path)
+	}
+
+	var loaded []*event.JournalEntry
+	err = db.Update(func(tx *bbolt.Tx) error {
+		entriesBucket, err := tx.CreateBucketIfNotExists([]byte(journalEntriesBucket))
+		if err != nil {
+			return err
+		}
+		metaBucket, err := tx.CreateBucketIfNotExists([]byte(journalMetaBucket))
+		if err != nil {
+			return err
+		}
+
+		if val := metaBucket.Get([]byte(journalNextSequenceKey)); len(val) == 8 {
+			self.nextSequence = binary.BigEndian.Uint64(val)
+		}
+		if val := metaBucket.Get([]byte(journalRetainedSinceKey)); len(val) == 8 {
+			self.retainedSince = time.Unix(0, int64(binary.BigEndian.Uint64(val)))
+		}
+
+		cursor := entriesBucket.Cursor()
+		for k, v := cursor.Last(); k != nil; k, v = cursor.Prev() {
+			entry := &event.JournalEntry{}
+			if err := json.Unmarshal(v, entry); err != nil {
+				return errors.Wrapf(err, "unable to decode event journal entry %v", binary.BigEndian.Uint64(k))
+			}
+			if uint64(len(loaded)) == self.logSize {
+				// the journal has shrunk, older entries will be removed on the next flush
+				self.retainedSince = entry.Timestamp.Add(time.Nanosecond)
+				self.evictedThrough = entry.Sequence
+				break
+			}
+			loaded = append(loaded, entry)
+		}
+		return nil
+	})
+
+	if err != nil {
+		_ = db.Close()
+		return errors.Wrapf(err, "unable to load event journal '%v'", path)
+	}
+
+	for i := len(loaded) - 1; i >= 0; i-- {
+		self.append(loaded[i])
+	}
+
+	if len(loaded) > 0 {
+		self.firstSequence = loaded[len(loaded)-1].Sequence
+		if self.nextSequence <= loaded[0].Sequence {
+			self.nextSequence = loaded[0].Sequence + 1
+		}
+	} else {
+		self.firstSequence = self.nextSequence
+	}
+
+	self.db = db
+
+	pfxlog.Logger().WithField("path", path).
+		WithField("entries", len(loaded)).
+		WithField("nextSequence", self.nextSequence).
+		Info("event journal loaded")
+
+	return self.flush()
+}
+
+// run persists the journal on the flush interval, if it's persistent, and closes it when the controller shuts down
+func (self *EventJournal) run(flushInterval time.Duration, closeNotify <-chan struct{}) {
+	var flushC <-chan time.Time
+	if self.db != nil {
+		ticker := time.NewTicker(flushInterval)
+		defer ticker.Stop()
+		flushC = ticker.C
+	}
+
+	for {
+		select {
+		case <-flushC:
+			if err := self.flush(); err != nil {
+				pfxlog.Logger().WithError(err).Error("failed to persist event journal")
+			}
+		case <-closeNotify:
+			self.close()
+			return
+		}
+	}
+}
+
+// close stops the formatter feeding the journal, then persists any remaining entries and closes the db
+func (self *EventJournal) close() {
+	self.lock.Lock()
+	formatter := self.formatter
+	self.formatter = nil
+	self.lock.Unlock()
+
+	if formatter != nil {
+		if err := formatter.Close(); err != nil {
+			pfxlog.Logger().WithError(err).Error("failed to close event journal formatter")
+		}
+	}
+
+	if self.db == nil {
+		return
+	}
+
+	if err := self.flush(); err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to persist event journal")
+	}
+	if err := self.db.Close(); err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to close event journal")
+	}
+}
+
+func (self *EventJournal) flush() error {
+	self.lock.Lock()
+	pending := self.pending
+	self.pending = nil
+	evictedThrough := self.evictedThrough
+	nextSequence := self.nextSequence
+	retainedSince := self.retainedSince
+	self.lock.Unlock()
+
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		entriesBucket := tx.Bucket([]byte(journalEntriesBucket))
+		metaBucket := tx.Bucket([]byte(journalMetaBucket))
+
+		for _, entry := range pending {
+			if entry.Sequence <= evictedThrough {
+				continue
+			}
+			val, err := json.Marshal(entry)
+			if err != nil {
+				return err
+			}
+			if err = entriesBucket.Put(journalKey(entry.Sequence), val); err != nil {
+				return err
+			}
+		}
+
+		cursor := entriesBucket.Cursor()
+		for k, _ := cursor.First(); k != nil && binary.BigEndian.Uint64(k) <= evictedThrough; k, _ = cursor.First() {
+			if err := cursor.Delete(); err != nil {
+				return err
+			}
+		}
+
+		if err := metaBucket.Put([]byte(journalNextSequenceKey), journalKey(nextSequence)); err != nil {
+			return err
+		}
+		return metaBucket.Put([]byte(journalRetainedSinceKey), journalKey(uint64(retainedSince.UnixNano())))
+	})
+}
+
+func journalKey(v uint64) []byte {
+	result := make([]byte, 8)
+	binary.BigEndian.PutUint64(result, v)
+	return result
+}
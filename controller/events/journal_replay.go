@@ -0,0 +1,180 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+)
+
+// loggingEventAcceptor is implemented by formatters built on BaseFormatter
+type loggingEventAcceptor interface {
+	AcceptLoggingEvent(event FormatterEvent)
+}
+
+// NewReplayer returns a replayer which decodes journal entries and passes them through a private dispatcher, which
+// has only the stream's subscriptions registered. Subscription options, such as includes and filters, are applied by
+// the same registration code used for live events, so replayed and live streams select the same events.
+func (self *EventJournal) NewReplayer(format string, subscriptions []*event.Subscription, sink event.JournalReplaySink) (event.JournalReplayer, error) {
+	if self.dispatcher == nil {
+		return nil, errors.New("event journal isn't attached to an event dispatcher")
+	}
+
+	formatterFactory := self.dispatcher.GetFormatterFactory(format)
+	if formatterFactory == nil {
+		return nil, errors.Errorf("invalid format ['%v']", format)
+	}
+
+	result := &journalReplayer{
+		dispatcher: self.dispatcher.newReplayDispatcher(),
+		sink:       sink,
+	}
+
+	result.formatter = formatterFactory.NewFormatter(result)
+	acceptor, ok := result.formatter.(loggingEventAcceptor)
+	if !ok {
+		_ = result.formatter.Close()
+		return nil, errors.Errorf("format ['%v'] doesn't support replay from the event journal", format)
+	}
+	result.acceptor = acceptor
+
+	if err := result.dispatcher.ProcessSubscriptions(result.formatter, subscriptions); err != nil {
+		_ = result.formatter.Close()
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// newReplayDispatcher returns a dispatcher which isn't connected to any event sources, but which shares the
+// state used to validate subscriptions and evaluate filters with this dispatcher
+func (self *Dispatcher) newReplayDispatcher() *Dispatcher {
+	result := NewDispatcher(self.closeNotify)
+	result.network = self.network
+	result.stores = self.stores
+	result.entityTypes = self.entityTypes
+	result.filterEntities = self.filterEntities
+	return result
+}
+
+type journalReplayer struct {
+	dispatcher *Dispatcher
+	formatter  io.Closer
+	acceptor   loggingEventAcceptor
+	sink       event.JournalReplaySink
+	sequence   uint64
+}
+
+func (self *journalReplayer) Replay(entry *event.JournalEntry) {
+	// formatters deliver events in order, so a marker ahead of the entry tells the sink which sequence number the
+	// next formatted event has
+	self.acceptor.AcceptLoggingEvent(journalSequenceMarker(entry.Sequence))
+
+	d := self.dispatcher
+	switch entry.EventType {
+	case "circuit":
+		replayEntry(entry, d.circuitEventHandlers.Value(), event.CircuitEventHandler.AcceptCircuitEvent)
+	case "link":
+		replayEntry(entry, d.linkEventHandlers.Value(), event.LinkEventHandler.AcceptLinkEvent)
+	case "metrics":
+		// metrics subscriptions are registered as message handlers, which apply their filters to replayed events
+		var handlers []event.MetricsEventHandler
+		for _, handler := range d.metricsMsgEventHandlers.Value() {
+			if metricsHandler, ok := handler.(event.MetricsEventHandler); ok {
+				handlers = append(handlers, metricsHandler)
+			}
+		}
+		replayEntry(entry, handlers, event.MetricsEventHandler.AcceptMetricsEvent)
+	case "router":
+		replayEntry(entry, d.routerEventHandlers.Value(), event.RouterEventHandler.AcceptRouterEvent)
+	case "service":
+		replayEntry(entry, d.serviceEventHandlers.Value(), event.ServiceEventHandler.AcceptServiceEvent)
+	case "terminator":
+		replayEntry(entry, d.terminatorEventHandlers.Value(), event.TerminatorEventHandler.AcceptTerminatorEvent)
+	case "usage":
+		replayEntry(entry, d.usageEventHandlers.Value(), event.UsageEventHandler.AcceptUsageEvent)
+	case "usage.v3":
+		replayEntry(entry, d.usageEventV3Handlers.Value(), event.UsageEventV3Handler.AcceptUsageEventV3)
+	case "cluster":
+		replayEntry(entry, d.clusterEventHandlers.Value(), event.ClusterEventHandler.AcceptClusterEvent)
+	case "entity.change":
+		replayEntry(entry, d.entityChangeEventHandlers.Value(), event.EntityChangeEventHandler.AcceptEntityChangeEvent)
+	case "session":
+		replayEntry(entry, d.sessionEventHandlers.Value(), event.SessionEventHandler.AcceptSessionEvent)
+	case "apiSession":
+		replayEntry(entry, d.apiSessionEventHandlers.Value(), event.ApiSessionEventHandler.AcceptApiSessionEvent)
+	case "authentication":
+		replayEntry(entry, d.authenticationEventHandlers.Value(), event.AuthenticationEventHandler.AcceptAuthenticationEvent)
+	case "alert":
+		replayEntry(entry, d.alertEventHandlers.Value(), event.AlertEventHandler.AcceptAlertEvent)
+	case "entityCount":
+		replayEntry(entry, d.entityCountEventHandlers.Value(), func(state *entityCountState, evt *event.EntityCountEvent) {
+			state.handler.AcceptEntityCountEvent(evt)
+		})
+	default:
+		pfxlog.Logger().Debugf("unable to replay journaled event of type %v", entry.EventType)
+	}
+}
+
+func replayEntry[H any, T any](entry *event.JournalEntry, handlers []H, accept func(H, *T)) {
+	if len(handlers) == 0 {
+		return
+	}
+
+	evt := new(T)
+	if err := json.Unmarshal(entry.Data, evt); err != nil {
+		pfxlog.Logger().WithError(err).WithField("sequence", entry.Sequence).
+			Errorf("unable to decode journaled event of type %v", entry.EventType)
+		return
+	}
+
+	for _, handler := range handlers {
+		accept(handler, evt)
+	}
+}
+
+func (self *journalReplayer) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	self.sink.AcceptJournaledEvent(self.sequence, eventType, formattedEvent)
+}
+
+func (self *journalReplayer) AcceptFormattedEventWithSource(source FormatterEvent, formattedEvent []byte) {
+	if marker, ok := source.(journalSequenceMarker); ok {
+		self.sequence = uint64(marker)
+		return
+	}
+	self.AcceptFormattedEvent(source.GetEventType(), formattedEvent)
+}
+
+func (self *journalReplayer) Close() error {
+	return self.formatter.Close()
+}
+
+// journalSequenceMarker is queued on the replay formatter ahead of each journal entry. It's consumed by the
+// replayer's sink and never reaches the stream consumer
+type journalSequenceMarker uint64
+
+func (self journalSequenceMarker) GetEventType() string {
+	return "journal.sequence"
+}
+
+func (self journalSequenceMarker) Format() ([]byte, error) {
+	return nil, nil
+}
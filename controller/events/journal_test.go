@@ -0,0 +1,225 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+type testJournalListener struct {
+	entries []*event.JournalEntry
+}
+
+func (self *testJournalListener) AcceptJournalEntry(entry *event.JournalEntry) {
+	self.entries = append(self.entries, entry)
+}
+
+func journalSequences(entries []*event.JournalEntry) []uint64 {
+	var result []uint64
+	for _, entry := range entries {
+		result = append(result, entry.Sequence)
+	}
+	return result
+}
+
+func TestEventJournalReplay(t *testing.T) {
+	req := require.New(t)
+
+	journal, err := NewEventJournal(&journalConfig{maxEntries: 5}, nil, nil)
+	req.NoError(err)
+
+	replay, gap := journal.Follow(event.JournalPosition{FromSequence: 1}, nil)
+	req.Empty(replay)
+	req.Nil(gap)
+
+	for i := 0; i < 8; i++ {
+		journal.AcceptFormattedEvent("circuit", []byte(fmt.Sprintf(`{"i":%d}`, i)))
+	}
+
+	replay, gap = journal.Follow(event.JournalPosition{FromSequence: 6}, nil)
+	req.Nil(gap)
+	req.Equal([]uint64{6, 7, 8}, journalSequences(replay))
+	req.Equal(event.CircuitEventsNs, replay[0].Namespace)
+
+	replay, gap = journal.Follow(event.JournalPosition{FromSequence: 9}, nil)
+	req.Nil(gap)
+	req.Empty(replay)
+
+	// entries 1-3 have been evicted
+	replay, gap = journal.Follow(event.JournalPosition{FromSequence: 2}, nil)
+	req.NotNil(gap)
+	req.Equal(uint64(2), gap.RequestedSequence)
+	req.Equal(uint64(4), gap.FirstSequence)
+	req.Equal([]uint64{4, 5, 6, 7, 8}, journalSequences(replay))
+
+	// a cursor past the end of the journal indicates the journal was reset
+	replay, gap = journal.Follow(event.JournalPosition{FromSequence: 100}, nil)
+	req.NotNil(gap)
+	req.Equal([]uint64{4, 5, 6, 7, 8}, journalSequences(replay))
+
+	fromTime := journal.entries[7].Timestamp
+	replay, gap = journal.Follow(event.JournalPosition{FromTimestamp: &fromTime}, nil)
+	req.Nil(gap)
+	req.Equal(uint64(7), replay[0].Sequence)
+
+	fromTime = time.Now().Add(-time.Hour)
+	replay, gap = journal.Follow(event.JournalPosition{FromTimestamp: &fromTime}, nil)
+	req.NotNil(gap)
+	req.Equal(&fromTime, gap.RequestedTimestamp)
+	req.Equal([]uint64{4, 5, 6, 7, 8}, journalSequences(replay))
+
+	listener := &testJournalListener{}
+	_, _ = journal.Follow(event.JournalPosition{FromSequence: 9}, listener)
+	journal.AcceptFormattedEvent("link", []byte(`{}`))
+	req.Equal([]uint64{9}, journalSequences(listener.entries))
+	req.Equal(event.LinkEventsNs, listener.entries[0].Namespace)
+
+	journal.Unfollow(listener)
+	journal.AcceptFormattedEvent("link", []byte(`{}`))
+	req.Len(listener.entries, 1)
+}
+
+func TestEventJournalPersistence(t *testing.T) {
+	req := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "journal.db")
+	closeNotify := make(chan struct{})
+
+	journal, err := NewEventJournal(&journalConfig{path: path, maxEntries: 3, flushInterval: time.Hour}, nil, closeNotify)
+	req.NoError(err)
+
+	for i := 0; i < 5; i++ {
+		journal.AcceptFormattedEvent("circuit", []byte(fmt.Sprintf(`{"i":%d}`, i)))
+	}
+	req.NoError(journal.flush())
+	req.NoError(journal.db.Close())
+
+	journal, err = NewEventJournal(&journalConfig{path: path, maxEntries: 2, flushInterval: time.Hour}, nil, closeNotify)
+	req.NoError(err)
+	defer close(closeNotify)
+
+	replay, gap := journal.Follow(event.JournalPosition{FromSequence: 3}, nil)
+	req.NotNil(gap)
+	req.Equal(uint64(4), gap.FirstSequence)
+	req.Equal([]uint64{4, 5}, journalSequences(replay))
+	req.JSONEq(`{"i":4}`, string(replay[1].Data))
+
+	journal.AcceptFormattedEvent("circuit", []byte(`{"i":5}`))
+	replay, gap = journal.Follow(event.JournalPosition{FromSequence: 5}, nil)
+	req.Nil(gap)
+	req.Equal([]uint64{5, 6}, journalSequences(replay))
+}
+
+type testJournalFormatter struct {
+	closed chan struct{}
+}
+
+func (self *testJournalFormatter) Close() error {
+	close(self.closed)
+	return nil
+}
+
+func TestEventJournalClosesFormatter(t *testing.T) {
+	req := require.New(t)
+
+	// the formatter is closed on shutdown whether or not the journal is persistent
+	for _, path := range []string{"", filepath.Join(t.TempDir(), "journal.db")} {
+		closeNotify := make(chan struct{})
+		journal, err := NewEventJournal(&journalConfig{path: path, maxEntries: 3, flushInterval: time.Hour}, nil, closeNotify)
+		req.NoError(err)
+
+		formatter := &testJournalFormatter{closed: make(chan struct{})}
+		journal.setFormatter(formatter)
+		close(closeNotify)
+
+		select {
+		case <-formatter.closed:
+		case <-time.After(time.Second):
+			req.Fail("formatter not closed", "path: %v", path)
+		}
+	}
+}
+
+type testReplaySink struct {
+	events chan *event.JournalEntry
+}
+
+func (self *testReplaySink) AcceptJournaledEvent(sequence uint64, eventType string, formattedEvent []byte) {
+	self.events <- &event.JournalEntry{Sequence: sequence, EventType: eventType, Data: formattedEvent}
+}
+
+func TestEventJournalReplayOptions(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+
+	journal, err := NewEventJournal(&journalConfig{maxEntries: 10}, nil, nil)
+	req.NoError(err)
+	journal.dispatcher = dispatcher
+
+	for _, eventType := range []event.CircuitEventType{event.CircuitCreated, event.CircuitFailed, event.CircuitDeleted} {
+		data, err := MarshalJson(&event.CircuitEvent{Namespace: event.CircuitEventsNs, EventType: eventType, CircuitId: "c1"})
+		req.NoError(err)
+		journal.AcceptFormattedEvent("circuit", data)
+	}
+	journal.AcceptFormattedEvent("link", []byte(`{"namespace":"fabric.links","event_type":"dialed"}`))
+
+	sink := &testReplaySink{events: make(chan *event.JournalEntry, 10)}
+	replayer, err := journal.NewReplayer(LogfmtFormat, []*event.Subscription{{
+		Type:    event.CircuitEventsNs,
+		Options: map[string]interface{}{"include": []interface{}{string(event.CircuitCreated), string(event.CircuitDeleted)}},
+	}}, sink)
+	req.NoError(err)
+	defer func() { _ = replayer.Close() }()
+
+	replay, _ := journal.Follow(event.JournalPosition{FromSequence: 1}, nil)
+	for _, entry := range replay {
+		replayer.Replay(entry)
+	}
+
+	// the failed circuit event is excluded by the include option, and links aren't subscribed to
+	var received []*event.JournalEntry
+	for len(received) < 2 {
+		select {
+		case entry := <-sink.events:
+			received = append(received, entry)
+		case <-time.After(time.Second):
+			req.FailNow("timed out waiting for replayed events")
+		}
+	}
+	req.Equal([]uint64{1, 3}, journalSequences(received))
+	req.Equal("circuit", received[0].EventType)
+	req.Contains(string(received[0].Data), "event_type=created")
+	req.Contains(string(received[1].Data), "event_type=deleted")
+
+	select {
+	case entry := <-sink.events:
+		req.FailNow("unexpected replayed event", "sequence %v", entry.Sequence)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	_, err = journal.NewReplayer("xml", nil, sink)
+	req.Error(err)
+}
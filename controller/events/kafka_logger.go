@@ -0,0 +1,398 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+)
+
+const KafkaEventTypeHeader = "ziti-event-type"
+
+// KafkaEventLoggerFactory creates event handlers which publish formatted events to kafka topics.
+//
+// Example configuration:
+//
+//	events:
+//	  kafkaLogger:
+//	    subscriptions:
+//	      - type: fabric.circuits
+//	      - type: fabric.usage
+//	        version: 3
+//	      - type: edge.sessions
+//	    handler:
+//	      type: kafka
+//	      format: json
+//	      brokers:
+//	        - localhost:9092
+//	      topic: ziti-events            # used for namespaces without a topic mapping
+//	      topics:
+//	        fabric.circuits: ziti-circuits
+//	        fabric.usage: ziti-usage
+//	      key: circuit_id               # field(s) used as the message key, first match wins
+//	      keys:                         # per namespace key field overrides
+//	        edge.sessions: identity_id
+//	      compression: snappy           # none, gzip, snappy, lz4 or zstd. default:none
+//	      bufferSize: 256               //default:256
+type KafkaEventLoggerFactory struct {
+	dispatcher *Dispatcher
+}
+
+func (self KafkaEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	conf, err := parseKafkaConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse kafka config")
+	}
+
+	producer, err := sarama.NewAsyncProducer(conf.brokers, conf.saramaConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create kafka producer for brokers %v", conf.brokers)
+	}
+
+	return NewKafkaEventLogger(self.dispatcher, conf, producer)
+}
+
+func NewKafkaEventLogger(dispatcher *Dispatcher, conf *kafkaConfig, producer sarama.AsyncProducer) (io.Closer, error) {
+	formatterFactory := dispatcher.GetFormatterFactory(conf.format)
+	if formatterFactory == nil {
+		_ = producer.Close()
+		return nil, errors.Errorf("invalid 'format' for kafka event handler: %v", conf.format)
+	}
+
+	sink := &kafkaEventSink{
+		config:      conf,
+		producer:    producer,
+		closeNotify: make(chan struct{}),
+	}
+	go sink.logErrors()
+
+	go func() {
+		<-dispatcher.closeNotify
+		if err := sink.Close(); err != nil {
+			pfxlog.Logger().WithError(err).Error("error closing kafka producer")
+		}
+	}()
+
+	return formatterFactory.NewFormatter(sink), nil
+}
+
+type kafkaConfig struct {
+	brokers      []string
+	format       string
+	defaultTopic string
+	topics       map[string]string
+	keyFields    []string
+	nsKeyFields  map[string][]string
+	saramaConfig *sarama.Config
+}
+
+func parseKafkaConfig(config map[interface{}]interface{}) (*kafkaConfig, error) {
+	ret := &kafkaConfig{
+		topics:       map[string]string{},
+		nsKeyFields:  map[string][]string{},
+		saramaConfig: sarama.NewConfig(),
+	}
+
+	ret.saramaConfig.ClientID = "ziti-controller"
+	ret.saramaConfig.Producer.RequiredAcks = sarama.WaitForLocal
+	ret.saramaConfig.Producer.Return.Errors = true
+	ret.saramaConfig.Producer.Return.Successes = false
+
+	value, found := config["brokers"]
+	if !found {
+		return nil, errors.New("missing kafka brokers")
+	}
+	brokers, err := kafkaStringList(value)
+	if err != nil || len(brokers) == 0 {
+		return nil, errors.Errorf("invalid kafka brokers %v, must be a string or list of strings", value)
+	}
+	ret.brokers = brokers
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			ret.format = format
+		} else {
+			return nil, errors.New("invalid 'format' for kafka event handler")
+		}
+	} else {
+		return nil, errors.New("'format' must be specified for event handler")
+	}
+
+	if value, found := config["topic"]; found {
+		if topic, ok := value.(string); ok {
+			ret.defaultTopic = topic
+		} else {
+			return nil, errors.Errorf("invalid kafka topic %v, must be a string", value)
+		}
+	}
+
+	if value, found := config["topics"]; found {
+		topics, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("invalid kafka topics, must be a map of event namespace to topic")
+		}
+		for k, v := range topics {
+			ret.topics[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	if ret.defaultTopic == "" && len(ret.topics) == 0 {
+		return nil, errors.New("kafka event handler requires a topic or topics mapping")
+	}
+
+	if value, found := config["key"]; found {
+		if ret.keyFields, err = kafkaStringList(value); err != nil {
+			return nil, errors.Wrap(err, "invalid kafka key")
+		}
+	}
+
+	if value, found := config["keys"]; found {
+		keys, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("invalid kafka keys, must be a map of event namespace to key field(s)")
+		}
+		for k, v := range keys {
+			fields, err := kafkaStringList(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid kafka keys for namespace %v", k)
+			}
+			ret.nsKeyFields[fmt.Sprintf("%v", k)] = fields
+		}
+	}
+
+	if value, found := config["clientId"]; found {
+		if s, ok := value.(string); ok {
+			ret.saramaConfig.ClientID = s
+		}
+	}
+
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok && size > 0 {
+			ret.saramaConfig.ChannelBufferSize = size
+		} else {
+			return nil, errors.Errorf("invalid kafka bufferSize %v, must be a positive integer", value)
+		}
+	}
+
+	if value, found := config["version"]; found {
+		version, err := sarama.ParseKafkaVersion(fmt.Sprintf("%v", value))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid kafka version %v", value)
+		}
+		ret.saramaConfig.Version = version
+	}
+
+	if value, found := config["compression"]; found {
+		codec := fmt.Sprintf("%v", value)
+		if err := ret.saramaConfig.Producer.Compression.UnmarshalText([]byte(codec)); err != nil {
+			return nil, errors.Wrapf(err, "invalid kafka compression %v", codec)
+		}
+	}
+
+	if value, found := config["tls"]; found {
+		if b, ok := value.(bool); ok {
+			ret.saramaConfig.Net.TLS.Enable = b
+		} else {
+			return nil, errors.New("invalid kafka tls value, must be boolean")
+		}
+	}
+
+	if value, found := config["username"]; found {
+		ret.saramaConfig.Net.SASL.Enable = true
+		ret.saramaConfig.Net.SASL.User = fmt.Sprintf("%v", value)
+		if value, found := config["password"]; found {
+			ret.saramaConfig.Net.SASL.Password = fmt.Sprintf("%v", value)
+		}
+	}
+
+	if err = ret.saramaConfig.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid kafka configuration")
+	}
+
+	return ret, nil
+}
+
+func kafkaStringList(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		var result []string
+		for _, entry := range v {
+			s, ok := entry.(string)
+			if !ok {
+				return nil, errors.Errorf("invalid value %v, must be a string", entry)
+			}
+			result = append(result, s)
+		}
+		return result, nil
+	case []string:
+		return v, nil
+	}
+	return nil, errors.Errorf("invalid value %v, must be a string or list of strings", value)
+}
+
+type kafkaEventSink struct {
+	config      *kafkaConfig
+	producer    sarama.AsyncProducer
+	lock        sync.Mutex
+	closed      bool
+	closeNotify chan struct{}
+	inFlight    sync.WaitGroup
+}
+
+func (self *kafkaEventSink) AcceptFormattedEvent(eventType string, formattedEvent []byte) {
+	self.publish(eventType, self.config.defaultTopic, nil, formattedEvent)
+}
+
+func (self *kafkaEventSink) AcceptFormattedEventWithSource(source FormatterEvent, formattedEvent []byte) {
+	fields, err := self.getFields(source, formattedEvent)
+	if err != nil {
+		pfxlog.Logger().WithError(err).Errorf("unable to extract kafka routing fields from event of type %v", source.GetEventType())
+		self.publish(source.GetEventType(), self.config.defaultTopic, nil, formattedEvent)
+		return
+	}
+
+	namespace, _ := fields["namespace"].(string)
+
+	topic, found := self.config.topics[namespace]
+	if !found {
+		topic = self.config.defaultTopic
+	}
+
+	keyFields, found := self.config.nsKeyFields[namespace]
+	if !found {
+		keyFields = self.config.keyFields
+	}
+
+	var key sarama.Encoder
+	for _, keyField := range keyFields {
+		if val, found := kafkaLookupField(fields, keyField); found {
+			key = sarama.StringEncoder(val)
+			break
+		}
+	}
+
+	self.publish(source.GetEventType(), topic, key, formattedEvent)
+}
+
+// getFields returns the json representation of the event as a map. If the event was formatted as json,
+// the formatted event is used, otherwise the source event is marshalled to json
+func (self *kafkaEventSink) getFields(source FormatterEvent, formattedEvent []byte) (map[string]interface{}, error) {
+	var err error
+	data := formattedEvent
+	if !strings.EqualFold(self.config.format, "json") {
+		if data, err = MarshalJson(source); err != nil {
+			return nil, err
+		}
+	}
+
+	fields := map[string]interface{}{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func (self *kafkaEventSink) publish(eventType string, topic string, key sarama.Encoder, formattedEvent []byte) {
+	if topic == "" {
+		pfxlog.Logger().Debugf("no kafka topic configured for event of type %v, dropping", eventType)
+		return
+	}
+
+	// the lock is only held while registering the send, so a stalled broker can't block Close
+	self.lock.Lock()
+	if self.closed {
+		self.lock.Unlock()
+		return
+	}
+	self.inFlight.Add(1)
+	self.lock.Unlock()
+	defer self.inFlight.Done()
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Key:   key,
+		Value: sarama.ByteEncoder(formattedEvent),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(KafkaEventTypeHeader), Value: []byte(eventType)},
+		},
+	}
+
+	// the producer buffers internally, so this only blocks if kafka is falling behind, in which case
+	// back-pressure is applied to the formatter, as with the other handlers, until the sink is closed
+	select {
+	case self.producer.Input() <- msg:
+	case <-self.closeNotify:
+		pfxlog.Logger().Debugf("kafka event handler closed, dropping event of type %v", eventType)
+	}
+}
+
+func (self *kafkaEventSink) logErrors() {
+	for err := range self.producer.Errors() {
+		topic := ""
+		if err.Msg != nil {
+			topic = err.Msg.Topic
+		}
+		pfxlog.Logger().WithError(err.Err).WithField("topic", topic).Error("error sending event to kafka")
+	}
+}
+
+func (self *kafkaEventSink) Close() error {
+	self.lock.Lock()
+	if self.closed {
+		self.lock.Unlock()
+		return nil
+	}
+	self.closed = true
+	close(self.closeNotify)
+	self.lock.Unlock()
+
+	// pending sends are released by closeNotify, and must finish before the producer input is closed
+	self.inFlight.Wait()
+	return self.producer.Close()
+}
+
+// kafkaLookupField finds the value for the given field. Nested fields, such as tags, may be specified using dots,
+// for example tags.clientId
+func kafkaLookupField(fields map[string]interface{}, name string) (string, bool) {
+	var current interface{} = fields
+	for _, part := range strings.Split(name, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if current, ok = m[part]; !ok || current == nil {
+			return "", false
+		}
+	}
+
+	switch v := current.(type) {
+	case string:
+		return v, v != ""
+	case map[string]interface{}, []interface{}:
+		return "", false
+	}
+	return fmt.Sprintf("%v", current), true
+}
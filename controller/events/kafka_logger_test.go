@@ -0,0 +1,199 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_KafkaTopicAndKeyRouting(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+
+	conf, err := parseKafkaConfig(map[interface{}]interface{}{
+		"brokers": []interface{}{"localhost:9092"},
+		"format":  "json",
+		"topic":   "ziti-events",
+		"topics": map[interface{}]interface{}{
+			event.CircuitEventsNs: "ziti-circuits",
+		},
+		"key": "circuit_id",
+		"keys": map[interface{}]interface{}{
+			event.SessionEventNS: []interface{}{"identity_id"},
+		},
+	})
+	req.NoError(err)
+
+	msgs := make(chan *sarama.ProducerMessage, 2)
+	checker := func(msg *sarama.ProducerMessage) error {
+		msgs <- msg
+		return nil
+	}
+
+	producer := mocks.NewAsyncProducer(t, conf.saramaConfig)
+	producer.ExpectInputWithMessageCheckerFunctionAndSucceed(checker)
+	producer.ExpectInputWithMessageCheckerFunctionAndSucceed(checker)
+
+	handler, err := NewKafkaEventLogger(dispatcher, conf, producer)
+	req.NoError(err)
+
+	handler.(event.CircuitEventHandler).AcceptCircuitEvent(&event.CircuitEvent{
+		Namespace: event.CircuitEventsNs,
+		EventType: event.CircuitCreated,
+		CircuitId: "circuit1",
+	})
+
+	nextMsg := func() *sarama.ProducerMessage {
+		select {
+		case msg := <-msgs:
+			return msg
+		case <-time.After(time.Second):
+			req.FailNow("timed out waiting for kafka message")
+		}
+		return nil
+	}
+
+	msg := nextMsg()
+	req.Equal("ziti-circuits", msg.Topic)
+	req.Equal(sarama.StringEncoder("circuit1"), msg.Key)
+	req.Equal(KafkaEventTypeHeader, string(msg.Headers[0].Key))
+	req.Equal("circuit", string(msg.Headers[0].Value))
+
+	handler.(event.SessionEventHandler).AcceptSessionEvent(&event.SessionEvent{
+		Namespace:  event.SessionEventNS,
+		EventType:  "created",
+		Id:         "session1",
+		IdentityId: "identity1",
+	})
+
+	msg = nextMsg()
+	req.Equal("ziti-events", msg.Topic)
+	req.Equal(sarama.StringEncoder("identity1"), msg.Key)
+}
+
+func Test_KafkaMockBroker(t *testing.T) {
+	req := require.New(t)
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader("ziti-events", 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t),
+	})
+
+	closeNotify := make(chan struct{})
+	dispatcher := NewDispatcher(closeNotify)
+
+	handler, err := KafkaEventLoggerFactory{dispatcher: dispatcher}.NewEventHandler(map[interface{}]interface{}{
+		"brokers": broker.Addr(),
+		"format":  "json",
+		"topic":   "ziti-events",
+	})
+	req.NoError(err)
+
+	handler.(event.RouterEventHandler).AcceptRouterEvent(&event.RouterEvent{
+		Namespace: event.RouterEventsNs,
+		EventType: event.RouterOnline,
+		RouterId:  "router1",
+	})
+
+	req.Eventually(func() bool {
+		for _, rr := range broker.History() {
+			if _, ok := rr.Request.(*sarama.ProduceRequest); ok {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+
+	close(closeNotify)
+}
+
+// stalledProducer stands in for a producer whose broker has stopped accepting messages
+type stalledProducer struct {
+	sarama.AsyncProducer
+	input  chan *sarama.ProducerMessage
+	errors chan *sarama.ProducerError
+}
+
+func (self *stalledProducer) Input() chan<- *sarama.ProducerMessage {
+	return self.input
+}
+
+func (self *stalledProducer) Errors() <-chan *sarama.ProducerError {
+	return self.errors
+}
+
+func (self *stalledProducer) Close() error {
+	close(self.errors)
+	return nil
+}
+
+func Test_KafkaCloseWithStalledBroker(t *testing.T) {
+	req := require.New(t)
+
+	producer := &stalledProducer{
+		input:  make(chan *sarama.ProducerMessage),
+		errors: make(chan *sarama.ProducerError),
+	}
+	sink := &kafkaEventSink{
+		config:      &kafkaConfig{defaultTopic: "ziti-events"},
+		producer:    producer,
+		closeNotify: make(chan struct{}),
+	}
+	go sink.logErrors()
+
+	published := make(chan struct{})
+	go func() {
+		sink.AcceptFormattedEvent("circuit", []byte("{}"))
+		close(published)
+	}()
+
+	closed := make(chan error, 1)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		closed <- sink.Close()
+	}()
+
+	select {
+	case err := <-closed:
+		req.NoError(err)
+	case <-time.After(time.Second):
+		req.FailNow("timed out closing kafka sink with a blocked publish")
+	}
+
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		req.FailNow("publish still blocked after close")
+	}
+
+	// events accepted after close are dropped without blocking
+	sink.AcceptFormattedEvent("circuit", []byte("{}"))
+}
@@ -0,0 +1,145 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/xweb/v2"
+	"github.com/pkg/errors"
+	"net/http"
+	"strings"
+)
+
+const (
+	EventMetricsApiBinding     = "event-metrics"
+	EventMetricsApiDefaultPath = "/metrics"
+
+	// metricsApiPath is the path served by the inspect based `metrics` binding
+	metricsApiPath = "/metrics"
+)
+
+var _ xweb.ApiHandlerFactory = &EventMetricsApiFactory{}
+
+// EventMetricsApiFactory exposes the series collected by prometheus event handlers on the
+// controller web listener. The path defaults to /metrics, which is where prometheus scrapes by
+// default. The inspect based `metrics` binding uses the same path, so when both are on the same
+// server, the `path` option must be used to move this binding elsewhere. Clashing paths are
+// rejected at startup.
+type EventMetricsApiFactory struct {
+	dispatcher *Dispatcher
+}
+
+func NewEventMetricsApiFactory(dispatcher *Dispatcher) *EventMetricsApiFactory {
+	return &EventMetricsApiFactory{
+		dispatcher: dispatcher,
+	}
+}
+
+func (factory *EventMetricsApiFactory) Validate(config *xweb.InstanceConfig) error {
+	for _, serverConfig := range config.ServerConfigs {
+		hasMetricsApi := false
+		var paths []string
+		for _, api := range serverConfig.APIs {
+			if api.Binding() == "metrics" {
+				hasMetricsApi = true
+			} else if api.Binding() == EventMetricsApiBinding {
+				paths = append(paths, getEventMetricsPath(api.Options()))
+			}
+		}
+
+		for _, path := range paths {
+			if hasMetricsApi && (hasPathPrefix(path, metricsApiPath) || hasPathPrefix(metricsApiPath, path)) {
+				return errors.Errorf("%v binding on server %v uses path %v, which clashes with the metrics binding",
+					EventMetricsApiBinding, serverConfig.Name, path)
+			}
+		}
+	}
+	return nil
+}
+
+func (factory *EventMetricsApiFactory) Binding() string {
+	return EventMetricsApiBinding
+}
+
+func (factory *EventMetricsApiFactory) New(_ *xweb.ServerConfig, options map[interface{}]interface{}) (xweb.ApiHandler, error) {
+	result := &EventMetricsApiHandler{
+		dispatcher: factory.dispatcher,
+		options:    options,
+		rootPath:   getEventMetricsPath(options),
+	}
+
+	pfxlog.Logger().Infof("prometheus event metrics are enabled on %v", result.rootPath)
+
+	return result, nil
+}
+
+func getEventMetricsPath(options map[interface{}]interface{}) string {
+	if value, found := options["path"]; found {
+		if path, ok := value.(string); ok && path != "" {
+			return path
+		}
+	}
+	return EventMetricsApiDefaultPath
+}
+
+// hasPathPrefix returns true if the leading segments of the path are the segments of the prefix, so /metrics is a
+// prefix of /metrics/events but not of /metrics-events
+func hasPathPrefix(path, prefix string) bool {
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	prefixSegments := strings.Split(strings.Trim(prefix, "/"), "/")
+	if prefixSegments[0] == "" {
+		return true
+	}
+	if len(prefixSegments) > len(pathSegments) {
+		return false
+	}
+	for i, segment := range prefixSegments {
+		if pathSegments[i] != segment {
+			return false
+		}
+	}
+	return true
+}
+
+type EventMetricsApiHandler struct {
+	dispatcher *Dispatcher
+	options    map[interface{}]interface{}
+	rootPath   string
+}
+
+func (self *EventMetricsApiHandler) Binding() string {
+	return EventMetricsApiBinding
+}
+
+func (self *EventMetricsApiHandler) Options() map[interface{}]interface{} {
+	return self.options
+}
+
+func (self *EventMetricsApiHandler) RootPath() string {
+	return self.rootPath
+}
+
+func (self *EventMetricsApiHandler) IsHandler(r *http.Request) bool {
+	return hasPathPrefix(r.URL.Path, self.rootPath)
+}
+
+func (self *EventMetricsApiHandler) ServeHTTP(rw http.ResponseWriter, _ *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := self.dispatcher.WritePrometheusMetrics(rw); err != nil {
+		pfxlog.Logger().WithError(err).Error("failed to write prometheus event metrics")
+	}
+}
@@ -0,0 +1,390 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	promTypeGauge   = "gauge"
+	promTypeCounter = "counter"
+	promTypeSummary = "summary"
+)
+
+var promQuantiles = []struct {
+	key      string
+	quantile string
+}{
+	{"p50", "0.5"},
+	{"p75", "0.75"},
+	{"p95", "0.95"},
+	{"p99", "0.99"},
+	{"p999", "0.999"},
+	{"p9999", "0.9999"},
+}
+
+// PrometheusEventHandlerFactory creates PrometheusEventHandler instances. Handlers are tracked
+// by the dispatcher, so they can be scraped via the event metrics API binding.
+//
+// Example configuration:
+//
+//	events:
+//	  prometheus:
+//	    subscriptions:
+//	      - type: metrics
+//	        sourceFilter: .*
+//	        metricFilter: .*
+//	      - type: services
+//	    handler:
+//	      type: prometheus
+//	      seriesTtl: 5m
+//	      includeTimestamps: false
+type PrometheusEventHandlerFactory struct {
+	dispatcher *Dispatcher
+}
+
+func (self PrometheusEventHandlerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	handler, err := NewPrometheusEventHandler(config)
+	if err != nil {
+		return nil, err
+	}
+
+	self.dispatcher.AddPrometheusEventHandler(handler)
+	go handler.runExpiry(self.dispatcher.closeNotify)
+
+	return handler, nil
+}
+
+func (self *Dispatcher) AddPrometheusEventHandler(handler *PrometheusEventHandler) {
+	self.prometheusEventHandlers.Append(handler)
+	// routers going offline expire their series, so this is wired in regardless of subscriptions
+	self.AddRouterEventHandler(handler)
+}
+
+func (self *Dispatcher) RemovePrometheusEventHandler(handler *PrometheusEventHandler) {
+	self.prometheusEventHandlers.Delete(handler)
+	self.RemoveRouterEventHandler(handler)
+}
+
+// WritePrometheusMetrics writes the current state of all prometheus event handlers in the
+// prometheus text exposition format
+func (self *Dispatcher) WritePrometheusMetrics(out io.Writer) error {
+	for _, handler := range self.prometheusEventHandlers.Value() {
+		if err := handler.WriteMetrics(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewPrometheusEventHandler(config map[interface{}]interface{}) (*PrometheusEventHandler, error) {
+	result := &PrometheusEventHandler{
+		seriesTtl: 5 * time.Minute,
+		series:    map[string]*promSample{},
+	}
+
+	if val, found := config["seriesTtl"]; found {
+		strVal, ok := val.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid type %v for prometheus handler seriesTtl configuration", reflect.TypeOf(val))
+		}
+		ttl, err := time.ParseDuration(strVal)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid duration value for prometheus handler seriesTtl: '%v'", strVal)
+		}
+		if ttl <= 0 {
+			return nil, errors.Errorf("prometheus handler seriesTtl must be positive, got '%v'", strVal)
+		}
+		result.seriesTtl = ttl
+	}
+
+	if val, found := config["includeTimestamps"]; found {
+		if b, ok := val.(bool); ok {
+			result.includeTimestamps = b
+		} else {
+			return nil, errors.New("invalid value for prometheus handler includeTimestamps, must be boolean")
+		}
+	}
+
+	return result, nil
+}
+
+// PrometheusEventHandler converts metrics and service events into prometheus series. Meters become
+// counters and rate gauges, histograms and timers become summaries. Series are labeled with the
+// source router and, where available, the link or service they pertain to. Series which haven't been
+// updated within the configured ttl, or which belong to a router which has gone offline, are dropped.
+type PrometheusEventHandler struct {
+	seriesTtl         time.Duration
+	includeTimestamps bool
+
+	lock   sync.Mutex
+	series map[string]*promSample
+}
+
+type promSample struct {
+	family     string
+	metricType string
+	name       string
+	labels     string
+	sourceId   string
+	value      float64
+	timestamp  time.Time
+	updated    time.Time
+}
+
+func (self *PrometheusEventHandler) AcceptMetricsEvent(evt *event.MetricsEvent) {
+	family := (*PrometheusMetricsEvent)(evt).getMetricName()
+	labels := self.getMetricsEventLabels(evt)
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := time.Now()
+	set := func(metricType, suffix string, extraLabels []string, value float64) {
+		self.setSample(family, metricType, family+suffix, labels, extraLabels, evt.SourceAppId, value, evt.Timestamp, now)
+	}
+
+	switch evt.MetricType {
+	case "intValue", "floatValue":
+		if v, ok := promValue(evt.Metrics["value"]); ok {
+			set(promTypeGauge, "", nil, v)
+		}
+	case "meter":
+		if v, ok := promValue(evt.Metrics["count"]); ok {
+			counterFamily := family + "_total"
+			self.setSample(counterFamily, promTypeCounter, counterFamily, labels, nil, evt.SourceAppId, v, evt.Timestamp, now)
+		}
+		for _, key := range []string{"mean_rate", "m1_rate", "m5_rate", "m15_rate"} {
+			if v, ok := promValue(evt.Metrics[key]); ok {
+				rateFamily := family + "_" + key
+				self.setSample(rateFamily, promTypeGauge, rateFamily, labels, nil, evt.SourceAppId, v, evt.Timestamp, now)
+			}
+		}
+	case "histogram", "timer":
+		count, hasCount := promValue(evt.Metrics["count"])
+		for _, q := range promQuantiles {
+			if v, ok := promValue(evt.Metrics[q.key]); ok {
+				set(promTypeSummary, "", []string{fmt.Sprintf(`quantile="%s"`, q.quantile)}, v)
+			}
+		}
+		if hasCount {
+			set(promTypeSummary, "_count", nil, count)
+			if mean, ok := promValue(evt.Metrics["mean"]); ok {
+				set(promTypeSummary, "_sum", nil, mean*count)
+			}
+		}
+	default:
+		pfxlog.Logger().Debugf("unhandled metric type %v for prometheus event handler", evt.MetricType)
+	}
+}
+
+func (self *PrometheusEventHandler) AcceptServiceEvent(evt *event.ServiceEvent) {
+	family := "ziti_" + promSanitize(evt.EventType) + "_total"
+	labels := []string{promLabel("service_id", evt.ServiceId)}
+	if evt.TerminatorId != "" {
+		labels = append(labels, promLabel("terminator_id", evt.TerminatorId))
+	}
+	sort.Strings(labels)
+	labelStr := strings.Join(labels, ",")
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := time.Now()
+	timestamp := time.Unix(evt.IntervalStartUTC, 0).Add(time.Duration(evt.IntervalLength) * time.Second)
+	key := family + "{" + labelStr + "}"
+	total := float64(evt.Count)
+	if current, found := self.series[key]; found {
+		total += current.value
+	}
+	self.setSample(family, promTypeCounter, family, labelStr, nil, "", total, timestamp, now)
+}
+
+func (self *PrometheusEventHandler) AcceptRouterEvent(evt *event.RouterEvent) {
+	if evt.RouterOnline {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for k, v := range self.series {
+		if v.sourceId == evt.RouterId {
+			delete(self.series, k)
+		}
+	}
+}
+
+func (self *PrometheusEventHandler) setSample(family, metricType, name, labels string, extraLabels []string,
+	sourceId string, value float64, timestamp, now time.Time) {
+
+	if len(extraLabels) > 0 {
+		all := append([]string{}, extraLabels...)
+		if labels != "" {
+			all = append(all, labels)
+		}
+		labels = strings.Join(all, ",")
+	}
+
+	key := name + "{" + labels + "}"
+	self.series[key] = &promSample{
+		family:     family,
+		metricType: metricType,
+		name:       name,
+		labels:     labels,
+		sourceId:   sourceId,
+		value:      value,
+		timestamp:  timestamp,
+		updated:    now,
+	}
+}
+
+func (self *PrometheusEventHandler) getMetricsEventLabels(evt *event.MetricsEvent) string {
+	var labels []string
+	for k, v := range evt.Tags {
+		labels = append(labels, promLabel(k, v))
+	}
+
+	if evt.SourceAppId != "" {
+		labels = append(labels, promLabel("source_id", evt.SourceAppId))
+	}
+
+	if evt.SourceEntityId != "" {
+		switch {
+		case strings.HasPrefix(evt.Metric, "link."):
+			labels = append(labels, promLabel("link_id", evt.SourceEntityId))
+		case strings.HasPrefix(evt.Metric, "ctrl."):
+			labels = append(labels, promLabel("ctrl_id", evt.SourceEntityId))
+		default:
+			labels = append(labels, promLabel("entity_id", evt.SourceEntityId))
+		}
+	}
+
+	sort.Strings(labels)
+	return strings.Join(labels, ",")
+}
+
+func (self *PrometheusEventHandler) runExpiry(closeNotify <-chan struct{}) {
+	ticker := time.NewTicker(self.seriesTtl / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.expireSeries(time.Now())
+		case <-closeNotify:
+			return
+		}
+	}
+}
+
+func (self *PrometheusEventHandler) expireSeries(now time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for k, v := range self.series {
+		if now.Sub(v.updated) > self.seriesTtl {
+			delete(self.series, k)
+		}
+	}
+}
+
+// WriteMetrics outputs all current series in the prometheus text exposition format
+func (self *PrometheusEventHandler) WriteMetrics(out io.Writer) error {
+	self.lock.Lock()
+	samples := make([]*promSample, 0, len(self.series))
+	for _, v := range self.series {
+		samples = append(samples, v)
+	}
+	self.lock.Unlock()
+
+	sort.Slice(samples, func(i, j int) bool {
+		if samples[i].family != samples[j].family {
+			return samples[i].family < samples[j].family
+		}
+		if samples[i].name != samples[j].name {
+			return samples[i].name < samples[j].name
+		}
+		return samples[i].labels < samples[j].labels
+	})
+
+	buf := &strings.Builder{}
+	currentFamily := ""
+	for _, sample := range samples {
+		if sample.family != currentFamily {
+			currentFamily = sample.family
+			_, _ = fmt.Fprintf(buf, "# HELP %[1]s %[1]s\n# TYPE %[1]s %[2]s\n", sample.family, sample.metricType)
+		}
+		_, _ = fmt.Fprintf(buf, "%s{%s} %v", sample.name, sample.labels, sample.value)
+		if self.includeTimestamps {
+			_, _ = fmt.Fprintf(buf, " %d", sample.timestamp.UnixMilli())
+		}
+		buf.WriteString("\n")
+	}
+
+	_, err := io.WriteString(out, buf.String())
+	return err
+}
+
+func promLabel(name, value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return fmt.Sprintf(`%s="%s"`, promSanitize(name), value)
+}
+
+func promSanitize(name string) string {
+	result := strings.Builder{}
+	for idx, c := range name {
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (idx > 0 && c >= '0' && c <= '9') {
+			result.WriteRune(c)
+		} else {
+			result.WriteRune('_')
+		}
+	}
+	return result.String()
+}
+
+func promValue(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,147 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/openziti/xweb/v2"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_PrometheusEventHandler(t *testing.T) {
+	req := require.New(t)
+
+	handler, err := NewPrometheusEventHandler(map[interface{}]interface{}{
+		"seriesTtl": "1m",
+	})
+	req.NoError(err)
+
+	handler.AcceptMetricsEvent(&event.MetricsEvent{
+		MetricType:     "meter",
+		SourceAppId:    "router1",
+		SourceEntityId: "link1",
+		Timestamp:      time.Now(),
+		Metric:         "link.tx.bytesrate",
+		Metrics: map[string]interface{}{
+			"count":   int64(100),
+			"m1_rate": 2.5,
+		},
+		Tags: map[string]string{
+			"sourceRouterId": "router1",
+			"targetRouterId": "router2",
+		},
+	})
+
+	handler.AcceptMetricsEvent(&event.MetricsEvent{
+		MetricType:  "histogram",
+		SourceAppId: "router2",
+		Timestamp:   time.Now(),
+		Metric:      "xgress.tx_write_time",
+		Metrics: map[string]interface{}{
+			"count": int64(10),
+			"mean":  4.0,
+			"p50":   3.0,
+			"p99":   9.0,
+		},
+	})
+
+	handler.AcceptServiceEvent(&event.ServiceEvent{
+		EventType:        "service.dial.success",
+		ServiceId:        "svc1",
+		Count:            3,
+		IntervalStartUTC: time.Now().Unix(),
+		IntervalLength:   60,
+	})
+	handler.AcceptServiceEvent(&event.ServiceEvent{
+		EventType:        "service.dial.success",
+		ServiceId:        "svc1",
+		Count:            2,
+		IntervalStartUTC: time.Now().Unix(),
+		IntervalLength:   60,
+	})
+
+	buf := &strings.Builder{}
+	req.NoError(handler.WriteMetrics(buf))
+	output := buf.String()
+
+	labels := `{link_id="link1",sourceRouterId="router1",source_id="router1",targetRouterId="router2"}`
+	req.Contains(output, "# TYPE ziti_link_tx_bytesrate_total counter\n")
+	req.Contains(output, "ziti_link_tx_bytesrate_total"+labels+" 100\n")
+	req.Contains(output, "ziti_link_tx_bytesrate_m1_rate"+labels+" 2.5\n")
+	req.Contains(output, "# TYPE ziti_xgress_tx_write_time summary\n")
+	req.Contains(output, `ziti_xgress_tx_write_time{quantile="0.99",source_id="router2"} 9`+"\n")
+	req.Contains(output, `ziti_xgress_tx_write_time_count{source_id="router2"} 10`+"\n")
+	req.Contains(output, `ziti_xgress_tx_write_time_sum{source_id="router2"} 40`+"\n")
+	req.Contains(output, `ziti_service_dial_success_total{service_id="svc1"} 5`+"\n")
+
+	handler.AcceptRouterEvent(&event.RouterEvent{
+		RouterId:     "router1",
+		RouterOnline: false,
+	})
+
+	buf.Reset()
+	req.NoError(handler.WriteMetrics(buf))
+	output = buf.String()
+	req.NotContains(output, "ziti_link_tx_bytesrate")
+	req.Contains(output, "ziti_xgress_tx_write_time")
+
+	handler.expireSeries(time.Now().Add(2 * time.Minute))
+
+	buf.Reset()
+	req.NoError(handler.WriteMetrics(buf))
+	req.Equal("", buf.String())
+}
+
+func Test_EventMetricsApiPath(t *testing.T) {
+	req := require.New(t)
+
+	newConfig := func(options map[interface{}]interface{}) *xweb.InstanceConfig {
+		metricsApi := &xweb.ApiConfig{}
+		req.NoError(metricsApi.Parse(map[interface{}]interface{}{"binding": "metrics"}))
+		eventMetricsApi := &xweb.ApiConfig{}
+		req.NoError(eventMetricsApi.Parse(map[interface{}]interface{}{"binding": EventMetricsApiBinding, "options": options}))
+		return &xweb.InstanceConfig{
+			ServerConfigs: []*xweb.ServerConfig{{Name: "test", APIs: []*xweb.ApiConfig{metricsApi, eventMetricsApi}}},
+		}
+	}
+
+	factory := NewEventMetricsApiFactory(nil)
+
+	// the default path is where prometheus scrapes, so it has to be moved when the metrics binding is present
+	handler, err := factory.New(nil, map[interface{}]interface{}{})
+	req.NoError(err)
+	req.Equal("/metrics", handler.RootPath())
+	req.Error(factory.Validate(newConfig(map[interface{}]interface{}{})))
+
+	// paths clash if one is a parent of the other, but sharing a string prefix isn't a clash
+	req.NoError(factory.Validate(newConfig(map[interface{}]interface{}{"path": "/prometheus"})))
+	req.NoError(factory.Validate(newConfig(map[interface{}]interface{}{"path": "/metrics-events"})))
+	req.Error(factory.Validate(newConfig(map[interface{}]interface{}{"path": "/metrics/events"})))
+	req.Error(factory.Validate(newConfig(map[interface{}]interface{}{"path": "/"})))
+
+	handler, err = factory.New(nil, map[interface{}]interface{}{"path": "/metrics-events"})
+	req.NoError(err)
+	req.True(handler.IsHandler(httptest.NewRequest(http.MethodGet, "/metrics-events", nil)))
+	req.True(handler.IsHandler(httptest.NewRequest(http.MethodGet, "/metrics-events/", nil)))
+	req.False(handler.IsHandler(httptest.NewRequest(http.MethodGet, "/metrics", nil)))
+	req.False(handler.IsHandler(httptest.NewRequest(http.MethodGet, "/metrics-events2", nil)))
+}
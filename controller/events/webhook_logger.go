@@ -0,0 +1,674 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	WebhookSignatureHeader = "X-Ziti-Signature"
+	webhookSpoolSuffix     = ".batch"
+)
+
+// WebhookEventLoggerFactory creates event handlers which POST batches of formatted events to an HTTP endpoint.
+//
+// Example configuration:
+//
+//	events:
+//	  siem:
+//	    subscriptions:
+//	      - type: fabric.circuits
+//	    handler:
+//	      type: webhook
+//	      format: json
+//	      url: https://siem.example.com/ingest
+//	      secret: shared-hmac-secret
+//	      batchSize: 100          //default:100
+//	      batchInterval: 1s       //default:1s
+//	      timeout: 10s            //default:10s
+//	      maxRetryInterval: 30s   //default:30s
+//	      spoolDir: /var/lib/ziti/webhook-spool
+//	      spoolMaxSizeMb: 100     //default:100
+//	      headers:
+//	        Authorization: Bearer abc
+//
+// A batch which can't be delivered is spooled straight away, and the spool is retried in the background, backing off
+// up to maxRetryInterval between attempts. New batches are spooled behind older ones until the spool is drained, so
+// events are delivered in order. If spoolDir is set, the spool is kept on disk and survives restarts. Otherwise, it's
+// kept in memory. In both cases, the oldest batches are dropped once the spool grows past spoolMaxSizeMb.
+type WebhookEventLoggerFactory struct{}
+
+func (WebhookEventLoggerFactory) NewEventHandler(config map[interface{}]interface{}) (interface{}, error) {
+	return NewWebhookEventLogger(fabricFormatterFactory{}, config)
+}
+
+func NewWebhookEventLogger(formatterFactory LoggingHandlerFactory, config map[interface{}]interface{}) (interface{}, error) {
+	bufferSize := 10
+	if value, found := config["bufferSize"]; found {
+		if size, ok := value.(int); ok {
+			bufferSize = size
+		}
+	}
+
+	conf, err := parseWebhookConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse webhook config")
+	}
+
+	if value, found := config["format"]; found {
+		if format, ok := value.(string); ok {
+			if IsBinaryFormat(format) {
+				conf.binary = true
+				if _, found := config["contentType"]; !found {
+					conf.contentType = "application/octet-stream"
+				}
+			}
+			writer, err := newWebhookWriteCloser(conf)
+			if err != nil {
+				return nil, err
+			}
+			return formatterFactory.NewLoggingHandler(format, bufferSize, writer)
+		}
+		return nil, errors.New("invalid 'format' for event webhook")
+	}
+	return nil, errors.New("'format' must be specified for event handler")
+}
+
+type webhookConfig struct {
+	url              string
+	secret           []byte
+	headers          map[string]string
+	contentType      string
+	binary           bool
+	batchSize        int
+	batchInterval    time.Duration
+	queueSize        int
+	timeout          time.Duration
+	maxRetryInterval time.Duration
+	spoolDir         string
+	spoolMaxSize     int64
+}
+
+func parseWebhookConfig(config map[interface{}]interface{}) (*webhookConfig, error) {
+	ret := &webhookConfig{
+		headers:          map[string]string{},
+		contentType:      "application/x-ndjson",
+		batchSize:        100,
+		batchInterval:    time.Second,
+		queueSize:        1000,
+		timeout:          10 * time.Second,
+		maxRetryInterval: 30 * time.Second,
+		spoolMaxSize:     100 * 1024 * 1024,
+	}
+
+	if value, found := config["url"]; !found {
+		return nil, errors.New("missing webhook url")
+	} else if u, ok := value.(string); ok && u != "" {
+		ret.url = u
+	} else {
+		return nil, errors.Errorf("invalid webhook url %v", value)
+	}
+
+	if value, found := config["secret"]; !found {
+		return nil, errors.New("missing webhook secret, required for HMAC signing")
+	} else if s, ok := value.(string); ok && s != "" {
+		ret.secret = []byte(s)
+	} else {
+		return nil, errors.New("invalid webhook secret, must be a non-empty string")
+	}
+
+	if value, found := config["contentType"]; found {
+		if s, ok := value.(string); ok {
+			ret.contentType = s
+		}
+	}
+
+	if value, found := config["headers"]; found {
+		headers, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.New("invalid webhook headers, must be a map")
+		}
+		for k, v := range headers {
+			ret.headers[fmt.Sprintf("%v", k)] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	if value, found := config["batchSize"]; found {
+		if v, ok := value.(int); ok && v > 0 {
+			ret.batchSize = v
+		} else {
+			return nil, errors.Errorf("invalid webhook batchSize %v, must be a positive integer", value)
+		}
+	}
+
+	if value, found := config["queueSize"]; found {
+		if v, ok := value.(int); ok && v > 0 {
+			ret.queueSize = v
+		} else {
+			return nil, errors.Errorf("invalid webhook queueSize %v, must be a positive integer", value)
+		}
+	}
+
+	var err error
+	if ret.batchInterval, err = parseWebhookDuration(config, "batchInterval", ret.batchInterval); err != nil {
+		return nil, err
+	}
+	if ret.timeout, err = parseWebhookDuration(config, "timeout", ret.timeout); err != nil {
+		return nil, err
+	}
+	if ret.maxRetryInterval, err = parseWebhookDuration(config, "maxRetryInterval", ret.maxRetryInterval); err != nil {
+		return nil, err
+	}
+
+	if value, found := config["spoolDir"]; found {
+		if s, ok := value.(string); ok {
+			ret.spoolDir = s
+		} else {
+			return nil, errors.New("invalid webhook spoolDir, must be a string")
+		}
+	}
+
+	if value, found := config["spoolMaxSizeMb"]; found {
+		if v, ok := value.(int); ok && v > 0 {
+			ret.spoolMaxSize = int64(v) * 1024 * 1024
+		} else {
+			return nil, errors.Errorf("invalid webhook spoolMaxSizeMb %v, must be a positive integer", value)
+		}
+	}
+
+	return ret, nil
+}
+
+func parseWebhookDuration(config map[interface{}]interface{}, key string, defaultValue time.Duration) (time.Duration, error) {
+	value, found := config[key]
+	if !found {
+		return defaultValue, nil
+	}
+	strVal, ok := value.(string)
+	if !ok {
+		return 0, errors.Errorf("invalid type %T for webhook %v, must be a duration string", value, key)
+	}
+	result, err := time.ParseDuration(strVal)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid duration value for webhook %v: '%v'", key, strVal)
+	}
+	if result <= 0 {
+		return 0, errors.Errorf("webhook %v must be positive, got '%v'", key, strVal)
+	}
+	return result, nil
+}
+
+type webhookWriteCloser struct {
+	config   *webhookConfig
+	client   *http.Client
+	spool    *webhookSpool
+	messages chan []byte
+	spooled  chan struct{}
+	ctx      context.Context
+	cancel   context.CancelFunc
+	closed   atomic.Bool
+	done     sync.WaitGroup
+}
+
+func newWebhookWriteCloser(config *webhookConfig) (*webhookWriteCloser, error) {
+	spool, err := newWebhookSpool(config.spoolDir, config.spoolMaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := &webhookWriteCloser{
+		config:   config,
+		client:   &http.Client{Timeout: config.timeout},
+		spool:    spool,
+		messages: make(chan []byte, config.queueSize),
+		spooled:  make(chan struct{}, 1),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+
+	result.done.Add(2)
+	go result.run()
+	go result.retrySpool()
+
+	// batches spooled before a restart are sent straight away
+	if spool.hasEntries() {
+		result.notifySpooled()
+	}
+
+	return result, nil
+}
+
+func (self *webhookWriteCloser) Write(data []byte) (int, error) {
+	if self.closed.Load() {
+		return 0, errors.New("webhook event handler closed")
+	}
+
+	select {
+	case self.messages <- data:
+		return len(data), nil
+	case <-self.ctx.Done():
+		return 0, errors.New("webhook event handler closed")
+	default:
+		return 0, errors.Errorf("webhook queue full. Message: %s", string(data))
+	}
+}
+
+func (self *webhookWriteCloser) Close() error {
+	if self.closed.CompareAndSwap(false, true) {
+		self.cancel()
+		self.done.Wait()
+	}
+	return nil
+}
+
+// run collects events into batches. Delivery is only tried once here, so an unavailable endpoint doesn't stop
+// events being taken off the queue. Retries are left to retrySpool
+func (self *webhookWriteCloser) run() {
+	defer self.done.Done()
+
+	ticker := time.NewTicker(self.config.batchInterval)
+	defer ticker.Stop()
+
+	var batch [][]byte
+
+	for {
+		select {
+		case msg := <-self.messages:
+			batch = append(batch, msg)
+			if len(batch) >= self.config.batchSize {
+				self.flush(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				self.flush(batch)
+				batch = nil
+			}
+		case <-self.ctx.Done():
+			// anything still queued is spooled, so it can be sent when the controller restarts
+			for drained := false; !drained; {
+				select {
+				case msg := <-self.messages:
+					batch = append(batch, msg)
+				default:
+					drained = true
+				}
+			}
+			if len(batch) > 0 {
+				if self.spool.isPersistent() {
+					self.spoolBatch(self.encodeBatch(batch))
+				} else {
+					pfxlog.Logger().WithField("url", self.config.url).
+						Warnf("no webhook spoolDir configured, dropping %d undelivered events on shutdown", len(batch))
+				}
+			}
+			return
+		}
+	}
+}
+
+func (self *webhookWriteCloser) encodeBatch(batch [][]byte) []byte {
+	// binary formats are self-delimiting, text formats are newline delimited
+	if self.config.binary {
+		return bytes.Join(batch, nil)
+	}
+	return bytes.Join(batch, []byte("\n"))
+}
+
+func (self *webhookWriteCloser) flush(batch [][]byte) {
+	body := self.encodeBatch(batch)
+
+	// older, spooled batches go first, to preserve ordering
+	if self.spool.hasEntries() {
+		self.spoolBatch(body)
+		return
+	}
+
+	if err := self.send(body); err != nil {
+		var rejected *webhookRejectedError
+		if errors.As(err, &rejected) {
+			pfxlog.Logger().WithError(err).WithField("url", self.config.url).Errorf("webhook rejected batch of %d events, dropping", len(batch))
+			return
+		}
+		pfxlog.Logger().WithError(err).WithField("url", self.config.url).Warn("unable to deliver batch to webhook, spooling for retry")
+		self.spoolBatch(body)
+	}
+}
+
+func (self *webhookWriteCloser) spoolBatch(body []byte) {
+	if err := self.spool.add(body); err != nil {
+		pfxlog.Logger().WithError(err).WithField("url", self.config.url).Error("unable to spool webhook batch, dropping")
+		return
+	}
+	self.notifySpooled()
+}
+
+func (self *webhookWriteCloser) notifySpooled() {
+	select {
+	case self.spooled <- struct{}{}:
+	default:
+	}
+}
+
+// retrySpool sends spooled batches. It's woken when a batch is spooled, and while batches can't be delivered it
+// retries with an exponential backoff
+func (self *webhookWriteCloser) retrySpool() {
+	defer self.done.Done()
+
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = 500 * time.Millisecond
+	expBackoff.MaxInterval = self.config.maxRetryInterval
+	expBackoff.MaxElapsedTime = 0
+
+	// retryC is only set while backing off, so new batches don't cut the backoff short
+	var retryC <-chan time.Time
+
+	for {
+		select {
+		case <-self.spooled:
+			if retryC != nil {
+				continue
+			}
+		case <-retryC:
+		case <-self.ctx.Done():
+			return
+		}
+
+		if self.drainSpool() {
+			expBackoff.Reset()
+			retryC = nil
+		} else {
+			retryC = time.After(expBackoff.NextBackOff())
+		}
+	}
+}
+
+// drainSpool sends spooled batches, oldest first. It returns true if the spool was fully drained
+func (self *webhookWriteCloser) drainSpool() bool {
+	for {
+		entry, body, found, err := self.spool.oldest()
+		if err != nil {
+			pfxlog.Logger().WithError(err).WithField("entry", entry).Error("unable to read webhook spool entry, removing")
+			self.spool.remove(entry)
+			continue
+		}
+
+		if !found {
+			return true
+		}
+
+		if err = self.send(body); err != nil {
+			var rejected *webhookRejectedError
+			if !errors.As(err, &rejected) {
+				pfxlog.Logger().WithError(err).WithField("url", self.config.url).Debug("unable to deliver spooled batch to webhook")
+				return false
+			}
+			pfxlog.Logger().WithError(err).WithField("entry", entry).Error("webhook rejected spooled batch, dropping")
+		}
+		self.spool.remove(entry)
+	}
+}
+
+// send makes a single delivery attempt
+func (self *webhookWriteCloser) send(body []byte) error {
+	req, err := http.NewRequestWithContext(self.ctx, http.MethodPost, self.config.url, bytes.NewReader(body))
+	if err != nil {
+		return &webhookRejectedError{err: err}
+	}
+
+	for k, v := range self.config.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", self.config.contentType)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookBody(self.config.secret, body))
+
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err = errors.Errorf("webhook returned status %v", resp.Status)
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+		return &webhookRejectedError{err: err}
+	}
+	return err
+}
+
+// webhookRejectedError indicates the endpoint rejected a batch, so retrying or spooling it won't help
+type webhookRejectedError struct {
+	err error
+}
+
+func (self *webhookRejectedError) Error() string {
+	return self.err.Error()
+}
+
+// SignWebhookBody returns the value of the signature header for the given body. The value has the
+// form sha256=<hex encoded HMAC-SHA256 of the body>
+func SignWebhookBody(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookSpool holds undelivered batches. If it has a directory, each batch is stored in its own file, named with a
+// zero padded sequence number, so lexical order is delivery order. Otherwise, batches are kept in memory. When the
+// spool exceeds its maximum size the oldest batches are dropped. The spool is used by both the batching and the
+// retry goroutines, so access is locked
+type webhookSpool struct {
+	lock    sync.Mutex
+	dir     string
+	maxSize int64
+	nextSeq uint64
+	memory  map[string][]byte
+}
+
+func newWebhookSpool(dir string, maxSize int64) (*webhookSpool, error) {
+	result := &webhookSpool{
+		dir:     dir,
+		maxSize: maxSize,
+	}
+
+	if dir == "" {
+		result.memory = map[string][]byte{}
+		return result, nil
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "unable to create webhook spool directory %v", dir)
+	}
+
+	entries, err := result.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) > 0 {
+		last := strings.TrimSuffix(filepath.Base(entries[len(entries)-1]), webhookSpoolSuffix)
+		seq, err := strconv.ParseUint(last, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid webhook spool entry %v", last)
+		}
+		result.nextSeq = seq + 1
+	}
+
+	return result, nil
+}
+
+func (self *webhookSpool) isPersistent() bool {
+	return self.dir != ""
+}
+
+func (self *webhookSpool) hasEntries() bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	entries, err := self.entries()
+	return err == nil && len(entries) > 0
+}
+
+// entries returns the spooled batches, oldest first. The caller must hold the lock, unless the spool is only used
+// from one goroutine
+func (self *webhookSpool) entries() ([]string, error) {
+	var result []string
+
+	if self.memory != nil {
+		for entry := range self.memory {
+			result = append(result, entry)
+		}
+	} else {
+		dirEntries, err := os.ReadDir(self.dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read webhook spool directory %v", self.dir)
+		}
+
+		for _, entry := range dirEntries {
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), webhookSpoolSuffix) {
+				result = append(result, filepath.Join(self.dir, entry.Name()))
+			}
+		}
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+// oldest returns the oldest spooled batch. If the batch can't be read, the entry is returned with the error, so the
+// caller can remove it
+func (self *webhookSpool) oldest() (string, []byte, bool, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	entries, err := self.entries()
+	if err != nil || len(entries) == 0 {
+		return "", nil, false, err
+	}
+
+	entry := entries[0]
+	if self.memory != nil {
+		return entry, self.memory[entry], true, nil
+	}
+
+	body, err := os.ReadFile(entry)
+	return entry, body, true, err
+}
+
+func (self *webhookSpool) add(body []byte) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	name := fmt.Sprintf("%020d%s", self.nextSeq, webhookSpoolSuffix)
+	self.nextSeq++
+
+	if self.memory != nil {
+		self.memory[name] = body
+		return self.enforceMaxSize()
+	}
+
+	name = filepath.Join(self.dir, name)
+	tmpName := name + ".tmp"
+	if err := os.WriteFile(tmpName, body, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpName, name); err != nil {
+		return err
+	}
+
+	return self.enforceMaxSize()
+}
+
+func (self *webhookSpool) remove(entry string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.removeUnlocked(entry)
+}
+
+func (self *webhookSpool) removeUnlocked(entry string) {
+	if self.memory != nil {
+		delete(self.memory, entry)
+		return
+	}
+
+	if err := os.Remove(entry); err != nil && !os.IsNotExist(err) {
+		pfxlog.Logger().WithError(err).WithField("file", entry).Error("unable to remove webhook spool entry")
+	}
+}
+
+func (self *webhookSpool) enforceMaxSize() error {
+	entries, err := self.entries()
+	if err != nil {
+		return err
+	}
+
+	var sizes []int64
+	var total int64
+	for _, entry := range entries {
+		var size int64
+		if self.memory != nil {
+			size = int64(len(self.memory[entry]))
+		} else {
+			info, err := os.Stat(entry)
+			if err != nil {
+				return err
+			}
+			size = info.Size()
+		}
+		sizes = append(sizes, size)
+		total += size
+	}
+
+	dropped := 0
+	// always keep the most recent batch, even if it alone exceeds the limit
+	for idx := 0; total > self.maxSize && idx < len(entries)-1; idx++ {
+		self.removeUnlocked(entries[idx])
+		total -= sizes[idx]
+		dropped++
+	}
+
+	if dropped > 0 {
+		pfxlog.Logger().WithField("dir", self.dir).Warnf("webhook spool full, dropped %d oldest batches", dropped)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WebhookBatchingAndSpooling(t *testing.T) {
+	req := require.New(t)
+
+	var available atomic.Bool
+	bodies := make(chan string, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(WebhookSignatureHeader) != SignWebhookBody([]byte("secret"), body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if !available.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		bodies <- string(body)
+	}))
+	defer server.Close()
+
+	spoolDir := t.TempDir()
+	conf, err := parseWebhookConfig(map[interface{}]interface{}{
+		"url":              server.URL,
+		"secret":           "secret",
+		"batchSize":        2,
+		"batchInterval":    "50ms",
+		"maxRetryInterval": "10ms",
+		"spoolDir":         spoolDir,
+	})
+	req.NoError(err)
+
+	writer, err := newWebhookWriteCloser(conf)
+	req.NoError(err)
+	defer func() { _ = writer.Close() }()
+
+	_, err = writer.Write([]byte("one"))
+	req.NoError(err)
+	_, err = writer.Write([]byte("two"))
+	req.NoError(err)
+
+	req.Eventually(func() bool {
+		return writer.spool.hasEntries()
+	}, 2*time.Second, 10*time.Millisecond)
+
+	available.Store(true)
+
+	_, err = writer.Write([]byte("three"))
+	req.NoError(err)
+
+	select {
+	case body := <-bodies:
+		req.Equal("one\ntwo", body)
+	case <-time.After(2 * time.Second):
+		req.Fail("timed out waiting for spooled batch")
+	}
+
+	select {
+	case body := <-bodies:
+		req.Equal("three", strings.TrimSpace(body))
+	case <-time.After(2 * time.Second):
+		req.Fail("timed out waiting for batch")
+	}
+
+	req.Eventually(func() bool {
+		return !writer.spool.hasEntries()
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func Test_WebhookIntakeContinuesWhileUnavailable(t *testing.T) {
+	req := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	conf, err := parseWebhookConfig(map[interface{}]interface{}{
+		"url":              server.URL,
+		"secret":           "secret",
+		"batchSize":        1,
+		"queueSize":        2,
+		"maxRetryInterval": "1h",
+	})
+	req.NoError(err)
+
+	writer, err := newWebhookWriteCloser(conf)
+	req.NoError(err)
+	defer func() { _ = writer.Close() }()
+
+	for i := 0; i < 20; i++ {
+		req.Eventually(func() bool {
+			_, err = writer.Write([]byte("event"))
+			return err == nil
+		}, time.Second, time.Millisecond)
+	}
+
+	req.Eventually(func() bool {
+		writer.spool.lock.Lock()
+		defer writer.spool.lock.Unlock()
+		entries, err := writer.spool.entries()
+		return err == nil && len(entries) == 20
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func Test_WebhookQueuedEventsSpooledOnClose(t *testing.T) {
+	req := require.New(t)
+
+	spoolDir := t.TempDir()
+	conf, err := parseWebhookConfig(map[interface{}]interface{}{
+		"url":           "http://127.0.0.1:1",
+		"secret":        "secret",
+		"batchSize":     100,
+		"batchInterval": "1h",
+		"spoolDir":      spoolDir,
+	})
+	req.NoError(err)
+
+	writer, err := newWebhookWriteCloser(conf)
+	req.NoError(err)
+
+	for _, msg := range []string{"one", "two", "three"} {
+		_, err = writer.Write([]byte(msg))
+		req.NoError(err)
+	}
+	req.NoError(writer.Close())
+
+	_, err = writer.Write([]byte("four"))
+	req.Error(err)
+
+	spool, err := newWebhookSpool(spoolDir, conf.spoolMaxSize)
+	req.NoError(err)
+	_, body, found, err := spool.oldest()
+	req.NoError(err)
+	req.True(found)
+	req.Equal("one\ntwo\nthree", string(body))
+}
+
+func Test_WebhookSpoolMaxSize(t *testing.T) {
+	req := require.New(t)
+
+	spool, err := newWebhookSpool(t.TempDir(), 10)
+	req.NoError(err)
+
+	req.NoError(spool.add([]byte("123456")))
+	req.NoError(spool.add([]byte("789012")))
+
+	entries, err := spool.entries()
+	req.NoError(err)
+	req.Len(entries, 1)
+	req.True(strings.HasSuffix(entries[0], "00000000000000000001"+webhookSpoolSuffix))
+
+	reopened, err := newWebhookSpool(spool.dir, 10)
+	req.NoError(err)
+	req.Equal(uint64(2), reopened.nextSeq)
+}
@@ -17,6 +17,7 @@
 package handler_ctrl
 
 import (
+	"context"
 	"github.com/openziti/ziti/controller/xt"
 	"google.golang.org/protobuf/proto"
 	"time"
@@ -145,3 +146,7 @@ func (self *circuitParams) GetLogContext() logcontext.Context {
 func (self *circuitParams) GetDeadline() time.Time {
 	return self.deadline
 }
+
+func (self *circuitParams) GetTraceContext() context.Context {
+	return context.Background()
+}
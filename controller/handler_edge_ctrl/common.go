@@ -1,6 +1,7 @@
 package handler_edge_ctrl
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/openziti/ziti/common"
 	"github.com/openziti/ziti/common/logcontext"
 	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/common/tracing"
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/env"
@@ -26,6 +28,7 @@ import (
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type requestHandler interface {
@@ -118,6 +121,36 @@ type baseSessionRequestContext struct {
 	logContext   logcontext.Context
 	env          model.Env
 	accessClaims *common.AccessClaims
+	traceCtx     context.Context
+	traceSpan    trace.Span
+	policySpan   trace.Span
+}
+
+// startCircuitTrace starts the span covering a circuit request, along with a child span for the session and
+// policy checks which precede circuit creation
+func (self *baseSessionRequestContext) startCircuitTrace(name string) {
+	self.traceCtx, self.traceSpan = tracing.Tracer().Start(context.Background(), name)
+	_, self.policySpan = tracing.Tracer().Start(self.traceCtx, "edge.policyCheck")
+}
+
+func (self *baseSessionRequestContext) endPolicyCheckTrace() {
+	if self.policySpan != nil && self.policySpan.IsRecording() {
+		tracing.EndSpan(self.policySpan, self.err)
+	}
+}
+
+func (self *baseSessionRequestContext) endCircuitTrace() {
+	self.endPolicyCheckTrace()
+	if self.traceSpan != nil {
+		tracing.EndSpan(self.traceSpan, self.err)
+	}
+}
+
+func (self *baseSessionRequestContext) getTraceContext() context.Context {
+	if self.traceCtx == nil {
+		return context.Background()
+	}
+	return self.traceCtx
 }
 
 func (self *baseSessionRequestContext) newChangeContext() *change.Context {
@@ -558,6 +591,8 @@ func (self *baseSessionRequestContext) createCircuit(terminatorInstanceId string
 	var circuit *network.Circuit
 	returnPeerData := map[uint32][]byte{}
 
+	self.endPolicyCheckTrace()
+
 	if self.err == nil {
 		if self.service.EncryptionRequired && peerData[edge.PublicKeyHeader] == nil {
 			self.err = encryptionDataMissing("encryption required on service, initiator did not send public header")
@@ -649,6 +684,10 @@ func (self *sessionCircuitParams) GetDeadline() time.Time {
 	return self.deadline
 }
 
+func (self *sessionCircuitParams) GetTraceContext() context.Context {
+	return self.reqCtx.getTraceContext()
+}
+
 type tunnelCircuitParams struct {
 	serviceId    string
 	sourceRouter *network.Router
@@ -693,3 +732,7 @@ func (self *tunnelCircuitParams) GetLogContext() logcontext.Context {
 func (self *tunnelCircuitParams) GetDeadline() time.Time {
 	return self.deadline
 }
+
+func (self *tunnelCircuitParams) GetTraceContext() context.Context {
+	return self.reqCtx.getTraceContext()
+}
@@ -77,12 +77,13 @@ func (self *createCircuitHandler) HandleReceiveCreateCircuitV1(msg *channel.Mess
 	self.CreateCircuit(ctx, self.CreateCircuitV1Response)
 }
 
-func (self *createCircuitHandler) CreateCircuitV1Response(circuitInfo *network.Circuit, peerData map[uint32][]byte) (*channel.Message, error) {
+func (self *createCircuitHandler) CreateCircuitV1Response(circuitInfo *network.Circuit, peerData map[uint32][]byte, rateLimits *edge_ctrl_pb.CircuitRateLimits) (*channel.Message, error) {
 	response := &edge_ctrl_pb.CreateCircuitResponse{
-		CircuitId: circuitInfo.Id,
-		Address:   circuitInfo.Path.IngressId,
-		PeerData:  peerData,
-		Tags:      circuitInfo.Tags,
+		CircuitId:  circuitInfo.Id,
+		Address:    circuitInfo.Path.IngressId,
+		PeerData:   peerData,
+		Tags:       circuitInfo.Tags,
+		RateLimits: rateLimits,
 	}
 
 	body, err := proto.Marshal(response)
@@ -108,18 +109,22 @@ func (self *createCircuitHandler) HandleReceiveCreateCircuitV2(msg *channel.Mess
 	self.CreateCircuit(ctx, self.CreateCircuitV2Response)
 }
 
-func (self *createCircuitHandler) CreateCircuitV2Response(circuitInfo *network.Circuit, peerData map[uint32][]byte) (*channel.Message, error) {
+func (self *createCircuitHandler) CreateCircuitV2Response(circuitInfo *network.Circuit, peerData map[uint32][]byte, rateLimits *edge_ctrl_pb.CircuitRateLimits) (*channel.Message, error) {
 	response := &ctrl_msg.CreateCircuitResponse{
-		CircuitId: circuitInfo.Id,
-		Address:   circuitInfo.Path.IngressId,
-		PeerData:  peerData,
-		Tags:      circuitInfo.Tags,
+		CircuitId:  circuitInfo.Id,
+		Address:    circuitInfo.Path.IngressId,
+		PeerData:   peerData,
+		Tags:       circuitInfo.Tags,
+		RateLimits: rateLimits,
 	}
 
-	return response.ToMessage(), nil
+	return response.ToMessage()
 }
 
 func (self *createCircuitHandler) CreateCircuit(ctx *CreateCircuitRequestContext, f createCircuitResponseFactory) {
+	ctx.startCircuitTrace("edge.createCircuit")
+	defer ctx.endCircuitTrace()
+
 	if !ctx.loadRouter() {
 		return
 	}
@@ -136,9 +141,10 @@ func (self *createCircuitHandler) CreateCircuit(ctx *CreateCircuitRequestContext
 	}
 
 	log := pfxlog.ContextLogger(self.ch.Label()).WithField("token", ctx.req.GetSessionToken())
-	responseMsg, err := f(circuitInfo, peerData)
+	responseMsg, err := f(circuitInfo, peerData, ctx.getRateLimits())
 	if err != nil {
 		log.WithError(err).Error("error generating create circuit response")
+		return
 	}
 	log.Debugf("responding with successful circuit setup")
 
@@ -148,7 +154,7 @@ func (self *createCircuitHandler) CreateCircuit(ctx *CreateCircuitRequestContext
 	}
 }
 
-type createCircuitResponseFactory func(*network.Circuit, map[uint32][]byte) (*channel.Message, error)
+type createCircuitResponseFactory func(*network.Circuit, map[uint32][]byte, *edge_ctrl_pb.CircuitRateLimits) (*channel.Message, error)
 
 var _ CreateCircuitRequest = (*edge_ctrl_pb.CreateCircuitRequest)(nil)
 
@@ -83,6 +83,9 @@ func (self *createCircuitForServiceHandler) HandleReceive(msg *channel.Message,
 }
 
 func (self *createCircuitForServiceHandler) CreateCircuit(ctx *CreateCircuitForServiceRequestContext) {
+	ctx.startCircuitTrace("edge.createCircuitForService")
+	defer ctx.endCircuitTrace()
+
 	if !ctx.loadRouter() {
 		return
 	}
@@ -76,6 +76,9 @@ func (self *createTunnelCircuitV2Handler) HandleReceive(msg *channel.Message, ch
 }
 
 func (self *createTunnelCircuitV2Handler) CreateCircuit(ctx *CreateTunnelCircuitV2RequestContext) {
+	ctx.startCircuitTrace("edge.createTunnelCircuit")
+	defer ctx.endCircuitTrace()
+
 	if !ctx.loadRouter() {
 		return
 	}
@@ -0,0 +1,74 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_edge_ctrl
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/router/xgress_common"
+)
+
+// getRateLimits returns the limits which tell the ingress edge router how fast to move data for the dialing identity.
+// Limits can be set in the service tags and in the identity app data. They're kept separate, as the router shares
+// the identity limits across all the identity's circuits, and the service limits across all the service's circuits.
+// Invalid limits are logged and ignored. If no limits are set, nil is returned
+func getRateLimits(service *model.Service, identity *model.Identity) *edge_ctrl_pb.CircuitRateLimits {
+	result := &edge_ctrl_pb.CircuitRateLimits{}
+
+	if service != nil {
+		result.ServiceId = service.Id
+		result.ServiceIngress = parseRateLimit(service.Tags, xgress_common.IngressRateLimitKey, "serviceId", service.Id)
+		result.ServiceEgress = parseRateLimit(service.Tags, xgress_common.EgressRateLimitKey, "serviceId", service.Id)
+	}
+
+	if identity != nil {
+		result.IdentityId = identity.Id
+		result.IdentityIngress = parseRateLimit(identity.AppData, xgress_common.IngressRateLimitKey, "identityId", identity.Id)
+		result.IdentityEgress = parseRateLimit(identity.AppData, xgress_common.EgressRateLimitKey, "identityId", identity.Id)
+	}
+
+	if result.ServiceIngress == 0 && result.ServiceEgress == 0 && result.IdentityIngress == 0 && result.IdentityEgress == 0 {
+		return nil
+	}
+
+	return result
+}
+
+func parseRateLimit(values map[string]interface{}, key string, entityField string, entityId string) uint64 {
+	val, found := values[key]
+	if !found || val == nil {
+		return 0
+	}
+
+	limit, err := xgress_common.ParseRateLimit(val)
+	if err != nil {
+		pfxlog.Logger().WithField(entityField, entityId).WithField("key", key).WithError(err).Warn("ignoring invalid rate limit")
+	}
+	return limit
+}
+
+// getRateLimits returns the rate limits for the session's service and identity
+func (self *baseSessionRequestContext) getRateLimits() *edge_ctrl_pb.CircuitRateLimits {
+	var identity *model.Identity
+	if self.apiSession != nil && self.apiSession.Identity != nil {
+		identity = self.apiSession.Identity
+	} else if self.session != nil {
+		identity, _ = self.handler.getAppEnv().Managers.Identity.Read(self.session.IdentityId)
+	}
+	return getRateLimits(self.service, identity)
+}
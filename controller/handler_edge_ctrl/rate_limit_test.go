@@ -0,0 +1,69 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_edge_ctrl
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/controller/model"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGetRateLimits(t *testing.T) {
+	req := require.New(t)
+
+	service := &model.Service{BaseEntity: models.BaseEntity{Id: "svc", Tags: map[string]interface{}{}}}
+	identity := &model.Identity{BaseEntity: models.BaseEntity{Id: "id"}, AppData: map[string]interface{}{}}
+
+	req.Nil(getRateLimits(service, identity))
+	req.Nil(getRateLimits(nil, nil))
+
+	service.Tags["ingressRateLimit"] = "5000000"
+	service.Tags["egressRateLimit"] = 1_000_000
+	req.True(proto.Equal(&edge_ctrl_pb.CircuitRateLimits{
+		IdentityId:     "id",
+		ServiceId:      "svc",
+		ServiceIngress: 5_000_000,
+		ServiceEgress:  1_000_000,
+	}, getRateLimits(service, identity)))
+
+	// app data comes from json, so numbers are floats. Identity and service limits are kept separate, since the
+	// router shares them across different sets of circuits
+	identity.AppData["ingressRateLimit"] = float64(2_000_000)
+	identity.AppData["egressRateLimit"] = float64(4_000_000)
+	req.True(proto.Equal(&edge_ctrl_pb.CircuitRateLimits{
+		IdentityId:      "id",
+		IdentityIngress: 2_000_000,
+		IdentityEgress:  4_000_000,
+		ServiceId:       "svc",
+		ServiceIngress:  5_000_000,
+		ServiceEgress:   1_000_000,
+	}, getRateLimits(service, identity)))
+
+	// invalid limits are ignored
+	service.Tags["ingressRateLimit"] = "lots"
+	delete(service.Tags, "egressRateLimit")
+	req.True(proto.Equal(&edge_ctrl_pb.CircuitRateLimits{
+		IdentityId:      "id",
+		IdentityIngress: 2_000_000,
+		IdentityEgress:  4_000_000,
+		ServiceId:       "svc",
+	}, getRateLimits(service, identity)))
+}
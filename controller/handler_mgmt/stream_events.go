@@ -26,16 +26,26 @@ import (
 	"github.com/openziti/ziti/common/handler_common"
 	"github.com/openziti/ziti/common/pb/mgmt_pb"
 	"io"
+	"sync/atomic"
+	"time"
 )
 
+const journalStreamQueueSize = 1024
+
 type StreamEventsRequest struct {
 	Format        string                `json:"format"`
 	Subscriptions []*event.Subscription `json:"subscriptions"`
+
+	// FromSequence and FromTimestamp request that events be streamed from the event journal, starting with the
+	// given sequence number or time. Journaled events carry their sequence number in the EventSequenceHeader.
+	FromSequence  *uint64    `json:"fromSequence,omitempty"`
+	FromTimestamp *time.Time `json:"fromTimestamp,omitempty"`
 }
 
 type streamEventsHandler struct {
-	network             *network.Network
-	eventStreamHandlers []io.Closer
+	network               *network.Network
+	eventStreamHandlers   []io.Closer
+	journalStreamHandlers []*journalStreamHandler
 }
 
 func newStreamEventsHandler(network *network.Network) *streamEventsHandler {
@@ -55,6 +65,11 @@ func (handler *streamEventsHandler) HandleReceive(msg *channel.Message, ch chann
 		return
 	}
 
+	if request.FromSequence != nil || request.FromTimestamp != nil {
+		handler.streamFromJournal(msg, ch, request)
+		return
+	}
+
 	formatterFactory := dispatcher.GetFormatterFactory(request.Format)
 	if formatterFactory == nil {
 		handler_common.SendFailure(msg, ch, fmt.Sprintf("invalid format ['%v']", request.Format))
@@ -74,7 +89,62 @@ func (handler *streamEventsHandler) HandleReceive(msg *channel.Message, ch chann
 	}
 }
 
+func (handler *streamEventsHandler) streamFromJournal(msg *channel.Message, ch channel.Channel, request *StreamEventsRequest) {
+	journal := handler.network.GetEventDispatcher().GetEventJournal()
+	if journal == nil {
+		handler_common.SendFailure(msg, ch, "event journal is not enabled")
+		return
+	}
+
+	journaled := map[string]struct{}{}
+	for _, ns := range journal.Namespaces() {
+		journaled[ns] = struct{}{}
+	}
+
+	for _, sub := range request.Subscriptions {
+		if _, found := journaled[sub.Type]; !found {
+			handler_common.SendFailure(msg, ch, fmt.Sprintf("event type ['%v'] is not journaled, journaled types: %v", sub.Type, journal.Namespaces()))
+			return
+		}
+	}
+
+	streamHandler := &journalStreamHandler{
+		ch:          ch,
+		entries:     make(chan *event.JournalEntry, journalStreamQueueSize),
+		closeNotify: make(chan struct{}),
+	}
+
+	// the replayer applies the subscription options and format, the same as for live event streams
+	replayer, err := journal.NewReplayer(request.Format, request.Subscriptions, streamHandler)
+	if err != nil {
+		handler_common.SendFailure(msg, ch, err.Error())
+		return
+	}
+	streamHandler.replayer = replayer
+
+	position := event.JournalPosition{
+		FromTimestamp: request.FromTimestamp,
+	}
+	if request.FromSequence != nil {
+		position.FromSequence = *request.FromSequence
+	}
+
+	replay, gap := journal.Follow(position, streamHandler)
+	handler.journalStreamHandlers = append(handler.journalStreamHandlers, streamHandler)
+
+	handler_common.SendSuccess(msg, ch, "success")
+
+	go streamHandler.run(replay, gap)
+}
+
 func (handler *streamEventsHandler) HandleClose(channel.Channel) {
+	if journal := handler.network.GetEventDispatcher().GetEventJournal(); journal != nil {
+		for _, streamHandler := range handler.journalStreamHandlers {
+			journal.Unfollow(streamHandler)
+			streamHandler.stop()
+		}
+	}
+
 	for _, streamHandler := range handler.eventStreamHandlers {
 		handler.network.GetEventDispatcher().RemoveAllSubscriptions(streamHandler)
 		if err := streamHandler.Close(); err != nil {
@@ -101,3 +171,88 @@ func (handler *EventsStreamHandler) close() {
 		pfxlog.Logger().WithError(err).Errorf("failure while closing handler")
 	}
 }
+
+// journalStreamHandler sends replayed journal entries, followed by live entries, to a stream consumer. If the
+// consumer can't keep up, the channel is closed and the consumer can resume from the last sequence number received.
+type journalStreamHandler struct {
+	ch          channel.Channel
+	replayer    event.JournalReplayer
+	entries     chan *event.JournalEntry
+	closeNotify chan struct{}
+	closed      atomic.Bool
+}
+
+func (handler *journalStreamHandler) AcceptJournalEntry(entry *event.JournalEntry) {
+	select {
+	case handler.entries <- entry:
+	default:
+		if handler.closed.CompareAndSwap(false, true) {
+			pfxlog.Logger().Warn("event journal stream consumer is too slow, closing channel")
+			close(handler.closeNotify)
+			// entries are delivered while the journal is locked, and closing the channel unfollows the journal
+			go handler.close()
+		}
+	}
+}
+
+func (handler *journalStreamHandler) stop() {
+	if handler.closed.CompareAndSwap(false, true) {
+		close(handler.closeNotify)
+	}
+	if err := handler.replayer.Close(); err != nil {
+		pfxlog.Logger().WithError(err).Error("error while closing event journal replayer")
+	}
+}
+
+func (handler *journalStreamHandler) run(replay []*event.JournalEntry, gap *event.JournalGap) {
+	if gap != nil {
+		body, err := json.Marshal(gap)
+		if err != nil {
+			pfxlog.Logger().WithError(err).Error("unable to marshal event journal gap")
+			return
+		}
+		msg := channel.NewMessage(int32(mgmt_pb.ContentType_StreamEventsEventType), body)
+		msg.PutStringHeader(int32(mgmt_pb.Header_EventTypeHeader), event.JournalGapEventType)
+		if !handler.send(msg) {
+			return
+		}
+	}
+
+	for _, entry := range replay {
+		handler.replayer.Replay(entry)
+	}
+
+	for {
+		select {
+		case entry := <-handler.entries:
+			handler.replayer.Replay(entry)
+		case <-handler.closeNotify:
+			return
+		}
+	}
+}
+
+func (handler *journalStreamHandler) AcceptJournaledEvent(sequence uint64, eventType string, formattedEvent []byte) {
+	if handler.closed.Load() {
+		return
+	}
+	msg := channel.NewMessage(int32(mgmt_pb.ContentType_StreamEventsEventType), formattedEvent)
+	msg.PutStringHeader(int32(mgmt_pb.Header_EventTypeHeader), eventType)
+	msg.PutUint64Header(int32(mgmt_pb.Header_EventSequenceHeader), sequence)
+	handler.send(msg)
+}
+
+func (handler *journalStreamHandler) send(msg *channel.Message) bool {
+	if err := handler.ch.Send(msg); err != nil {
+		pfxlog.Logger().Errorf("unexpected error sending StreamEventsEvent (%s)", err)
+		handler.close()
+		return false
+	}
+	return true
+}
+
+func (handler *journalStreamHandler) close() {
+	if err := handler.ch.Close(); err != nil {
+		pfxlog.Logger().WithError(err).Errorf("failure while closing handler")
+	}
+}
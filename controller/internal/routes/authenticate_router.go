@@ -254,7 +254,7 @@ func (ro *AuthRouter) authMfa(ae *env.AppEnv, rc *response.RequestContext, mfaCo
 		return
 	}
 
-	ok, _ := ae.Managers.Mfa.Verify(mfa, *mfaCode.Code, rc.NewChangeContext())
+	ok, _ := ae.Managers.Authenticator.VerifyMfa(mfa, *mfaCode.Code, rc.NewChangeContext())
 
 	if !ok {
 		rc.RespondWithError(apierror.NewInvalidMfaTokenError())
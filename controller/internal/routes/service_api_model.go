@@ -17,9 +17,12 @@
 package routes
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/edge-api/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/stringz"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/env"
@@ -104,6 +107,35 @@ func MapPatchServiceToModel(id string, service *rest_model.ServicePatch) *model.
 	return ret
 }
 
+// servicePathPolicy holds the path constraints of a service. The edge API service model doesn't include them, so
+// they're read from the request body, letting a service and its constraints be created or updated together
+type servicePathPolicy struct {
+	AvoidRouters  []string `json:"avoidRouters"`
+	EgressRouters []string `json:"egressRouters"`
+	MaxHops       int32    `json:"maxHops"`
+}
+
+// setServicePathPolicy sets the path constraints in the request body on the service
+func setServicePathPolicy(body []byte, service *model.Service) error {
+	if len(body) == 0 {
+		return nil
+	}
+
+	policy := &servicePathPolicy{}
+	if err := json.Unmarshal(body, policy); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return errorz.NewFieldError(fmt.Sprintf("%v must be of type %v", typeErr.Field, typeErr.Type), typeErr.Field, typeErr.Value)
+		}
+		return err
+	}
+
+	service.AvoidRouters = policy.AvoidRouters
+	service.EgressRouters = policy.EgressRouters
+	service.MaxHops = policy.MaxHops
+	return nil
+}
+
 func MapServiceToRestEntity(ae *env.AppEnv, rc *response.RequestContext, service *model.ServiceDetail) (interface{}, error) {
 	return MapServiceToRestModel(ae, rc, service)
 }
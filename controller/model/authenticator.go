@@ -68,6 +68,30 @@ type AuthContext interface {
 	GetCerts() []*x509.Certificate
 	GetHeaders() map[string]interface{}
 	GetChangeContext() *change.Context
+	GetAttempt() *AuthAttempt
+}
+
+// AuthAttempt collects what an AuthProcessor was able to resolve while processing an AuthContext, so that
+// failed attempts can be reported with as much detail as is known.
+type AuthAttempt struct {
+	IdentityId      string
+	AuthenticatorId string
+	AuthPolicyId    string
+	FailureReason   string
+}
+
+func (attempt *AuthAttempt) SetIdentity(identity *Identity) {
+	if identity != nil {
+		attempt.IdentityId = identity.Id
+		attempt.AuthPolicyId = identity.AuthPolicyId
+	}
+}
+
+func (attempt *AuthAttempt) SetAuthenticator(authenticator *Authenticator) {
+	if authenticator != nil {
+		attempt.AuthenticatorId = authenticator.Id
+		attempt.IdentityId = authenticator.IdentityId
+	}
 }
 
 type AuthContextHttp struct {
@@ -76,6 +100,7 @@ type AuthContextHttp struct {
 	Certs         []*x509.Certificate
 	Headers       map[string]interface{}
 	ChangeContext *change.Context
+	Attempt       AuthAttempt
 }
 
 func NewAuthContextHttp(request *http.Request, method string, data interface{}, ctx *change.Context) AuthContext {
@@ -118,6 +143,10 @@ func (context *AuthContextHttp) GetChangeContext() *change.Context {
 	return context.ChangeContext
 }
 
+func (context *AuthContextHttp) GetAttempt() *AuthAttempt {
+	return &context.Attempt
+}
+
 var _ AuthResult = &AuthResultBase{}
 
 type AuthResultBase struct {
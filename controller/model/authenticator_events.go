@@ -0,0 +1,103 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package model
+
+import (
+	"time"
+
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/event"
+)
+
+// AuthMethodTotp is the auth method reported for MFA challenge responses
+const AuthMethodTotp = "totp"
+
+// Failure reasons reported in edge.authentications events
+const (
+	AuthFailureUnsupportedMethod   = "UNSUPPORTED_METHOD"
+	AuthFailureInvalidCredentials  = "INVALID_CREDENTIALS"
+	AuthFailureMissingCredentials  = "MISSING_CREDENTIALS"
+	AuthFailureUnknownIdentity     = "UNKNOWN_IDENTITY"
+	AuthFailureIdentityDisabled    = "IDENTITY_DISABLED"
+	AuthFailureDeniedByAuthPolicy  = "DENIED_BY_AUTH_POLICY"
+	AuthFailureMaxAttemptsExceeded = "MAX_ATTEMPTS_EXCEEDED"
+	AuthFailureCertRejected        = "CERT_REJECTED"
+	AuthFailureCertExpired         = "CERT_EXPIRED"
+	AuthFailureJwtSignature        = "JWT_SIGNATURE_INVALID"
+	AuthFailureJwtClaims           = "JWT_CLAIMS_INVALID"
+	AuthFailureInvalidMfaCode      = "INVALID_MFA_CODE"
+	AuthFailureInternalError       = "INTERNAL_ERROR"
+)
+
+// dispatchAuthenticationEvent reports the outcome of a primary authentication attempt. Failures which
+// weren't given a more specific reason by the AuthProcessor are reported as invalid credentials.
+func dispatchAuthenticationEvent(env Env, context AuthContext, result AuthResult, err error) {
+	attempt := context.GetAttempt()
+
+	evt := newAuthenticationEvent(context.GetMethod(), context.GetChangeContext())
+	evt.IdentityId = attempt.IdentityId
+	evt.AuthenticatorId = attempt.AuthenticatorId
+	evt.AuthPolicyId = attempt.AuthPolicyId
+
+	if err == nil && result != nil && result.IsSuccessful() {
+		evt.EventType = event.AuthenticationEventTypeSuccess
+		evt.IdentityId = result.IdentityId()
+		if result.AuthenticatorId() != "" {
+			evt.AuthenticatorId = result.AuthenticatorId()
+		}
+		if result.AuthPolicyId() != "" {
+			evt.AuthPolicyId = result.AuthPolicyId()
+		}
+	} else {
+		evt.EventType = event.AuthenticationEventTypeFailure
+		evt.FailureReason = attempt.FailureReason
+		if evt.FailureReason == "" {
+			evt.FailureReason = AuthFailureInvalidCredentials
+		}
+	}
+
+	env.GetHostController().GetNetwork().GetEventDispatcher().AcceptAuthenticationEvent(evt)
+}
+
+// dispatchLockoutEvent reports that an identity was disabled because it exceeded the number of failed
+// attempts allowed by its auth policy
+func dispatchLockoutEvent(env Env, context AuthContext) {
+	attempt := context.GetAttempt()
+
+	evt := newAuthenticationEvent(context.GetMethod(), context.GetChangeContext())
+	evt.EventType = event.AuthenticationEventTypeLockout
+	evt.IdentityId = attempt.IdentityId
+	evt.AuthenticatorId = attempt.AuthenticatorId
+	evt.AuthPolicyId = attempt.AuthPolicyId
+	evt.FailureReason = AuthFailureMaxAttemptsExceeded
+
+	env.GetHostController().GetNetwork().GetEventDispatcher().AcceptAuthenticationEvent(evt)
+}
+
+func newAuthenticationEvent(method string, ctx *change.Context) *event.AuthenticationEvent {
+	evt := &event.AuthenticationEvent{
+		Namespace:  event.AuthenticationEventNS,
+		Timestamp:  time.Now(),
+		AuthMethod: method,
+	}
+
+	if source := ctx.GetSource(); source != nil {
+		evt.RemoteAddress = source.RemoteAddr
+	}
+
+	return evt
+}
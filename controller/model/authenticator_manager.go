@@ -33,6 +33,7 @@ import (
 	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/command"
 	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
 	"github.com/openziti/ziti/controller/fields"
 	"github.com/openziti/ziti/controller/models"
 	"github.com/openziti/ziti/controller/network"
@@ -76,10 +77,41 @@ func (self *AuthenticatorManager) Authorize(authContext AuthContext) (AuthResult
 	authModule := self.env.GetAuthRegistry().GetByMethod(authContext.GetMethod())
 
 	if authModule == nil {
+		authContext.GetAttempt().FailureReason = AuthFailureUnsupportedMethod
+		dispatchAuthenticationEvent(self.env, authContext, nil, nil)
 		return nil, apierror.NewInvalidAuthMethod()
 	}
 
-	return authModule.Process(authContext)
+	result, err := authModule.Process(authContext)
+	dispatchAuthenticationEvent(self.env, authContext, result, err)
+
+	return result, err
+}
+
+// VerifyMfa checks a TOTP or recovery code supplied to complete authentication and reports the outcome
+// as an authentication event
+func (self *AuthenticatorManager) VerifyMfa(mfa *Mfa, code string, ctx *change.Context) (bool, error) {
+	ok, err := self.env.GetManagers().Mfa.Verify(mfa, code, ctx)
+
+	evt := newAuthenticationEvent(AuthMethodTotp, ctx)
+	evt.IdentityId = mfa.IdentityId
+	if identity, _ := self.env.GetManagers().Identity.Read(mfa.IdentityId); identity != nil {
+		evt.AuthPolicyId = identity.AuthPolicyId
+	}
+
+	if ok {
+		evt.EventType = event.AuthenticationEventTypeMfaSuccess
+	} else {
+		evt.EventType = event.AuthenticationEventTypeMfaFailure
+		evt.FailureReason = AuthFailureInvalidMfaCode
+		if err != nil {
+			evt.FailureReason = AuthFailureInternalError
+		}
+	}
+
+	self.env.GetHostController().GetNetwork().GetEventDispatcher().AcceptAuthenticationEvent(evt)
+
+	return ok, err
 }
 
 func (self *AuthenticatorManager) ReadFingerprints(authenticatorId string) ([]string, error) {
@@ -122,11 +122,13 @@ func (module *AuthModuleCert) Process(context AuthContext) (AuthResult, error) {
 
 	if err != nil {
 		logger.WithError(err).Error("error obtaining client certificates")
+		context.GetAttempt().FailureReason = AuthFailureCertRejected
 		return nil, err
 	}
 
 	if len(certs) == 0 {
 		logger.Error("no client certificates found")
+		context.GetAttempt().FailureReason = AuthFailureMissingCredentials
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -137,11 +139,13 @@ func (module *AuthModuleCert) Process(context AuthContext) (AuthResult, error) {
 
 	if err != nil {
 		logger.WithError(err).Error("error verifying client certificate")
+		context.GetAttempt().FailureReason = AuthFailureCertRejected
 		return nil, apierror.NewInvalidAuth()
 	}
 
 	if len(chains) == 0 {
 		logger.Error("failed to verify client, no valid roots")
+		context.GetAttempt().FailureReason = AuthFailureCertRejected
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -164,6 +168,7 @@ func (module *AuthModuleCert) Process(context AuthContext) (AuthResult, error) {
 
 		if identity == nil {
 			logger.Error("failed to find identity by externalId")
+			context.GetAttempt().FailureReason = AuthFailureUnknownIdentity
 			return nil, apierror.NewInvalidAuth()
 		}
 
@@ -177,14 +182,17 @@ func (module *AuthModuleCert) Process(context AuthContext) (AuthResult, error) {
 
 		if authenticator == nil {
 			logger.Error("failed to find authenticator by fingerprint")
+			context.GetAttempt().FailureReason = AuthFailureUnknownIdentity
 			return nil, apierror.NewInvalidAuth()
 		}
 
+		context.GetAttempt().SetAuthenticator(authenticator)
 		identity, _ = module.env.GetManagers().Identity.Read(authenticator.IdentityId)
 	}
 
 	if identity == nil {
 		logger.Error("failed to find a valid identity for authentication")
+		context.GetAttempt().FailureReason = AuthFailureUnknownIdentity
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -193,11 +201,15 @@ func (module *AuthModuleCert) Process(context AuthContext) (AuthResult, error) {
 		WithField("identityId", authenticator.IdentityId).
 		WithField("authPolicyId", identity.AuthPolicyId)
 
+	context.GetAttempt().SetIdentity(identity)
+	context.GetAttempt().SetAuthenticator(authenticator)
+
 	if identity.Disabled {
 		logger.
 			WithField("disabledAt", identity.DisabledAt).
 			WithField("disabledUntil", identity.DisabledUntil).
 			Error("authentication failed, identity is disabled")
+		context.GetAttempt().FailureReason = AuthFailureIdentityDisabled
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -205,17 +217,20 @@ func (module *AuthModuleCert) Process(context AuthContext) (AuthResult, error) {
 
 	if authPolicy == nil {
 		logger.Error("failed to obtain authPolicy by id")
+		context.GetAttempt().FailureReason = AuthFailureInternalError
 		return nil, apierror.NewInvalidAuth()
 	}
 
 	if !authPolicy.Primary.Cert.Allowed {
 		logger.Error("invalid certificate authentication, not allowed by auth policy")
+		context.GetAttempt().FailureReason = AuthFailureDeniedByAuthPolicy
 		return nil, apierror.NewInvalidAuth()
 	}
 
 	if !authPolicy.Primary.Cert.AllowExpiredCerts {
 		if !module.isCertExpirationValid(clientCert) {
 			logger.Error("failed to verify expiration period of client certificate")
+			context.GetAttempt().FailureReason = AuthFailureCertExpired
 			return nil, apierror.NewInvalidAuth()
 		}
 	}
@@ -296,12 +296,14 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 	if len(authHeaders) != 1 {
 		logger.Error("no authorization header found")
+		context.GetAttempt().FailureReason = AuthFailureMissingCredentials
 		return nil, apierror.NewInvalidAuth()
 	}
 	authHeader := authHeaders[0]
 
 	if !strings.HasPrefix(authHeader, "Bearer ") {
 		logger.Error("authorization header missing Bearer prefix")
+		context.GetAttempt().FailureReason = AuthFailureMissingCredentials
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -316,6 +318,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 		if extJwt == nil {
 			logger.Error("no external jwt signer found for internal claims")
+			context.GetAttempt().FailureReason = AuthFailureJwtSignature
 			return nil, apierror.NewInvalidAuth()
 		}
 
@@ -326,6 +329,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 			issuer, ok = issuerVal.(string)
 			if !ok {
 				logger.Error("issuer in claims was not a string")
+				context.GetAttempt().FailureReason = AuthFailureJwtClaims
 				return nil, apierror.NewInvalidAuth()
 			}
 		}
@@ -334,6 +338,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 		if extJwt.Issuer != nil && *extJwt.Issuer != issuer {
 			logger.WithField("expectedIssuer", *extJwt.Issuer).Error("invalid issuer")
+			context.GetAttempt().FailureReason = AuthFailureJwtClaims
 			return nil, apierror.NewInvalidAuth()
 		}
 
@@ -342,6 +347,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 			if audValues == nil {
 				logger.WithField("audience", audValues).Error("audience is missing")
+				context.GetAttempt().FailureReason = AuthFailureJwtClaims
 				return nil, apierror.NewInvalidAuth()
 			}
 
@@ -360,6 +366,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 					if !ok {
 						logger.WithField("audience", audValues).Error("audience is not a string or array of strings")
+						context.GetAttempt().FailureReason = AuthFailureJwtClaims
 						return nil, apierror.NewInvalidAuth()
 					}
 
@@ -377,6 +384,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 			if !found {
 				logger.WithField("expectedAudience", *extJwt.Audience).WithField("claimsAudiences", audSlice).Error("invalid audience")
+				context.GetAttempt().FailureReason = AuthFailureJwtClaims
 				return nil, apierror.NewInvalidAuth()
 			}
 		}
@@ -392,6 +400,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 		if !ok {
 			logger.Error("claims property on external jwt signer not found in claims")
+			context.GetAttempt().FailureReason = AuthFailureJwtClaims
 			return nil, apierror.NewInvalidAuth()
 		}
 
@@ -399,6 +408,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 		if !ok || claimsId == "" {
 			logger.Error("expected claims id was not a string or was empty")
+			context.GetAttempt().FailureReason = AuthFailureJwtClaims
 			return nil, apierror.NewInvalidAuth()
 		}
 
@@ -413,30 +423,37 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 
 		if err != nil {
 			logger.WithError(err).Error("encountered unhandled error during authentication")
+			context.GetAttempt().FailureReason = AuthFailureUnknownIdentity
 			return nil, apierror.NewInvalidAuth()
 		}
 
 		if authPolicy == nil {
 			logger.WithError(err).Error("encountered unhandled nil auth policy during authentication")
+			context.GetAttempt().FailureReason = AuthFailureInternalError
 			return nil, apierror.NewInvalidAuth()
 		}
 
 		if identity == nil {
 			logger.WithError(err).Error("encountered unhandled nil identity during authentication")
+			context.GetAttempt().FailureReason = AuthFailureUnknownIdentity
 			return nil, apierror.NewInvalidAuth()
 		}
 
+		context.GetAttempt().SetIdentity(identity)
+
 		externalJwtSignerId := ""
 		if identity.Disabled {
 			logger.
 				WithField("disabledAt", identity.DisabledAt).
 				WithField("disabledUntil", identity.DisabledUntil).
 				Error("authentication failed, identity is disabled")
+			context.GetAttempt().FailureReason = AuthFailureIdentityDisabled
 			return nil, apierror.NewInvalidAuth()
 		}
 
 		if !authPolicy.Primary.ExtJwt.Allowed {
 			logger.Error("external jwt authentication on auth policy is disabled")
+			context.GetAttempt().FailureReason = AuthFailureDeniedByAuthPolicy
 			return nil, apierror.NewInvalidAuth()
 		}
 
@@ -455,6 +472,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 					logger.
 						WithField("allowedSigners", authPolicy.Primary.ExtJwt.AllowedExtJwtSigners).
 						Error("auth policy does not allow specified signer")
+					context.GetAttempt().FailureReason = AuthFailureDeniedByAuthPolicy
 					return nil, apierror.NewInvalidAuth()
 				}
 			} else {
@@ -462,6 +480,7 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 			}
 		} else if authPolicy.Secondary.RequiredExtJwtSigner != nil {
 			if extJwt.Id != *authPolicy.Secondary.RequiredExtJwtSigner {
+				context.GetAttempt().FailureReason = AuthFailureDeniedByAuthPolicy
 				return nil, apierror.NewInvalidAuth()
 			}
 
@@ -484,7 +503,12 @@ func (a *AuthModuleExtJwt) process(context AuthContext, isPrimary bool) (AuthRes
 		return result, nil
 	}
 
-	logger.Error("authorization failed, jwt did not verify")
+	logger.WithError(err).Error("authorization failed, jwt did not verify")
+	if errors.Is(err, jwt.ErrTokenMalformed) || errors.Is(err, jwt.ErrTokenUnverifiable) || errors.Is(err, jwt.ErrTokenSignatureInvalid) {
+		context.GetAttempt().FailureReason = AuthFailureJwtSignature
+	} else {
+		context.GetAttempt().FailureReason = AuthFailureJwtClaims
+	}
 	return nil, apierror.NewInvalidAuth()
 }
 
@@ -65,6 +65,7 @@ func (module *AuthModuleUpdb) Process(context AuthContext) (AuthResult, error) {
 	}
 
 	if username == "" || password == "" {
+		context.GetAttempt().FailureReason = AuthFailureMissingCredentials
 		return nil, errorz.NewCouldNotValidate(errors.New("username and password fields are required"))
 	}
 
@@ -73,11 +74,13 @@ func (module *AuthModuleUpdb) Process(context AuthContext) (AuthResult, error) {
 
 	if err != nil {
 		logger.WithError(err).Error("could not authenticate, authenticator lookup by username errored")
+		context.GetAttempt().FailureReason = AuthFailureInternalError
 		return nil, err
 	}
 
 	if authenticator == nil {
 		logger.WithError(err).Error("could not authenticate, authenticator lookup returned nil")
+		context.GetAttempt().FailureReason = AuthFailureUnknownIdentity
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -85,23 +88,30 @@ func (module *AuthModuleUpdb) Process(context AuthContext) (AuthResult, error) {
 		WithField("authenticatorId", authenticator.Id).
 		WithField("identityId", authenticator.IdentityId)
 
+	context.GetAttempt().SetAuthenticator(authenticator)
+
 	authPolicy, identity, err := getAuthPolicyByIdentityId(module.env, module.method, authenticator.Id, authenticator.IdentityId)
 
 	if err != nil {
 		logger.WithError(err).Errorf("could not look up auth policy by identity id")
+		context.GetAttempt().FailureReason = AuthFailureUnknownIdentity
 		return nil, apierror.NewInvalidAuth()
 	}
 
 	if authPolicy == nil {
 		logger.Error("auth policy look up returned nil")
+		context.GetAttempt().FailureReason = AuthFailureInternalError
 		return nil, apierror.NewInvalidAuth()
 	}
 
+	context.GetAttempt().SetIdentity(identity)
+
 	if identity.Disabled {
 		logger.
 			WithField("disabledAt", identity.DisabledAt).
 			WithField("disabledUntil", identity.DisabledUntil).
 			Error("authentication failed, identity is disabled")
+		context.GetAttempt().FailureReason = AuthFailureIdentityDisabled
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -109,6 +119,7 @@ func (module *AuthModuleUpdb) Process(context AuthContext) (AuthResult, error) {
 
 	if !authPolicy.Primary.Updb.Allowed {
 		logger.Error("auth policy does not allow updb authentication")
+		context.GetAttempt().FailureReason = AuthFailureDeniedByAuthPolicy
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -125,9 +136,13 @@ func (module *AuthModuleUpdb) Process(context AuthContext) (AuthResult, error) {
 	if authPolicy.Primary.Updb.MaxAttempts != db.UpdbUnlimitedAttemptsLimit && attempts > authPolicy.Primary.Updb.MaxAttempts {
 		logger.WithField("attempts", attempts).WithField("maxAttempts", authPolicy.Primary.Updb.MaxAttempts).Error("updb auth failed, max attempts exceeded")
 
+		context.GetAttempt().FailureReason = AuthFailureMaxAttemptsExceeded
+
 		duration := time.Duration(authPolicy.Primary.Updb.LockoutDurationMinutes) * time.Minute
 		if err = module.env.GetManagers().Identity.Disable(authenticator.IdentityId, duration, context.GetChangeContext()); err != nil {
 			logger.WithError(err).Error("could not lock identity, unhandled error")
+		} else {
+			dispatchLockoutEvent(module.env, context)
 		}
 
 		return nil, apierror.NewInvalidAuth()
@@ -138,13 +153,14 @@ func (module *AuthModuleUpdb) Process(context AuthContext) (AuthResult, error) {
 	salt, err := DecodeSalt(updb.Salt)
 
 	if err != nil {
+		context.GetAttempt().FailureReason = AuthFailureInternalError
 		return nil, apierror.NewInvalidAuth()
 	}
 
 	hr := module.env.GetManagers().Authenticator.ReHashPassword(password, salt)
 
 	if updb.Password != hr.Password {
-
+		context.GetAttempt().FailureReason = AuthFailureInvalidCredentials
 		return nil, apierror.NewInvalidAuth()
 	}
 
@@ -63,6 +63,9 @@ func (self *EdgeServiceManager) newModelEntity() *Service {
 }
 
 func (self *EdgeServiceManager) Create(entity *Service, ctx *change.Context) error {
+	if err := network.ValidateServiceTags(entity.Tags); err != nil {
+		return err
+	}
 	return network.DispatchCreate[*Service](self, entity, ctx)
 }
 
@@ -72,6 +75,9 @@ func (self *EdgeServiceManager) ApplyCreate(cmd *command.CreateEntityCommand[*Se
 }
 
 func (self *EdgeServiceManager) Update(entity *Service, checker fields.UpdatedFields, ctx *change.Context) error {
+	if err := network.ValidateServiceTags(entity.Tags); err != nil {
+		return err
+	}
 	if checker != nil {
 		checker = checker.RemoveFields("encryptionRequired")
 	}
@@ -208,6 +214,9 @@ func (self *EdgeServiceManager) Marshall(entity *Service) ([]byte, error) {
 		RoleAttributes:     entity.RoleAttributes,
 		Configs:            entity.Configs,
 		EncryptionRequired: entity.EncryptionRequired,
+		AvoidRouters:       entity.AvoidRouters,
+		EgressRouters:      entity.EgressRouters,
+		MaxHops:            entity.MaxHops,
 	}
 
 	return proto.Marshal(msg)
@@ -230,6 +239,9 @@ func (self *EdgeServiceManager) Unmarshall(bytes []byte) (*Service, error) {
 		RoleAttributes:     msg.RoleAttributes,
 		Configs:            msg.Configs,
 		EncryptionRequired: msg.EncryptionRequired,
+		AvoidRouters:       msg.AvoidRouters,
+		EgressRouters:      msg.EgressRouters,
+		MaxHops:            msg.MaxHops,
 	}, nil
 }
 
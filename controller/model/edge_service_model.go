@@ -34,6 +34,9 @@ type Service struct {
 	RoleAttributes     []string      `json:"roleAttributes"`
 	Configs            []string      `json:"configs"`
 	EncryptionRequired bool          `json:"encryptionRequired"`
+	AvoidRouters       []string      `json:"avoidRouters"`
+	EgressRouters      []string      `json:"egressRouters"`
+	MaxHops            int32         `json:"maxHops"`
 }
 
 func (entity *Service) toBoltEntity(tx *bbolt.Tx, env Env) (*db.EdgeService, error) {
@@ -47,6 +50,9 @@ func (entity *Service) toBoltEntity(tx *bbolt.Tx, env Env) (*db.EdgeService, err
 			Name:               entity.Name,
 			MaxIdleTime:        entity.MaxIdleTime,
 			TerminatorStrategy: entity.TerminatorStrategy,
+			AvoidRouters:       entity.AvoidRouters,
+			EgressRouters:      entity.EgressRouters,
+			MaxHops:            entity.MaxHops,
 		},
 		RoleAttributes:     entity.RoleAttributes,
 		Configs:            entity.Configs,
@@ -93,6 +99,9 @@ func (entity *Service) fillFrom(_ Env, _ *bbolt.Tx, boltService *db.EdgeService)
 	entity.RoleAttributes = boltService.RoleAttributes
 	entity.Configs = boltService.Configs
 	entity.EncryptionRequired = boltService.EncryptionRequired
+	entity.AvoidRouters = boltService.AvoidRouters
+	entity.EgressRouters = boltService.EgressRouters
+	entity.MaxHops = boltService.MaxHops
 	return nil
 }
 
@@ -106,6 +115,9 @@ type ServiceDetail struct {
 	Configs            []string                          `json:"configs"`
 	Config             map[string]map[string]interface{} `json:"config"`
 	EncryptionRequired bool                              `json:"encryptionRequired"`
+	AvoidRouters       []string                          `json:"avoidRouters"`
+	EgressRouters      []string                          `json:"egressRouters"`
+	MaxHops            int32                             `json:"maxHops"`
 }
 
 func (entity *ServiceDetail) toBoltEntityForCreate(*bbolt.Tx, Env) (*db.EdgeService, error) {
@@ -124,6 +136,9 @@ func (entity *ServiceDetail) fillFrom(_ Env, _ *bbolt.Tx, boltService *db.EdgeSe
 	entity.RoleAttributes = boltService.RoleAttributes
 	entity.Configs = boltService.Configs
 	entity.EncryptionRequired = boltService.EncryptionRequired
+	entity.AvoidRouters = boltService.AvoidRouters
+	entity.EgressRouters = boltService.EgressRouters
+	entity.MaxHops = boltService.MaxHops
 
 	return nil
 }
@@ -0,0 +1,154 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/pb/cmd_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/command"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+func (entity *AlertRule) toBolt() *db.AlertRule {
+	return &db.AlertRule{
+		BaseExtEntity: *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Type:          entity.Type,
+		For:           entity.For,
+		Threshold:     entity.Threshold,
+		Window:        entity.Window,
+		MinCircuits:   int32(entity.MinCircuits),
+	}
+}
+
+func newAlertRuleManager(managers *Managers) *AlertRuleManager {
+	result := &AlertRuleManager{
+		baseEntityManager: newBaseEntityManager[*AlertRule, *db.AlertRule](managers, managers.stores.AlertRule, func() *AlertRule {
+			return &AlertRule{}
+		}),
+		store: managers.stores.AlertRule,
+	}
+	result.populateEntity = result.populateAlertRule
+	return result
+}
+
+// AlertRuleManager persists the alert rules created through the management API. Creates and deletes are applied
+// through the command dispatcher, so every controller in a cluster stores the rule and hands it to its AlertManager
+type AlertRuleManager struct {
+	baseEntityManager[*AlertRule, *db.AlertRule]
+	store db.AlertRuleStore
+}
+
+func (self *AlertRuleManager) Create(entity *AlertRule, ctx *change.Context) error {
+	return DispatchCreate[*AlertRule](self, entity, ctx)
+}
+
+func (self *AlertRuleManager) ApplyCreate(cmd *command.CreateEntityCommand[*AlertRule], ctx boltz.MutateContext) error {
+	err := self.db.Update(ctx, func(ctx boltz.MutateContext) error {
+		return self.store.Create(ctx, cmd.Entity.toBolt())
+	})
+	if err != nil {
+		return err
+	}
+
+	rule := *cmd.Entity
+	rule.Source = AlertRuleSourceApi
+	self.Alerts.addRule(&rule)
+	return nil
+}
+
+func (self *AlertRuleManager) Delete(id string, ctx *change.Context) error {
+	cmd := &command.DeleteEntityCommand{
+		Context: ctx,
+		Deleter: self,
+		Id:      id,
+	}
+	return self.Dispatch(cmd)
+}
+
+func (self *AlertRuleManager) ApplyDelete(cmd *command.DeleteEntityCommand, ctx boltz.MutateContext) error {
+	if err := self.baseEntityManager.ApplyDelete(cmd, ctx); err != nil {
+		return err
+	}
+	self.Alerts.removeRule(cmd.Id)
+	return nil
+}
+
+func (self *AlertRuleManager) Read(id string) (*AlertRule, error) {
+	return self.BaseLoad(id)
+}
+
+func (self *AlertRuleManager) populateAlertRule(entity *AlertRule, _ *bbolt.Tx, boltEntity boltz.Entity) error {
+	boltAlertRule, ok := boltEntity.(*db.AlertRule)
+	if !ok {
+		return errors.Errorf("unexpected type %v when filling model alert rule", reflect.TypeOf(boltEntity))
+	}
+	entity.Type = boltAlertRule.Type
+	entity.For = boltAlertRule.For
+	entity.Threshold = boltAlertRule.Threshold
+	entity.Window = boltAlertRule.Window
+	entity.MinCircuits = int(boltAlertRule.MinCircuits)
+	entity.Source = AlertRuleSourceApi
+	entity.FillCommon(boltAlertRule)
+	return nil
+}
+
+func (self *AlertRuleManager) Marshall(entity *AlertRule) ([]byte, error) {
+	tags, err := cmd_pb.EncodeTags(entity.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &cmd_pb.AlertRule{
+		Id:          entity.Id,
+		Type:        entity.Type,
+		For:         int64(entity.For),
+		Threshold:   entity.Threshold,
+		Window:      int64(entity.Window),
+		MinCircuits: int32(entity.MinCircuits),
+		Tags:        tags,
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (self *AlertRuleManager) Unmarshall(bytes []byte) (*AlertRule, error) {
+	msg := &cmd_pb.AlertRule{}
+	if err := proto.Unmarshal(bytes, msg); err != nil {
+		return nil, err
+	}
+
+	return &AlertRule{
+		BaseEntity: models.BaseEntity{
+			Id:   msg.Id,
+			Tags: cmd_pb.DecodeTags(msg.Tags),
+		},
+		Type:        msg.Type,
+		For:         time.Duration(msg.For),
+		Threshold:   msg.Threshold,
+		Window:      time.Duration(msg.Window),
+		MinCircuits: int(msg.MinCircuits),
+		Source:      AlertRuleSourceApi,
+	}, nil
+}
@@ -0,0 +1,883 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/metrics/metrics_pb"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/openziti/ziti/controller/idgen"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	AlertRuleRouterOffline        = "routerOffline"
+	AlertRuleNoHealthyTerminators = "noHealthyTerminators"
+	AlertRuleLinkLatency          = "linkLatency"
+	AlertRuleCircuitFailureRate   = "circuitFailureRate"
+
+	AlertRuleSourceConfig = "config"
+	AlertRuleSourceApi    = "api"
+
+	DefaultAlertEvaluationInterval = 15 * time.Second
+	DefaultAlertRouterOfflineFor   = 5 * time.Minute
+	DefaultAlertFailureRateWindow  = 5 * time.Minute
+	DefaultAlertMinCircuits        = 10
+
+	alertLinkLatencySampleTtl = 5 * time.Minute
+	alertCircuitBucketSize    = 10 * time.Second
+)
+
+var alertRuleEntityTypes = map[string]string{
+	AlertRuleRouterOffline:        "router",
+	AlertRuleNoHealthyTerminators: "service",
+	AlertRuleLinkLatency:          "link",
+	AlertRuleCircuitFailureRate:   "service",
+}
+
+// An AlertRule describes a condition to watch for. What the threshold means depends on the rule type:
+//   - routerOffline: unused. The alert fires once a router has been offline for longer than For
+//   - noHealthyTerminators: unused. The alert fires once a service has had no usable terminators for longer than For
+//   - linkLatency: the link p95 latency in milliseconds. Both sides of the link are checked
+//   - circuitFailureRate: the percentage of circuit creations for a service which failed over the last Window. Only
+//     evaluated once at least MinCircuits have been attempted in the window
+type AlertRule struct {
+	models.BaseEntity
+	Type        string
+	For         time.Duration
+	Threshold   float64
+	Window      time.Duration
+	MinCircuits int
+	Source      string
+}
+
+func (self *AlertRule) applyDefaults() {
+	if self.Type == AlertRuleRouterOffline && self.For == 0 {
+		self.For = DefaultAlertRouterOfflineFor
+	}
+	if self.Type == AlertRuleCircuitFailureRate {
+		if self.Window == 0 {
+			self.Window = DefaultAlertFailureRateWindow
+		}
+		if self.MinCircuits == 0 {
+			self.MinCircuits = DefaultAlertMinCircuits
+		}
+	}
+}
+
+func (self *AlertRule) Validate() error {
+	if self.Id == "" {
+		return errorz.NewFieldError("alert rule id is required", "id", self.Id)
+	}
+
+	if _, found := alertRuleEntityTypes[self.Type]; !found {
+		return errorz.NewFieldError("type must be one of routerOffline, noHealthyTerminators, linkLatency or circuitFailureRate", "type", self.Type)
+	}
+
+	if self.For < 0 {
+		return errorz.NewFieldError("for may not be negative", "for", self.For.String())
+	}
+
+	switch self.Type {
+	case AlertRuleLinkLatency:
+		if self.Threshold <= 0 {
+			return errorz.NewFieldError("threshold must be a positive number of milliseconds", "threshold", self.Threshold)
+		}
+	case AlertRuleCircuitFailureRate:
+		if self.Threshold <= 0 || self.Threshold > 100 {
+			return errorz.NewFieldError("threshold must be a percentage greater than 0 and at most 100", "threshold", self.Threshold)
+		}
+		if self.Window <= 0 {
+			return errorz.NewFieldError("window must be a positive duration", "window", self.Window.String())
+		}
+		if self.MinCircuits < 1 {
+			return errorz.NewFieldError("minCircuits must be at least 1", "minCircuits", self.MinCircuits)
+		}
+	}
+
+	return nil
+}
+
+// An Alert is a rule which is currently firing for an entity
+type Alert struct {
+	RuleId     string
+	RuleType   string
+	EntityType string
+	EntityId   string
+	Value      float64
+	Threshold  float64
+	FiredAt    time.Time
+	Message    string
+}
+
+type AlertConfig struct {
+	EvaluationInterval time.Duration
+	Rules              []*AlertRule
+}
+
+func ParseAlertConfig(config map[interface{}]interface{}) (*AlertConfig, error) {
+	result := &AlertConfig{
+		EvaluationInterval: DefaultAlertEvaluationInterval,
+	}
+
+	if value, found := config["evaluationInterval"]; found {
+		d, err := parseAlertDuration(value)
+		if err != nil || d <= 0 {
+			return nil, errors.Errorf("invalid alerts evaluationInterval %v, must be a positive duration", value)
+		}
+		result.EvaluationInterval = d
+	}
+
+	if value, found := config["rules"]; found {
+		list, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid alerts rules, must be a list")
+		}
+		for idx, v := range list {
+			ruleMap, ok := v.(map[interface{}]interface{})
+			if !ok {
+				return nil, errors.Errorf("invalid alert rule at index %v, must be a map", idx)
+			}
+			rule, err := parseAlertRule(ruleMap)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid alert rule at index %v", idx)
+			}
+			result.Rules = append(result.Rules, rule)
+		}
+	}
+
+	return result, nil
+}
+
+func parseAlertRule(config map[interface{}]interface{}) (*AlertRule, error) {
+	result := &AlertRule{
+		Source: AlertRuleSourceConfig,
+	}
+
+	result.Id, _ = config["id"].(string)
+	result.Type, _ = config["type"].(string)
+
+	for _, name := range []string{"for", "window"} {
+		value, found := config[name]
+		if !found {
+			continue
+		}
+		d, err := parseAlertDuration(value)
+		if err != nil {
+			return nil, errors.Errorf("invalid %v %v, must be a duration", name, value)
+		}
+		if name == "for" {
+			result.For = d
+		} else {
+			result.Window = d
+		}
+	}
+
+	if value, found := config["threshold"]; found {
+		switch v := value.(type) {
+		case int:
+			result.Threshold = float64(v)
+		case float64:
+			result.Threshold = v
+		default:
+			return nil, errors.Errorf("invalid threshold %v, must be a number", value)
+		}
+	}
+
+	if value, found := config["minCircuits"]; found {
+		v, ok := value.(int)
+		if !ok {
+			return nil, errors.Errorf("invalid minCircuits %v, must be an integer", value)
+		}
+		result.MinCircuits = v
+	}
+
+	result.applyDefaults()
+	if err := result.Validate(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseAlertDuration(value interface{}) (time.Duration, error) {
+	s, ok := value.(string)
+	if !ok {
+		return 0, errors.Errorf("%v is not a duration", value)
+	}
+	return time.ParseDuration(s)
+}
+
+type alertState struct {
+	pendingSince time.Time
+	firedAt      time.Time
+	firing       bool
+	value        float64
+	threshold    float64
+	message      string
+}
+
+type alertObservation struct {
+	since     time.Time
+	value     float64
+	threshold float64
+	message   string
+}
+
+type serviceTerminatorState struct {
+	usable      bool
+	unusableSet time.Time
+}
+
+type linkLatencySample struct {
+	p95     float64
+	updated time.Time
+}
+
+type circuitCounts struct {
+	created uint64
+	failed  uint64
+}
+
+func newAlertManager(network *Network) *AlertManager {
+	return &AlertManager{
+		network:         network,
+		rules:           map[string]*AlertRule{},
+		states:          map[string]map[string]*alertState{},
+		routersOffline:  map[string]time.Time{},
+		services:        map[string]*serviceTerminatorState{},
+		linkLatencies:   map[string]map[string]linkLatencySample{},
+		circuitCounts:   map[string]map[int64]*circuitCounts{},
+		currentTimeFunc: time.Now,
+	}
+}
+
+// AlertManager evaluates alert rules against router, terminator, circuit and metrics events and emits
+// fabric.alerts events when an alert fires or resolves. Rules can come from the controller config or be added
+// through the management API. Rules added through the API are stored by the AlertRuleManager, which hands them to
+// the AlertManager as they're applied, so they survive restarts and are evaluated by every controller in a cluster.
+type AlertManager struct {
+	network         *Network
+	dispatcher      event.Dispatcher
+	currentTimeFunc func() time.Time
+	lock            sync.Mutex
+	rules           map[string]*AlertRule
+	states          map[string]map[string]*alertState
+	routersOffline  map[string]time.Time
+	services        map[string]*serviceTerminatorState
+	linkLatencies   map[string]map[string]linkLatencySample
+	circuitCounts   map[string]map[int64]*circuitCounts
+}
+
+// Start loads the configured and stored rules, subscribes to the events the rules are evaluated against and starts
+// evaluating
+func (self *AlertManager) Start(config *AlertConfig) error {
+	storedRules, err := self.network.AlertRules.BaseList("true limit none")
+	if err != nil {
+		return errors.Wrap(err, "unable to list stored alert rules")
+	}
+
+	services, err := self.getServiceTerminatorStates()
+	if err != nil {
+		return err
+	}
+
+	self.lock.Lock()
+	configRuleIds := map[string]struct{}{}
+	for _, rule := range config.Rules {
+		if _, found := configRuleIds[rule.Id]; found {
+			self.lock.Unlock()
+			return errors.Errorf("duplicate alert rule id %v", rule.Id)
+		}
+		configRuleIds[rule.Id] = struct{}{}
+		self.rules[rule.Id] = rule
+	}
+
+	for _, rule := range storedRules.Entities {
+		self.addRuleUnsafe(rule)
+	}
+
+	self.updateServicesUnsafe(services)
+
+	// routers which never connect after a restart won't generate an offline event, so treat every router
+	// which isn't connected as having gone offline now
+	now := self.currentTimeFunc()
+	routers, err := self.network.Routers.BaseList("true limit none")
+	if err != nil {
+		self.lock.Unlock()
+		return errors.Wrap(err, "unable to list routers for alerting")
+	}
+	for _, router := range routers.Entities {
+		if !self.network.Routers.IsConnected(router.Id) {
+			self.routersOffline[router.Id] = now
+		}
+	}
+	self.lock.Unlock()
+
+	self.dispatcher = self.network.GetEventDispatcher()
+	self.dispatcher.AddRouterEventHandler(self)
+	self.dispatcher.AddTerminatorEventHandler(self)
+	self.dispatcher.AddCircuitEventHandler(self)
+	self.dispatcher.AddMetricsMessageHandler(self)
+
+	self.network.GetDb().AddRestoreListener(self.reload)
+
+	go self.run(config.EvaluationInterval, self.network.GetCloseNotify())
+
+	return nil
+}
+
+// reload brings the stored rules and service terminator states up to date after a snapshot is restored. Restoring a
+// snapshot replaces the database without applying the commands which led to it, so rules aren't added or removed
+// as they would be otherwise
+func (self *AlertManager) reload() {
+	log := pfxlog.Logger()
+
+	storedRules, err := self.network.AlertRules.BaseList("true limit none")
+	if err != nil {
+		log.WithError(err).Error("unable to list stored alert rules after snapshot restore")
+		return
+	}
+
+	services, err := self.getServiceTerminatorStates()
+	if err != nil {
+		log.WithError(err).Error("unable to reload service terminator states after snapshot restore")
+	}
+
+	stored := map[string]struct{}{}
+	for _, rule := range storedRules.Entities {
+		stored[rule.Id] = struct{}{}
+	}
+
+	self.lock.Lock()
+	now := self.currentTimeFunc()
+	var events []*event.AlertEvent
+	for id, rule := range self.rules {
+		if _, found := stored[id]; !found && rule.Source != AlertRuleSourceConfig {
+			events = append(events, self.removeRuleUnsafe(rule, now)...)
+		}
+	}
+
+	for _, rule := range storedRules.Entities {
+		self.addRuleUnsafe(rule)
+	}
+
+	self.updateServicesUnsafe(services)
+	self.lock.Unlock()
+
+	self.emit(events)
+}
+
+// getServiceTerminatorStates works out which services with terminators have usable terminators. Terminator events
+// only track services as their terminators change, so without this, services whose terminators were all unusable
+// when the controller started would never alert
+func (self *AlertManager) getServiceTerminatorStates() (map[string]*serviceTerminatorState, error) {
+	services, err := self.network.Services.BaseList("true limit none")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list services for alerting")
+	}
+
+	now := self.currentTimeFunc()
+	result := map[string]*serviceTerminatorState{}
+	for _, service := range services.Entities {
+		if len(service.Terminators) == 0 {
+			continue
+		}
+		state := &serviceTerminatorState{unusableSet: now}
+		for _, terminator := range service.Terminators {
+			if (terminator.Precedence.IsDefault() || terminator.Precedence.IsRequired()) &&
+				self.network.ConnectedRouter(terminator.Router) {
+				state.usable = true
+				break
+			}
+		}
+		result[service.Id] = state
+	}
+	return result, nil
+}
+
+// updateServicesUnsafe merges in the given service terminator states. Services whose usability is unchanged keep
+// their current state, so alerts which are pending or firing keep their start time. Must be called with the lock
+// held
+func (self *AlertManager) updateServicesUnsafe(services map[string]*serviceTerminatorState) {
+	for serviceId, state := range services {
+		if current, found := self.services[serviceId]; !found || current.usable != state.usable {
+			self.services[serviceId] = state
+		}
+	}
+}
+
+func (self *AlertManager) AcceptRouterEvent(evt *event.RouterEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if evt.EventType == event.RouterOnline {
+		delete(self.routersOffline, evt.RouterId)
+	} else if _, found := self.routersOffline[evt.RouterId]; !found {
+		self.routersOffline[evt.RouterId] = evt.Timestamp
+	}
+}
+
+func (self *AlertManager) AcceptTerminatorEvent(evt *event.TerminatorEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	state, found := self.services[evt.ServiceId]
+	if !found {
+		state = &serviceTerminatorState{usable: true}
+		self.services[evt.ServiceId] = state
+	}
+
+	usable := evt.UsableDefaultTerminators+evt.UsableRequiredTerminators > 0
+	if state.usable && !usable {
+		state.unusableSet = evt.Timestamp
+	}
+	state.usable = usable
+}
+
+func (self *AlertManager) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	if evt.EventType != event.CircuitCreated && evt.EventType != event.CircuitFailed {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	buckets, found := self.circuitCounts[evt.ServiceId]
+	if !found {
+		buckets = map[int64]*circuitCounts{}
+		self.circuitCounts[evt.ServiceId] = buckets
+	}
+
+	bucketStart := evt.Timestamp.Truncate(alertCircuitBucketSize).Unix()
+	counts, found := buckets[bucketStart]
+	if !found {
+		counts = &circuitCounts{}
+		buckets[bucketStart] = counts
+	}
+
+	if evt.EventType == event.CircuitCreated {
+		counts.created++
+	} else {
+		counts.failed++
+	}
+}
+
+func (self *AlertManager) AcceptMetricsMsg(msg *metrics_pb.MetricsMessage) {
+	now := self.currentTimeFunc()
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for name, histogram := range msg.Histograms {
+		linkId, found := parseLinkLatencyMetric(name)
+		if !found {
+			continue
+		}
+		samples, found := self.linkLatencies[linkId]
+		if !found {
+			samples = map[string]linkLatencySample{}
+			self.linkLatencies[linkId] = samples
+		}
+		samples[msg.SourceId] = linkLatencySample{
+			p95:     histogram.P95 / float64(time.Millisecond),
+			updated: now,
+		}
+	}
+}
+
+func parseLinkLatencyMetric(name string) (string, bool) {
+	const prefix = "link."
+	const suffix = ".latency"
+	if len(name) <= len(prefix)+len(suffix) || name[:len(prefix)] != prefix || name[len(name)-len(suffix):] != suffix {
+		return "", false
+	}
+	return name[len(prefix) : len(name)-len(suffix)], true
+}
+
+// ListRules returns copies of the current rules, ordered by id
+func (self *AlertManager) ListRules() []*AlertRule {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*AlertRule
+	for _, rule := range self.rules {
+		ruleCopy := *rule
+		result = append(result, &ruleCopy)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+	return result
+}
+
+// GetRule returns a copy of the rule with the given id, or nil if there's no such rule
+func (self *AlertManager) GetRule(id string) *AlertRule {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if rule, found := self.rules[id]; found {
+		ruleCopy := *rule
+		return &ruleCopy
+	}
+	return nil
+}
+
+// CreateRule stores a rule. If the rule doesn't have an id, one is generated. The rule is evaluated once the create
+// has been applied
+func (self *AlertManager) CreateRule(rule *AlertRule, ctx *change.Context) error {
+	if rule.Id == "" {
+		rule.Id = idgen.NewUUIDString()
+	}
+	rule.Source = AlertRuleSourceApi
+	rule.applyDefaults()
+
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	if existing := self.GetRule(rule.Id); existing != nil {
+		return errorz.NewFieldError("an alert rule with the given id already exists", "id", rule.Id)
+	}
+
+	return self.network.AlertRules.Create(rule, ctx)
+}
+
+// DeleteRule removes a rule which was added through the API. Any alerts firing for the rule are resolved once the
+// delete has been applied
+func (self *AlertManager) DeleteRule(id string, ctx *change.Context) error {
+	rule := self.GetRule(id)
+	if rule == nil {
+		return boltz.NewNotFoundError("alertRule", "id", id)
+	}
+
+	if rule.Source == AlertRuleSourceConfig {
+		return errorz.NewFieldError("alert rules from the controller config can't be deleted", "id", id)
+	}
+
+	return self.network.AlertRules.Delete(id, ctx)
+}
+
+// addRule starts evaluating a stored rule
+func (self *AlertManager) addRule(rule *AlertRule) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.addRuleUnsafe(rule)
+}
+
+func (self *AlertManager) addRuleUnsafe(rule *AlertRule) {
+	if existing, found := self.rules[rule.Id]; found && existing.Source == AlertRuleSourceConfig {
+		pfxlog.Logger().WithField("ruleId", rule.Id).
+			Warn("stored alert rule has the same id as a rule from the controller config, ignoring stored rule")
+		return
+	}
+	self.rules[rule.Id] = rule
+}
+
+// removeRule stops evaluating a stored rule and resolves any alerts firing for it
+func (self *AlertManager) removeRule(id string) {
+	self.lock.Lock()
+
+	rule, found := self.rules[id]
+	if !found || rule.Source == AlertRuleSourceConfig {
+		self.lock.Unlock()
+		return
+	}
+
+	events := self.removeRuleUnsafe(rule, self.currentTimeFunc())
+	self.lock.Unlock()
+
+	self.emit(events)
+}
+
+// removeRuleUnsafe stops evaluating the rule and returns resolved events for any alerts firing for it. Must be
+// called with the lock held
+func (self *AlertManager) removeRuleUnsafe(rule *AlertRule, now time.Time) []*event.AlertEvent {
+	var events []*event.AlertEvent
+	for entityId, state := range self.states[rule.Id] {
+		if state.firing {
+			events = append(events, self.newAlertEvent(event.AlertEventTypeResolved, now, rule, entityId, state,
+				fmt.Sprintf("alert rule %v was deleted", rule.Id)))
+		}
+	}
+
+	delete(self.rules, rule.Id)
+	delete(self.states, rule.Id)
+	return events
+}
+
+// ListAlerts returns the alerts which are currently firing, ordered by rule id and then entity id
+func (self *AlertManager) ListAlerts() []*Alert {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var result []*Alert
+	for ruleId, states := range self.states {
+		rule := self.rules[ruleId]
+		for entityId, state := range states {
+			if !state.firing {
+				continue
+			}
+			result = append(result, &Alert{
+				RuleId:     ruleId,
+				RuleType:   rule.Type,
+				EntityType: alertRuleEntityTypes[rule.Type],
+				EntityId:   entityId,
+				Value:      state.value,
+				Threshold:  state.threshold,
+				FiredAt:    state.firedAt,
+				Message:    state.message,
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].RuleId == result[j].RuleId {
+			return result[i].EntityId < result[j].EntityId
+		}
+		return result[i].RuleId < result[j].RuleId
+	})
+
+	return result
+}
+
+func (self *AlertManager) run(evaluationInterval time.Duration, closeNotify <-chan struct{}) {
+	ticker := time.NewTicker(evaluationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.evaluate()
+		case <-closeNotify:
+			return
+		}
+	}
+}
+
+// evaluate checks every rule against the current state. An alert fires once its condition has held for the rule's
+// For duration and resolves as soon as the condition no longer holds
+func (self *AlertManager) evaluate() {
+	now := self.currentTimeFunc()
+
+	self.lock.Lock()
+	self.removeStaleState(now)
+
+	var events []*event.AlertEvent
+	for _, rule := range self.rules {
+		observations := self.observe(rule, now)
+
+		states, found := self.states[rule.Id]
+		if !found {
+			states = map[string]*alertState{}
+			self.states[rule.Id] = states
+		}
+
+		for entityId, observation := range observations {
+			state, found := states[entityId]
+			if !found {
+				state = &alertState{pendingSince: observation.since}
+				states[entityId] = state
+			}
+			state.value = observation.value
+			state.threshold = observation.threshold
+			if !state.firing {
+				// only update the message while pending, so the listed alert explains why it fired
+				state.message = observation.message
+				if now.Sub(state.pendingSince) >= rule.For {
+					state.firing = true
+					state.firedAt = now
+					events = append(events, self.newAlertEvent(event.AlertEventTypeFired, now, rule, entityId, state, state.message))
+				}
+			}
+		}
+
+		for entityId, state := range states {
+			if _, found := observations[entityId]; found {
+				continue
+			}
+			if state.firing {
+				message := fmt.Sprintf("%v alert for %v %v resolved", rule.Type, alertRuleEntityTypes[rule.Type], entityId)
+				events = append(events, self.newAlertEvent(event.AlertEventTypeResolved, now, rule, entityId, state, message))
+			}
+			delete(states, entityId)
+		}
+	}
+	self.lock.Unlock()
+
+	self.emit(events)
+}
+
+// observe returns the entities which currently match the rule's condition, keyed by entity id. Must be called
+// with the lock held
+func (self *AlertManager) observe(rule *AlertRule, now time.Time) map[string]*alertObservation {
+	result := map[string]*alertObservation{}
+
+	switch rule.Type {
+	case AlertRuleRouterOffline:
+		for routerId, offlineSince := range self.routersOffline {
+			offline := now.Sub(offlineSince)
+			result[routerId] = &alertObservation{
+				since:     offlineSince,
+				value:     offline.Seconds(),
+				threshold: rule.For.Seconds(),
+				message:   fmt.Sprintf("router %v has been offline for %v", routerId, offline.Truncate(time.Second)),
+			}
+		}
+
+	case AlertRuleNoHealthyTerminators:
+		for serviceId, state := range self.services {
+			if !state.usable {
+				result[serviceId] = &alertObservation{
+					since:   state.unusableSet,
+					message: fmt.Sprintf("service %v has no healthy terminators", serviceId),
+				}
+			}
+		}
+
+	case AlertRuleLinkLatency:
+		for linkId, samples := range self.linkLatencies {
+			var p95 float64
+			for _, sample := range samples {
+				if sample.p95 > p95 {
+					p95 = sample.p95
+				}
+			}
+			if p95 > rule.Threshold {
+				result[linkId] = &alertObservation{
+					since:     now,
+					value:     p95,
+					threshold: rule.Threshold,
+					message:   fmt.Sprintf("link %v p95 latency is %.1fms, above threshold of %vms", linkId, p95, rule.Threshold),
+				}
+			}
+		}
+
+	case AlertRuleCircuitFailureRate:
+		windowStart := now.Add(-rule.Window).Unix()
+		for serviceId, buckets := range self.circuitCounts {
+			var created, failed uint64
+			for bucketStart, counts := range buckets {
+				if bucketStart >= windowStart {
+					created += counts.created
+					failed += counts.failed
+				}
+			}
+			total := created + failed
+			if total == 0 || total < uint64(rule.MinCircuits) {
+				continue
+			}
+			failureRate := float64(failed) * 100 / float64(total)
+			if failureRate > rule.Threshold {
+				result[serviceId] = &alertObservation{
+					since:     now,
+					value:     failureRate,
+					threshold: rule.Threshold,
+					message: fmt.Sprintf("%v of %v circuits for service %v failed in the last %v (%.1f%%)",
+						failed, total, serviceId, rule.Window, failureRate),
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// removeStaleState drops expired link latency samples and circuit counts, along with routers and services which
+// have been deleted. Must be called with the lock held
+func (self *AlertManager) removeStaleState(now time.Time) {
+	for linkId, samples := range self.linkLatencies {
+		for routerId, sample := range samples {
+			if now.Sub(sample.updated) > alertLinkLatencySampleTtl {
+				delete(samples, routerId)
+			}
+		}
+		if len(samples) == 0 {
+			delete(self.linkLatencies, linkId)
+		}
+	}
+
+	maxWindow := time.Duration(0)
+	for _, rule := range self.rules {
+		if rule.Type == AlertRuleCircuitFailureRate && rule.Window > maxWindow {
+			maxWindow = rule.Window
+		}
+	}
+	windowStart := now.Add(-maxWindow).Unix()
+	for serviceId, buckets := range self.circuitCounts {
+		for bucketStart := range buckets {
+			if bucketStart < windowStart-int64(alertCircuitBucketSize.Seconds()) {
+				delete(buckets, bucketStart)
+			}
+		}
+		if len(buckets) == 0 {
+			delete(self.circuitCounts, serviceId)
+		}
+	}
+
+	if self.network == nil {
+		return
+	}
+
+	for routerId := range self.routersOffline {
+		if exists, err := self.network.Routers.Exists(routerId); err == nil && !exists {
+			delete(self.routersOffline, routerId)
+		}
+	}
+
+	for serviceId, state := range self.services {
+		if state.usable {
+			continue
+		}
+		if _, err := self.network.Services.Read(serviceId); boltz.IsErrNotFoundErr(err) {
+			delete(self.services, serviceId)
+		}
+	}
+}
+
+func (self *AlertManager) newAlertEvent(eventType string, now time.Time, rule *AlertRule, entityId string, state *alertState, message string) *event.AlertEvent {
+	return &event.AlertEvent{
+		Namespace:  event.AlertEventsNs,
+		EventType:  eventType,
+		Timestamp:  now,
+		RuleId:     rule.Id,
+		RuleType:   rule.Type,
+		EntityType: alertRuleEntityTypes[rule.Type],
+		EntityId:   entityId,
+		Value:      state.value,
+		Threshold:  state.threshold,
+		FiredAt:    state.firedAt,
+		Message:    message,
+	}
+}
+
+func (self *AlertManager) emit(events []*event.AlertEvent) {
+	for _, evt := range events {
+		pfxlog.Logger().WithField("ruleId", evt.RuleId).WithField("entityId", evt.EntityId).Infof("alert %v: %v", evt.EventType, evt.Message)
+		if self.dispatcher != nil {
+			self.dispatcher.AcceptAlertEvent(evt)
+		}
+	}
+}
@@ -0,0 +1,364 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/metrics/metrics_pb"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+type testAlertDispatcher struct {
+	event.DispatcherMock
+	events []*event.AlertEvent
+}
+
+func (self *testAlertDispatcher) AcceptAlertEvent(evt *event.AlertEvent) {
+	self.events = append(self.events, evt)
+}
+
+func (self *testAlertDispatcher) take() []*event.AlertEvent {
+	result := self.events
+	self.events = nil
+	return result
+}
+
+func newTestAlertManager(now *time.Time, rules ...*AlertRule) (*AlertManager, *testAlertDispatcher) {
+	dispatcher := &testAlertDispatcher{}
+	result := newAlertManager(nil)
+	result.dispatcher = dispatcher
+	result.currentTimeFunc = func() time.Time {
+		return *now
+	}
+	for _, rule := range rules {
+		rule.applyDefaults()
+		result.rules[rule.Id] = rule
+	}
+	return result, dispatcher
+}
+
+func TestRouterOfflineAlert(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	mgr, dispatcher := newTestAlertManager(&now, &AlertRule{BaseEntity: models.BaseEntity{Id: "offline"}, Type: AlertRuleRouterOffline})
+
+	mgr.AcceptRouterEvent(&event.RouterEvent{EventType: event.RouterOffline, RouterId: "r1", Timestamp: now})
+
+	now = now.Add(4 * time.Minute)
+	mgr.evaluate()
+	req.Empty(dispatcher.take())
+	req.Empty(mgr.ListAlerts())
+
+	now = now.Add(2 * time.Minute)
+	mgr.evaluate()
+	events := dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeFired, events[0].EventType)
+	req.Equal("router", events[0].EntityType)
+	req.Equal("r1", events[0].EntityId)
+	req.Equal(float64(360), events[0].Value)
+	req.Equal(float64(300), events[0].Threshold)
+
+	alerts := mgr.ListAlerts()
+	req.Len(alerts, 1)
+	req.Equal(now, alerts[0].FiredAt)
+
+	// still offline, so nothing new is emitted
+	now = now.Add(time.Minute)
+	mgr.evaluate()
+	req.Empty(dispatcher.take())
+
+	mgr.AcceptRouterEvent(&event.RouterEvent{EventType: event.RouterOnline, RouterId: "r1", Timestamp: now})
+	mgr.evaluate()
+	events = dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeResolved, events[0].EventType)
+	req.Empty(mgr.ListAlerts())
+}
+
+func TestNoHealthyTerminatorsAlert(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	mgr, dispatcher := newTestAlertManager(&now, &AlertRule{BaseEntity: models.BaseEntity{Id: "terminators"}, Type: AlertRuleNoHealthyTerminators})
+
+	mgr.AcceptTerminatorEvent(&event.TerminatorEvent{ServiceId: "s1", UsableDefaultTerminators: 1, Timestamp: now})
+	mgr.AcceptTerminatorEvent(&event.TerminatorEvent{ServiceId: "s2", UsableRequiredTerminators: 1, Timestamp: now})
+	mgr.evaluate()
+	req.Empty(dispatcher.take())
+
+	mgr.AcceptTerminatorEvent(&event.TerminatorEvent{ServiceId: "s2", Timestamp: now})
+	mgr.evaluate()
+	events := dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeFired, events[0].EventType)
+	req.Equal("service", events[0].EntityType)
+	req.Equal("s2", events[0].EntityId)
+
+	mgr.AcceptTerminatorEvent(&event.TerminatorEvent{ServiceId: "s2", UsableDefaultTerminators: 2, Timestamp: now})
+	mgr.evaluate()
+	events = dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeResolved, events[0].EventType)
+}
+
+func TestLinkLatencyAlert(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	mgr, dispatcher := newTestAlertManager(&now, &AlertRule{BaseEntity: models.BaseEntity{Id: "latency"}, Type: AlertRuleLinkLatency, Threshold: 100, For: time.Minute})
+
+	latency := func(routerId string, ms float64) {
+		mgr.AcceptMetricsMsg(&metrics_pb.MetricsMessage{
+			SourceId: routerId,
+			Histograms: map[string]*metrics_pb.MetricsMessage_Histogram{
+				"link.l1.latency": {P95: ms * float64(time.Millisecond)},
+			},
+		})
+	}
+
+	latency("r1", 50)
+	latency("r2", 150)
+	mgr.evaluate()
+	req.Empty(dispatcher.take())
+
+	now = now.Add(time.Minute)
+	latency("r2", 160)
+	mgr.evaluate()
+	events := dispatcher.take()
+	req.Len(events, 1)
+	req.Equal("link", events[0].EntityType)
+	req.Equal("l1", events[0].EntityId)
+	req.Equal(float64(160), events[0].Value)
+
+	// once the samples expire the condition no longer holds
+	now = now.Add(alertLinkLatencySampleTtl + time.Second)
+	mgr.evaluate()
+	events = dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeResolved, events[0].EventType)
+}
+
+func TestCircuitFailureRateAlert(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	mgr, dispatcher := newTestAlertManager(&now, &AlertRule{BaseEntity: models.BaseEntity{Id: "failures"}, Type: AlertRuleCircuitFailureRate, Threshold: 20, MinCircuits: 5})
+
+	circuit := func(eventType event.CircuitEventType) {
+		mgr.AcceptCircuitEvent(&event.CircuitEvent{EventType: eventType, ServiceId: "s1", Timestamp: now})
+	}
+
+	// below the minimum number of circuits
+	circuit(event.CircuitFailed)
+	circuit(event.CircuitFailed)
+	mgr.evaluate()
+	req.Empty(dispatcher.take())
+
+	circuit(event.CircuitCreated)
+	circuit(event.CircuitCreated)
+	circuit(event.CircuitCreated)
+	circuit(event.CircuitDeleted)
+	mgr.evaluate()
+	events := dispatcher.take()
+	req.Len(events, 1)
+	req.Equal("s1", events[0].EntityId)
+	req.Equal(float64(40), events[0].Value)
+	req.Equal(float64(20), events[0].Threshold)
+
+	// the failures age out of the window
+	now = now.Add(DefaultAlertFailureRateWindow + time.Minute)
+	mgr.evaluate()
+	events = dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeResolved, events[0].EventType)
+	req.Empty(mgr.circuitCounts)
+}
+
+func TestAlertRuleManagement(t *testing.T) {
+	req := require.New(t)
+
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	dispatcher := &testAlertDispatcher{}
+	mgr := network.Alerts
+	mgr.dispatcher = dispatcher
+	mgr.currentTimeFunc = func() time.Time {
+		return now
+	}
+	mgr.rules["offline"] = &AlertRule{BaseEntity: models.BaseEntity{Id: "offline"}, Type: AlertRuleRouterOffline, Source: AlertRuleSourceConfig}
+
+	err = mgr.CreateRule(&AlertRule{Type: "bogus"}, change.New())
+	req.Error(err)
+	req.IsType(&errorz.FieldError{}, err)
+
+	err = mgr.CreateRule(&AlertRule{BaseEntity: models.BaseEntity{Id: "latency"}, Type: AlertRuleLinkLatency}, change.New())
+	req.Error(err)
+
+	rule := &AlertRule{Type: AlertRuleNoHealthyTerminators}
+	req.NoError(mgr.CreateRule(rule, change.New()))
+	req.NotEmpty(rule.Id)
+	req.Equal(AlertRuleSourceApi, mgr.GetRule(rule.Id).Source)
+	req.Len(mgr.ListRules(), 2)
+
+	stored, err := network.AlertRules.Read(rule.Id)
+	req.NoError(err)
+	req.Equal(AlertRuleNoHealthyTerminators, stored.Type)
+
+	req.Error(mgr.CreateRule(&AlertRule{BaseEntity: models.BaseEntity{Id: rule.Id}, Type: AlertRuleNoHealthyTerminators}, change.New()))
+	req.Error(mgr.CreateRule(&AlertRule{BaseEntity: models.BaseEntity{Id: "offline"}, Type: AlertRuleNoHealthyTerminators}, change.New()))
+	req.Error(mgr.DeleteRule("offline", change.New()))
+	req.Error(mgr.DeleteRule("missing", change.New()))
+
+	// a restarted controller picks up the stored rule alongside the config rules
+	restarted := newAlertManager(network)
+	req.NoError(restarted.Start(&AlertConfig{
+		EvaluationInterval: time.Minute,
+		Rules:              []*AlertRule{{BaseEntity: models.BaseEntity{Id: "offline"}, Type: AlertRuleRouterOffline, Source: AlertRuleSourceConfig}},
+	}))
+	req.Len(restarted.ListRules(), 2)
+	req.Equal(AlertRuleSourceApi, restarted.GetRule(rule.Id).Source)
+
+	svc := &Service{BaseEntity: models.BaseEntity{Id: "s1"}, Name: "s1", TerminatorStrategy: "smartrouting"}
+	req.NoError(network.Services.Create(svc, change.New()))
+
+	mgr.AcceptTerminatorEvent(&event.TerminatorEvent{ServiceId: "s1", Timestamp: now})
+	mgr.evaluate()
+	req.Len(dispatcher.take(), 1)
+
+	req.NoError(mgr.DeleteRule(rule.Id, change.New()))
+	events := dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeResolved, events[0].EventType)
+	req.Nil(mgr.GetRule(rule.Id))
+	req.Empty(mgr.ListAlerts())
+
+	_, err = network.AlertRules.Read(rule.Id)
+	req.True(boltz.IsErrNotFoundErr(err))
+}
+
+func TestAlertManagerLoadsCurrentState(t *testing.T) {
+	req := require.New(t)
+
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	router := NewRouter("r1", "r1", "", 0, false)
+	req.NoError(network.Routers.Create(router, change.New()))
+
+	svc := &Service{BaseEntity: models.BaseEntity{Id: "s1"}, Name: "s1", TerminatorStrategy: "smartrouting"}
+	req.NoError(network.Services.Create(svc, change.New()))
+
+	terminator := &Terminator{
+		BaseEntity: models.BaseEntity{Id: "t1"},
+		Service:    svc.Id,
+		Router:     router.Id,
+		Binding:    "transport",
+		Address:    "tcp:localhost:1234",
+	}
+	req.NoError(network.Terminators.Create(terminator, change.New()))
+
+	rule := &AlertRule{Type: AlertRuleNoHealthyTerminators}
+	req.NoError(network.Alerts.CreateRule(rule, change.New()))
+
+	// services whose terminators are all unusable at startup are tracked without waiting for a terminator event
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	dispatcher := &testAlertDispatcher{}
+	mgr := newAlertManager(network)
+	mgr.currentTimeFunc = func() time.Time {
+		return now
+	}
+	req.NoError(mgr.Start(&AlertConfig{EvaluationInterval: time.Minute}))
+	mgr.dispatcher = dispatcher
+
+	mgr.evaluate()
+	events := dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeFired, events[0].EventType)
+	req.Equal(svc.Id, events[0].EntityId)
+
+	// after a snapshot restore, rules missing from the restored data are dropped and their alerts resolved, while
+	// rules only in the restored data are picked up
+	restored := &AlertRule{BaseEntity: models.BaseEntity{Id: "restored"}, Type: AlertRuleRouterOffline, Source: AlertRuleSourceApi}
+	restored.applyDefaults()
+	req.NoError(network.AlertRules.Create(restored, change.New()))
+	req.NoError(network.AlertRules.Delete(rule.Id, change.New()))
+	mgr.rules[rule.Id] = rule
+	mgr.reload()
+
+	events = dispatcher.take()
+	req.Len(events, 1)
+	req.Equal(event.AlertEventTypeResolved, events[0].EventType)
+	req.Nil(mgr.GetRule(rule.Id))
+	req.NotNil(mgr.GetRule(restored.Id))
+
+	// services whose usability hasn't changed keep their state across a reload
+	req.Equal(now, mgr.services[svc.Id].unusableSet)
+	now = now.Add(time.Minute)
+	mgr.reload()
+	req.Equal(now.Add(-time.Minute), mgr.services[svc.Id].unusableSet)
+}
+
+func TestParseAlertConfig(t *testing.T) {
+	req := require.New(t)
+
+	config, err := ParseAlertConfig(map[interface{}]interface{}{
+		"evaluationInterval": "30s",
+		"rules": []interface{}{
+			map[interface{}]interface{}{"id": "offline", "type": "routerOffline", "for": "10m"},
+			map[interface{}]interface{}{"id": "failures", "type": "circuitFailureRate", "threshold": 12.5},
+		},
+	})
+	req.NoError(err)
+	req.Equal(30*time.Second, config.EvaluationInterval)
+	req.Len(config.Rules, 2)
+	req.Equal(10*time.Minute, config.Rules[0].For)
+	req.Equal(AlertRuleSourceConfig, config.Rules[0].Source)
+	req.Equal(12.5, config.Rules[1].Threshold)
+	req.Equal(DefaultAlertFailureRateWindow, config.Rules[1].Window)
+	req.Equal(DefaultAlertMinCircuits, config.Rules[1].MinCircuits)
+
+	_, err = ParseAlertConfig(map[interface{}]interface{}{
+		"rules": []interface{}{
+			map[interface{}]interface{}{"id": "latency", "type": "linkLatency"},
+		},
+	})
+	req.Error(err)
+}
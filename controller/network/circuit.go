@@ -17,6 +17,7 @@
 package network
 
 import (
+	"context"
 	"github.com/openziti/identity"
 	"github.com/openziti/storage/objectz"
 	"github.com/openziti/ziti/common/logcontext"
@@ -38,6 +39,10 @@ type Circuit struct {
 	PeerData   xt.PeerData
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+
+	// improvedCycles is the number of consecutive smart routing cycles in which a lower cost path was found. It's
+	// only used by smart routing, which runs on the network goroutine
+	improvedCycles uint32
 }
 
 func (self *Circuit) GetId() string {
@@ -76,7 +81,7 @@ func (self *Circuit) HasRouter(routerId string) bool {
 	if self == nil || self.Path == nil {
 		return false
 	}
-	for _, node := range self.Path.Nodes {
+	for _, node := range self.Path.allNodes() {
 		if node.Id == routerId {
 			return true
 		}
@@ -150,6 +155,16 @@ func (self *circuitController) all() []*Circuit {
 	return circuits
 }
 
+func (self *circuitController) countForTerminator(terminatorId string) int {
+	count := 0
+	self.circuits.IterCb(func(_ string, circuit *Circuit) {
+		if circuit.Terminator.GetId() == terminatorId {
+			count++
+		}
+	})
+	return count
+}
+
 func (self *circuitController) remove(circuit *Circuit) {
 	self.circuits.Remove(circuit.Id)
 }
@@ -161,4 +176,82 @@ type CreateCircuitParams interface {
 	GetCircuitTags(terminator xt.CostedTerminator) map[string]string
 	GetLogContext() logcontext.Context
 	GetDeadline() time.Time
+	GetTraceContext() context.Context
+}
+
+type dialContext struct {
+	network      *Network
+	clientId     string
+	peerData     xt.PeerData
+	sourceRouter *Router
+	service      *Service
+	locality     *xt.Locality
+}
+
+func (self *dialContext) GetClientId() string {
+	return self.clientId
+}
+
+func (self *dialContext) GetPeerData() xt.PeerData {
+	return self.peerData
+}
+
+func (self *dialContext) GetServiceTags() map[string]interface{} {
+	return self.service.Tags
+}
+
+// GetLocality returns the locality of the dialing identity. If the identity doesn't have one, the locality of the
+// router the dial came in on is used
+func (self *dialContext) GetLocality() xt.Locality {
+	if self.locality == nil {
+		locality := self.network.getIdentityLocality(self.clientId)
+		if locality.Region == "" && self.sourceRouter != nil {
+			locality = getLocality(self.sourceRouter.Tags)
+		}
+		self.locality = &locality
+	}
+	return *self.locality
+}
+
+// GetTerminatorLocalities returns the locality of each terminator's hosting identity. If the host doesn't have
+// one, the locality of the terminator's router is used. The hosting identities are all loaded in one transaction
+func (self *dialContext) GetTerminatorLocalities(terminators []xt.CostedTerminator) map[string]xt.Locality {
+	var hostIds []string
+	for _, terminator := range terminators {
+		if hostId := terminator.GetHostId(); hostId != "" {
+			hostIds = append(hostIds, hostId)
+		}
+	}
+	hostLocalities := self.network.getIdentityLocalities(hostIds)
+
+	routerLocalities := map[string]xt.Locality{}
+	result := map[string]xt.Locality{}
+	for _, terminator := range terminators {
+		locality := hostLocalities[terminator.GetHostId()]
+		if locality.Region == "" {
+			routerLocality, found := routerLocalities[terminator.GetRouterId()]
+			if !found {
+				if router, _ := self.network.Routers.Read(terminator.GetRouterId()); router != nil {
+					routerLocality = getLocality(router.Tags)
+				}
+				routerLocalities[terminator.GetRouterId()] = routerLocality
+			}
+			locality = routerLocality
+		}
+		result[terminator.GetId()] = locality
+	}
+	return result
+}
+
+func (network *Network) newDialContext(params CreateCircuitParams, svc *Service) xt.DialContext {
+	result := &dialContext{
+		network:      network,
+		clientId:     params.GetCircuitTags(nil)["clientId"],
+		sourceRouter: params.GetSourceRouter(),
+		service:      svc,
+	}
+	if clientId := params.GetClientId(); clientId != nil {
+		result.peerData = clientId.Data
+	}
+	return result
 }
@@ -0,0 +1,310 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/xt"
+	"go.etcd.io/bbolt"
+)
+
+// DryRunCircuitParams describes a dial to evaluate. The service may be given by id or by name. If no ingress router
+// is given, every edge router the identity can use to reach the service is evaluated
+type DryRunCircuitParams struct {
+	ServiceId  string
+	IdentityId string
+	RouterId   string
+	InstanceId string
+}
+
+// DryRunCircuitResult describes the decisions which would be made to create a circuit for a dial
+type DryRunCircuitResult struct {
+	ServiceId   string
+	ServiceName string
+	Strategy    string
+	IdentityId  string
+	Ingresses   []*DryRunIngress
+	Error       string
+}
+
+// DryRunIngress is the outcome of a dial through a single ingress router. If the dial would fail, Error holds the
+// reason
+type DryRunIngress struct {
+	RouterId     string
+	TerminatorId string
+	Path         []*DryRunHop
+	PathCost     int64
+	Terminators  []*DryRunTerminator
+	Error        string
+}
+
+// DryRunTerminator holds how a terminator was costed. RouteCost is biased by precedence, and is what strategies
+// compare. Terminators which weren't selected have the reason in Rejected
+type DryRunTerminator struct {
+	Id          string
+	RouterId    string
+	Binding     string
+	Address     string
+	InstanceId  string
+	Precedence  string
+	StaticCost  uint16
+	DynamicCost uint16
+	PathCost    uint32
+	RouteCost   uint32
+	Selected    bool
+	Rejected    string
+}
+
+// DryRunHop is a router on a path. LinkId and LinkCost describe the link to the next router, and are empty for the
+// last router on the path
+type DryRunHop struct {
+	RouterId   string
+	RouterCost int64
+	LinkId     string
+	LinkCost   int64
+}
+
+// DryRunCircuit runs terminator and path selection for a dial, without creating a circuit. Strategies which track
+// pending dials are told the dial was cancelled, so the dry run doesn't affect later selections
+func (network *Network) DryRunCircuit(params *DryRunCircuitParams) (*DryRunCircuitResult, error) {
+	svc, err := network.readServiceByIdOrName(params.ServiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy, err := network.strategyRegistry.GetStrategy(svc.TerminatorStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DryRunCircuitResult{
+		ServiceId:   svc.Id,
+		ServiceName: svc.Name,
+		Strategy:    svc.TerminatorStrategy,
+		IdentityId:  params.IdentityId,
+	}
+
+	if params.RouterId == "" && params.IdentityId == "" {
+		return nil, errorz.NewFieldError("either an identity or a router must be given", "routerId", params.RouterId)
+	}
+
+	routerIds := []string{params.RouterId}
+	if params.IdentityId != "" {
+		edgeRouterIds, dialable, err := network.getEdgeRoutersForDial(params.IdentityId, svc.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !dialable {
+			result.Error = fmt.Sprintf("identity %v isn't granted dial access to service %v by any service policy", params.IdentityId, svc.Name)
+			return result, nil
+		}
+
+		if params.RouterId == "" {
+			routerIds = edgeRouterIds
+			if len(routerIds) == 0 {
+				result.Error = fmt.Sprintf("identity %v has no edge routers in common with service %v", params.IdentityId, svc.Name)
+			}
+		} else if !stringz.Contains(edgeRouterIds, params.RouterId) {
+			result.Error = fmt.Sprintf("identity %v isn't granted access to router %v for service %v by edge router and service edge router policies",
+				params.IdentityId, params.RouterId, svc.Name)
+			return result, nil
+		}
+	}
+
+	constraints := network.getPathConstraints(newPathPolicy(svc))
+	for _, routerId := range routerIds {
+		result.Ingresses = append(result.Ingresses, network.dryRunIngress(params, svc, strategy, routerId, constraints))
+	}
+
+	return result, nil
+}
+
+func (network *Network) readServiceByIdOrName(idOrName string) (*Service, error) {
+	svc, err := network.Services.Read(idOrName)
+	if err != nil && boltz.IsErrNotFoundErr(err) {
+		if id, _ := network.Services.GetIdForName(idOrName); id != "" {
+			return network.Services.Read(id)
+		}
+	}
+	return svc, err
+}
+
+// getEdgeRoutersForDial returns the edge routers which both the identity and the service have access to. As when a
+// dial session is created, the identity must be granted dial access to the service by a service policy. If it isn't,
+// no routers are returned and dialable is false
+func (network *Network) getEdgeRoutersForDial(identityId, serviceId string) (result []string, dialable bool, err error) {
+	err = network.GetDb().View(func(tx *bbolt.Tx) error {
+		if !network.stores.Identity.IsEntityPresent(tx, identityId) {
+			return boltz.NewNotFoundError(network.stores.Identity.GetSingularEntityType(), "id", identityId)
+		}
+
+		if dialable = network.stores.EdgeService.IsDialableByIdentity(tx, serviceId, identityId); !dialable {
+			return nil
+		}
+
+		identityEdgeRouters := network.stores.Identity.GetRefCountedLinkCollection(db.EntityTypeRouters)
+		serviceEdgeRouters := network.stores.EdgeService.GetRefCountedLinkCollection(db.FieldEdgeRouters)
+
+		cursor := identityEdgeRouters.IterateLinks(tx, []byte(identityId), true)
+		for cursor.IsValid() {
+			edgeRouterId := cursor.Current()
+			identityCount := identityEdgeRouters.GetLinkCount(tx, []byte(identityId), edgeRouterId)
+			serviceCount := serviceEdgeRouters.GetLinkCount(tx, []byte(serviceId), edgeRouterId)
+			if identityCount != nil && *identityCount > 0 && serviceCount != nil && *serviceCount > 0 {
+				result = append(result, string(edgeRouterId))
+			}
+			cursor.Next()
+		}
+		return nil
+	})
+	return result, dialable, err
+}
+
+func (network *Network) dryRunIngress(params *DryRunCircuitParams, svc *Service, strategy xt.Strategy, routerId string, constraints *pathConstraints) *DryRunIngress {
+	result := &DryRunIngress{
+		RouterId: routerId,
+	}
+
+	srcR := network.Routers.getConnected(routerId)
+	if srcR == nil {
+		result.Error = fmt.Sprintf("router %v is not online", routerId)
+		return result
+	}
+
+	paths := map[string]*PathAndCost{}
+	details := map[string]*DryRunTerminator{}
+	var candidates []xt.CostedTerminator
+
+	for _, terminator := range svc.Terminators {
+		detail := &DryRunTerminator{
+			Id:          terminator.Id,
+			RouterId:    terminator.Router,
+			Binding:     terminator.Binding,
+			Address:     terminator.Address,
+			InstanceId:  terminator.InstanceId,
+			Precedence:  terminator.Precedence.String(),
+			StaticCost:  terminator.Cost,
+			DynamicCost: xt.GlobalCosts().GetDynamicCost(terminator.Id),
+		}
+		result.Terminators = append(result.Terminators, detail)
+		details[terminator.Id] = detail
+
+		if terminator.InstanceId != params.InstanceId {
+			detail.Rejected = fmt.Sprintf("instance id '%v' doesn't match requested instance id '%v'", terminator.InstanceId, params.InstanceId)
+			continue
+		}
+
+		if terminator.Precedence.IsDraining() {
+			detail.Rejected = "terminator is draining"
+			continue
+		}
+
+		pathAndCost, found := paths[terminator.Router]
+		if !found {
+			dstR := network.Routers.getConnected(terminator.Router)
+			if dstR == nil {
+				detail.Rejected = fmt.Sprintf("router %v is not online", terminator.Router)
+				continue
+			}
+
+			path, cost, err := network.constrainedPath(srcR, dstR, constraints)
+			if err != nil {
+				detail.Rejected = err.Error()
+				continue
+			}
+
+			pathAndCost = newPathAndCost(path, cost)
+			paths[terminator.Router] = pathAndCost
+		}
+
+		routingTerminator := newRoutingTerminator(terminator, pathAndCost.cost)
+		detail.PathCost = pathAndCost.cost
+		detail.RouteCost = routingTerminator.RouteCost
+		candidates = append(candidates, routingTerminator)
+	}
+
+	if len(candidates) == 0 {
+		if len(svc.Terminators) == 0 {
+			result.Error = fmt.Sprintf("service %v has no terminators", svc.Name)
+		} else {
+			result.Error = fmt.Sprintf("service %v has no usable terminators", svc.Name)
+		}
+		return result
+	}
+
+	dial := &dialContext{
+		network:      network,
+		clientId:     params.IdentityId,
+		sourceRouter: srcR,
+		service:      svc,
+	}
+
+	selected, err := selectTerminator(strategy, dial, candidates)
+	if err != nil {
+		result.Error = fmt.Sprintf("strategy %v errored selecting terminator: %v", svc.TerminatorStrategy, err)
+		return result
+	}
+	if selected == nil {
+		result.Error = fmt.Sprintf("strategy %v did not select a terminator", svc.TerminatorStrategy)
+		return result
+	}
+	strategy.NotifyEvent(xt.NewDialCancelled(selected))
+
+	for _, candidate := range candidates {
+		detail := details[candidate.GetId()]
+		if candidate.GetId() == selected.GetId() {
+			detail.Selected = true
+		} else if candidate.GetPrecedence() != selected.GetPrecedence() {
+			detail.Rejected = fmt.Sprintf("precedence %v is lower than the selected terminator's precedence %v",
+				candidate.GetPrecedence(), selected.GetPrecedence())
+		} else {
+			detail.Rejected = fmt.Sprintf("not selected by the %v strategy", svc.TerminatorStrategy)
+		}
+	}
+
+	pathAndCost := paths[selected.GetRouterId()]
+	result.TerminatorId = selected.GetId()
+	result.PathCost = int64(pathAndCost.cost)
+	result.Path = network.getDryRunHops(pathAndCost.path)
+
+	return result
+}
+
+func (network *Network) getDryRunHops(nodes []*Router) []*DryRunHop {
+	minRouterCost := network.options.MinRouterCost
+	var result []*DryRunHop
+	for idx, r := range nodes {
+		hop := &DryRunHop{
+			RouterId:   r.Id,
+			RouterCost: int64(maxUint16(r.Cost, minRouterCost)),
+		}
+		if idx < len(nodes)-1 {
+			if link, found := network.linkController.leastExpensiveLink(r, nodes[idx+1]); found {
+				hop.LinkId = link.Id
+				hop.LinkCost = link.GetCost()
+			}
+		}
+		result = append(result, hop)
+	}
+	return result
+}
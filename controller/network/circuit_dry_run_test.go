@@ -0,0 +1,204 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/storage/boltztest"
+	"github.com/openziti/ziti/common/eid"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_smartrouting"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunCircuit(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	n := test.network
+	for _, r := range test.routers {
+		req.NoError(n.Routers.Create(r, change.New()))
+	}
+
+	svc := &Service{
+		BaseEntity:         models.BaseEntity{Id: "dry-run-svc"},
+		Name:               "dry-run",
+		TerminatorStrategy: xt_smartrouting.Name,
+	}
+	req.NoError(n.Services.Create(svc, change.New()))
+
+	addTerminator := func(id, routerId, instanceId string, precedence xt.Precedence) {
+		terminator := &Terminator{
+			BaseEntity: models.BaseEntity{Id: id},
+			Service:    svc.Id,
+			Router:     routerId,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1234",
+			InstanceId: instanceId,
+			Precedence: precedence,
+		}
+		req.NoError(n.Terminators.Create(terminator, change.New()))
+	}
+
+	addTerminator("t-r3", "r3", "", xt.Precedences.Default)
+	addTerminator("t-r2", "r2", "", xt.Precedences.Default)
+	addTerminator("t-r1", "r1", "", xt.Precedences.Failed)
+	addTerminator("t-r3-other", "r3", "other", xt.Precedences.Default)
+
+	result, err := n.DryRunCircuit(&DryRunCircuitParams{
+		ServiceId: svc.Name,
+		RouterId:  "r0",
+	})
+	req.NoError(err)
+	req.Equal(svc.Id, result.ServiceId)
+	req.Equal(xt_smartrouting.Name, result.Strategy)
+	req.Len(result.Ingresses, 1)
+
+	ingress := result.Ingresses[0]
+	req.Empty(ingress.Error)
+	req.Equal("t-r2", ingress.TerminatorId)
+	req.Equal(int64(13), ingress.PathCost)
+	req.Equal([]*DryRunHop{
+		{RouterId: "r0", RouterCost: 10, LinkId: "l02", LinkCost: 3},
+		{RouterId: "r2", RouterCost: 10},
+	}, ingress.Path)
+
+	terminators := map[string]*DryRunTerminator{}
+	for _, terminator := range ingress.Terminators {
+		terminators[terminator.Id] = terminator
+	}
+	req.Len(terminators, 4)
+
+	req.True(terminators["t-r2"].Selected)
+	req.Empty(terminators["t-r2"].Rejected)
+	req.Equal(uint32(13), terminators["t-r2"].PathCost)
+
+	req.False(terminators["t-r3"].Selected)
+	req.Equal(uint32(23), terminators["t-r3"].PathCost)
+	req.Contains(terminators["t-r3"].Rejected, "not selected")
+
+	req.False(terminators["t-r1"].Selected)
+	req.Equal("failed", terminators["t-r1"].Precedence)
+	req.Contains(terminators["t-r1"].Rejected, "precedence")
+	req.Greater(terminators["t-r1"].RouteCost, terminators["t-r2"].RouteCost)
+
+	req.False(terminators["t-r3-other"].Selected)
+	req.Contains(terminators["t-r3-other"].Rejected, "instance id")
+
+	// dialing a specific instance should only consider terminators for that instance
+	result, err = n.DryRunCircuit(&DryRunCircuitParams{
+		ServiceId:  svc.Id,
+		RouterId:   "r0",
+		InstanceId: "other",
+	})
+	req.NoError(err)
+	req.Equal("t-r3-other", result.Ingresses[0].TerminatorId)
+	req.Len(result.Ingresses[0].Path, 3)
+
+	// with the ingress router offline, the dial should fail without selecting a terminator
+	n.Routers.markDisconnected(test.routers[0])
+	result, err = n.DryRunCircuit(&DryRunCircuitParams{
+		ServiceId: svc.Id,
+		RouterId:  "r0",
+	})
+	req.NoError(err)
+	req.Empty(result.Ingresses[0].TerminatorId)
+	req.Contains(result.Ingresses[0].Error, "not online")
+
+	// nothing to dial from
+	_, err = n.DryRunCircuit(&DryRunCircuitParams{ServiceId: svc.Id})
+	var fieldErr *errorz.FieldError
+	req.ErrorAs(err, &fieldErr)
+
+	_, err = n.DryRunCircuit(&DryRunCircuitParams{ServiceId: "missing", RouterId: "r0"})
+	req.True(boltz.IsErrNotFoundErr(err))
+}
+
+func TestDryRunCircuitDialPolicy(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	n := test.network
+
+	for _, id := range []string{"r0", "r1"} {
+		boltztest.RequireCreate(ctx, &db.EdgeRouter{
+			Router: db.Router{BaseExtEntity: boltz.BaseExtEntity{Id: id}, Name: id},
+		})
+	}
+
+	svc := ctx.RequireNewService("dry-run")
+	identity := ctx.RequireNewIdentity("dialer", false)
+	other := ctx.RequireNewIdentity("other", false)
+
+	boltztest.RequireCreate(ctx, &db.ServicePolicy{
+		BaseExtEntity: boltz.BaseExtEntity{Id: eid.New()},
+		Name:          "dial",
+		PolicyType:    db.PolicyTypeDial,
+		Semantic:      db.SemanticAnyOf,
+		IdentityRoles: []string{"@" + identity.Id},
+		ServiceRoles:  []string{"@" + svc.Id},
+	})
+	boltztest.RequireCreate(ctx, &db.EdgeRouterPolicy{
+		BaseExtEntity:   boltz.BaseExtEntity{Id: eid.New()},
+		Name:            "erp",
+		Semantic:        db.SemanticAnyOf,
+		IdentityRoles:   []string{db.AllRole},
+		EdgeRouterRoles: []string{"@r0"},
+	})
+	boltztest.RequireCreate(ctx, &db.ServiceEdgeRouterPolicy{
+		BaseExtEntity:   boltz.BaseExtEntity{Id: eid.New()},
+		Name:            "serp",
+		Semantic:        db.SemanticAnyOf,
+		ServiceRoles:    []string{db.AllRole},
+		EdgeRouterRoles: []string{db.AllRole},
+	})
+
+	// without a router, every router the identity can use is evaluated
+	result, err := n.DryRunCircuit(&DryRunCircuitParams{ServiceId: svc.Id, IdentityId: identity.Id})
+	req.NoError(err)
+	req.Empty(result.Error)
+	req.Len(result.Ingresses, 1)
+	req.Equal("r0", result.Ingresses[0].RouterId)
+
+	// a router the identity may use is evaluated
+	result, err = n.DryRunCircuit(&DryRunCircuitParams{ServiceId: svc.Id, IdentityId: identity.Id, RouterId: "r0"})
+	req.NoError(err)
+	req.Empty(result.Error)
+	req.Len(result.Ingresses, 1)
+
+	// a router the identity isn't granted isn't
+	result, err = n.DryRunCircuit(&DryRunCircuitParams{ServiceId: svc.Id, IdentityId: identity.Id, RouterId: "r1"})
+	req.NoError(err)
+	req.Contains(result.Error, "router r1")
+	req.Empty(result.Ingresses)
+
+	// identities which can't dial the service are rejected, even if a router is given
+	result, err = n.DryRunCircuit(&DryRunCircuitParams{ServiceId: svc.Id, IdentityId: other.Id, RouterId: "r0"})
+	req.NoError(err)
+	req.Contains(result.Error, "dial access")
+	req.Empty(result.Ingresses)
+}
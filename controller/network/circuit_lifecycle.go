@@ -23,26 +23,49 @@ import (
 	"time"
 )
 
-func (network *Network) fillCircuitPath(e *event.CircuitEvent, path *Path) {
-	if path == nil {
-		return
+func toEventCircuitPath(path *Path) event.CircuitPath {
+	result := event.CircuitPath{
+		IngressId:            path.IngressId,
+		EgressId:             path.EgressId,
+		InitiatorLocalAddr:   path.InitiatorLocalAddr,
+		InitiatorRemoteAddr:  path.InitiatorRemoteAddr,
+		TerminatorLocalAddr:  path.TerminatorLocalAddr,
+		TerminatorRemoteAddr: path.TerminatorRemoteAddr,
 	}
 	for _, r := range path.Nodes {
-		e.Path.Nodes = append(e.Path.Nodes, r.Id)
+		result.Nodes = append(result.Nodes, r.Id)
 	}
 	for _, l := range path.Links {
-		e.Path.Links = append(e.Path.Links, l.Id)
+		result.Links = append(result.Links, l.Id)
+	}
+	return result
+}
+
+func (network *Network) fillCircuitPath(e *event.CircuitEvent, path *Path) {
+	if path == nil {
+		return
 	}
-	e.Path.IngressId = path.IngressId
-	e.Path.EgressId = path.EgressId
-	e.Path.InitiatorLocalAddr = path.InitiatorLocalAddr
-	e.Path.InitiatorRemoteAddr = path.InitiatorRemoteAddr
-	e.Path.TerminatorLocalAddr = path.TerminatorLocalAddr
-	e.Path.TerminatorRemoteAddr = path.TerminatorRemoteAddr
+	e.Path = toEventCircuitPath(path)
 	e.LinkCount = len(path.Links)
 }
 
 func (network *Network) CircuitEvent(eventType event.CircuitEventType, circuit *Circuit, creationTimespan *time.Duration) {
+	network.eventDispatcher.AcceptCircuitEvent(network.newCircuitEvent(eventType, circuit, creationTimespan))
+}
+
+// CircuitReroutedEvent reports that a circuit was moved from oldPath to its current path, and why
+func (network *Network) CircuitReroutedEvent(circuit *Circuit, oldPath *Path, reason RerouteReason) {
+	circuitEvent := network.newCircuitEvent(event.CircuitRerouted, circuit, nil)
+	if oldPath != nil {
+		path := toEventCircuitPath(oldPath)
+		circuitEvent.OldPath = &path
+	}
+	rerouteReason := string(reason)
+	circuitEvent.RerouteReason = &rerouteReason
+	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
+}
+
+func (network *Network) newCircuitEvent(eventType event.CircuitEventType, circuit *Circuit, creationTimespan *time.Duration) *event.CircuitEvent {
 	var cost *uint32
 	var duration *time.Duration
 	if eventType == event.CircuitCreated {
@@ -70,7 +93,7 @@ func (network *Network) CircuitEvent(eventType event.CircuitEventType, circuit *
 	}
 
 	network.fillCircuitPath(circuitEvent, circuit.Path)
-	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
+	return circuitEvent
 }
 
 type CircuitFailureCause string
@@ -0,0 +1,81 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/xgress"
+)
+
+// PayloadCompressionTag is the service tag which selects how the payloads of the service's circuits are compressed
+// between the ingress and egress routers. Valid values are snappy, zstd and none
+const PayloadCompressionTag = "payloadCompression"
+
+func getPayloadCompression(svc *Service) xgress.Compression {
+	val, found := svc.Tags[PayloadCompressionTag]
+	if !found {
+		return xgress.CompressionNone
+	}
+
+	compression, err := xgress.ParseCompression(fmt.Sprint(val))
+	if err != nil {
+		pfxlog.Logger().WithField("serviceId", svc.Id).WithError(err).Warn("invalid payload compression on service")
+	}
+	return compression
+}
+
+func validatePayloadCompressionTag(tags map[string]interface{}) error {
+	val, found := tags[PayloadCompressionTag]
+	if !found {
+		return nil
+	}
+	name, ok := val.(string)
+	if !ok {
+		return errorz.NewFieldError("payloadCompression must be a string", "tags."+PayloadCompressionTag, val)
+	}
+	if _, err := xgress.ParseCompression(name); err != nil {
+		return errorz.NewFieldError(err.Error(), "tags."+PayloadCompressionTag, val)
+	}
+	return nil
+}
+
+// setPayloadCompressionTag tells the xgress instances at each end of the circuit to compress payloads, if the service
+// asks for compression and both routers support it. Routers in the middle of the path pass compressed payloads
+// through unchanged, so they don't need to support it
+func setPayloadCompressionTag(svc *Service, path *Path, tags map[string]string) {
+	compression := getPayloadCompression(svc)
+	if compression == xgress.CompressionNone || tags == nil {
+		return
+	}
+
+	ingress := path.Nodes[0]
+	egress := path.Nodes[len(path.Nodes)-1]
+	if !ingress.HasCapability(ctrl_pb.RouterCapability_PayloadCompression) ||
+		!egress.HasCapability(ctrl_pb.RouterCapability_PayloadCompression) {
+		pfxlog.Logger().WithField("serviceId", svc.Id).
+			WithField("ingressRouterId", ingress.Id).
+			WithField("egressRouterId", egress.Id).
+			Debug("not compressing payloads, ingress or egress router doesn't support payload compression")
+		return
+	}
+
+	tags[xgress.CircuitTagCompression] = string(compression)
+}
@@ -0,0 +1,64 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetPayloadCompressionTag(t *testing.T) {
+	req := require.New(t)
+
+	capable := &ctrl_pb.RouterMetadata{
+		Capabilities: []ctrl_pb.RouterCapability{ctrl_pb.RouterCapability_PayloadCompression},
+	}
+
+	ingress := NewRouter("ingress", "ingress", "", 0, false)
+	transit := NewRouter("transit", "transit", "", 0, false)
+	egress := NewRouter("egress", "egress", "", 0, false)
+	ingress.SetMetadata(capable)
+	egress.SetMetadata(capable)
+
+	path := &Path{Nodes: []*Router{ingress, transit, egress}}
+
+	newService := func(tags map[string]interface{}) *Service {
+		return &Service{BaseEntity: models.BaseEntity{Id: "svc", Tags: tags}}
+	}
+
+	// transit routers don't need to support compression
+	tags := map[string]string{}
+	setPayloadCompressionTag(newService(map[string]interface{}{PayloadCompressionTag: "zstd"}), path, tags)
+	req.Equal(string(xgress.CompressionZstd), tags[xgress.CircuitTagCompression])
+
+	// services which don't ask for compression, or ask for an unsupported one, aren't compressed
+	for _, svcTags := range []map[string]interface{}{nil, {PayloadCompressionTag: "none"}, {PayloadCompressionTag: "lzma"}} {
+		tags = map[string]string{}
+		setPayloadCompressionTag(newService(svcTags), path, tags)
+		req.NotContains(tags, xgress.CircuitTagCompression)
+	}
+
+	// both ends of the circuit have to support compression
+	egress.SetMetadata(nil)
+	tags = map[string]string{}
+	setPayloadCompressionTag(newService(map[string]interface{}{PayloadCompressionTag: "snappy"}), path, tags)
+	req.NotContains(tags, xgress.CircuitTagCompression)
+}
@@ -33,7 +33,7 @@ func (network *Network) fault(ffr *ForwardingFaultReport) {
 		log := pfxlog.Logger().WithField("circuitId", circuitId).WithField("routerId", ffr.R.Id)
 		s, found := network.circuitController.get(circuitId)
 		if found {
-			if success := network.rerouteCircuitWithTries(s, 2); success {
+			if success := network.rerouteCircuitWithTries(s, 2, RerouteReasonForwardingFault); success {
 				log.Info("rerouted circuit in response to forwarding fault from router")
 			} else {
 				log.Infof("error rerouting circuit in response to forwarding fault from router, circuit removed")
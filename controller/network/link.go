@@ -24,8 +24,12 @@ import (
 )
 
 type Link struct {
-	SrcLatency  int64
-	DstLatency  int64
+	SrcLatency int64
+	DstLatency int64
+	// SrcLoadCost and DstLoadCost are the costs added for the utilization, retransmissions and loss measured at
+	// either end of the link. They're only set if bandwidth aware link costing is enabled
+	SrcLoadCost int64
+	DstLoadCost int64
 	Cost        int64
 	Id          string
 	Iteration   uint32
@@ -39,6 +43,9 @@ type Link struct {
 	StaticCost  int32
 	usable      atomic.Bool
 	lock        sync.Mutex
+	// costChanged is set when the link is added to the link controller. It's called when the link's cost or
+	// usability changes, so cached paths can be refreshed
+	costChanged func()
 }
 
 func newLink(id string, linkProtocol string, dialAddress string, initialLatency time.Duration) *Link {
@@ -81,6 +88,7 @@ func (link *Link) SetState(m LinkMode) {
 	link.state.Mode = m
 	link.state.Timestamp = time.Now().UnixMilli()
 	link.recalculateUsable()
+	link.notifyCostChanged()
 }
 
 func (link *Link) SetDown(down bool) {
@@ -88,6 +96,7 @@ func (link *Link) SetDown(down bool) {
 	defer link.lock.Unlock()
 	link.down = down
 	link.recalculateUsable()
+	link.notifyCostChanged()
 }
 
 func (link *Link) IsDown() bool {
@@ -137,9 +146,36 @@ func (link *Link) SetDstLatency(latency int64) {
 	link.recalculateCost()
 }
 
+func (link *Link) GetSrcLoadCost() int64 {
+	return atomic.LoadInt64(&link.SrcLoadCost)
+}
+
+func (link *Link) SetSrcLoadCost(cost int64) {
+	atomic.StoreInt64(&link.SrcLoadCost, cost)
+	link.recalculateCost()
+}
+
+func (link *Link) GetDstLoadCost() int64 {
+	return atomic.LoadInt64(&link.DstLoadCost)
+}
+
+func (link *Link) SetDstLoadCost(cost int64) {
+	atomic.StoreInt64(&link.DstLoadCost, cost)
+	link.recalculateCost()
+}
+
 func (link *Link) recalculateCost() {
-	cost := int64(link.GetStaticCost()) + link.GetSrcLatency()/1_000_000 + link.GetDstLatency()/1_000_000
-	atomic.StoreInt64(&link.Cost, cost)
+	cost := int64(link.GetStaticCost()) + link.GetSrcLatency()/1_000_000 + link.GetDstLatency()/1_000_000 +
+		link.GetSrcLoadCost() + link.GetDstLoadCost()
+	if atomic.SwapInt64(&link.Cost, cost) != cost {
+		link.notifyCostChanged()
+	}
+}
+
+func (link *Link) notifyCostChanged() {
+	if link.costChanged != nil {
+		link.costChanged()
+	}
 }
 
 func (link *Link) GetCost() int64 {
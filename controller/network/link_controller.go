@@ -31,6 +31,7 @@ import (
 	"math"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -40,6 +41,10 @@ type linkController struct {
 	lock           sync.Mutex
 	initialLatency time.Duration
 	store          *objectz.ObjectStore[*Link]
+	// topologyVersion is incremented whenever routers or links are added or removed
+	topologyVersion atomic.Uint64
+	// costVersion is incremented whenever a link's cost or usability changes
+	costVersion atomic.Uint64
 }
 
 func newLinkController(options *Options) *linkController {
@@ -108,14 +113,25 @@ func (linkController *linkController) buildRouterLinks(router *Router) {
 			link.Dst.Store(router)
 		}
 	})
+	linkController.topologyChanged()
 }
 
 func (linkController *linkController) add(link *Link) {
+	link.costChanged = linkController.costChanged
 	linkController.linkTable.add(link)
 	link.Src.routerLinks.Add(link, link.DstId)
 	if dest := link.GetDest(); dest != nil {
 		dest.routerLinks.Add(link, link.Src.Id)
 	}
+	linkController.topologyChanged()
+}
+
+func (linkController *linkController) topologyChanged() {
+	linkController.topologyVersion.Add(1)
+}
+
+func (linkController *linkController) costChanged() {
+	linkController.costVersion.Add(1)
 }
 
 func (linkController *linkController) has(link *Link) bool {
@@ -180,6 +196,7 @@ func (linkController *linkController) remove(link *Link) {
 		if dest := link.GetDest(); dest != nil {
 			dest.routerLinks.Remove(link, link.Src.Id)
 		}
+		linkController.topologyChanged()
 	}
 }
 
@@ -0,0 +1,76 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/controller/xt"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	LocalityRegionTag = "region"
+	LocalityZoneTag   = "zone"
+)
+
+// getLocality returns the locality described by the region and zone tags of a router or identity
+func getLocality(tags map[string]interface{}) xt.Locality {
+	result := xt.Locality{}
+	if region, ok := tags[LocalityRegionTag].(string); ok {
+		result.Region = region
+	}
+	if zone, ok := tags[LocalityZoneTag].(string); ok {
+		result.Zone = zone
+	}
+	return result
+}
+
+func (network *Network) getIdentityLocality(identityId string) xt.Locality {
+	if identityId == "" {
+		return xt.Locality{}
+	}
+	return network.getIdentityLocalities([]string{identityId})[identityId]
+}
+
+// getIdentityLocalities returns the localities of the given identities, keyed by identity id, loading them in a
+// single transaction
+func (network *Network) getIdentityLocalities(identityIds []string) map[string]xt.Locality {
+	result := map[string]xt.Locality{}
+	if len(identityIds) == 0 {
+		return result
+	}
+
+	err := network.GetDb().View(func(tx *bbolt.Tx) error {
+		for _, identityId := range identityIds {
+			if _, found := result[identityId]; found {
+				continue
+			}
+			identity, found, err := network.stores.Identity.FindById(tx, identityId)
+			if err != nil {
+				return err
+			}
+			if found {
+				result[identityId] = getLocality(identity.Tags)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to load identity localities")
+	}
+	return result
+}
@@ -47,6 +47,9 @@ type Managers struct {
 	Routers         *RouterManager
 	Services        *ServiceManager
 	Inspections     *InspectionsManager
+	UsageRollups    *UsageRollupManager
+	Alerts          *AlertManager
+	AlertRules      *AlertRuleManager
 	Command         *CommandManager
 	Dispatcher      command.Dispatcher
 	Registry        ioc.Registry
@@ -166,6 +169,9 @@ func NewManagers(network *Network, dispatcher command.Dispatcher, db boltz.Db, s
 	result.Routers = newRouterManager(result)
 	result.Services = newServiceManager(result)
 	result.Inspections = NewInspectionsManager(network)
+	result.UsageRollups = newUsageRollupManager(network)
+	result.Alerts = newAlertManager(network)
+	result.AlertRules = newAlertRuleManager(result)
 	if result.Dispatcher == nil {
 		devVersion := versions.MustParseSemVer("0.0.0")
 		version := versions.MustParseSemVer(network.VersionProvider.Version())
@@ -180,6 +186,8 @@ func NewManagers(network *Network, dispatcher command.Dispatcher, db boltz.Db, s
 	RegisterManagerDecoder[*Service](result, result.Services)
 	RegisterManagerDecoder[*Router](result, result.Routers)
 	RegisterManagerDecoder[*Terminator](result, result.Terminators)
+	RegisterCreateDecoder[*AlertRule](result, result.AlertRules)
+	RegisterDeleteDecoder(result, result.AlertRules)
 	RegisterCommand(result, &DeleteTerminatorsBatchCommand{}, &cmd_pb.DeleteTerminatorsBatchCommand{})
 
 	return result
@@ -19,6 +19,7 @@ package network
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/openziti/foundation/v2/concurrenz"
@@ -26,12 +27,16 @@ import (
 	"github.com/openziti/storage/objectz"
 	fabricMetrics "github.com/openziti/ziti/common/metrics"
 	"github.com/openziti/ziti/common/pb/mgmt_pb"
+	"github.com/openziti/ziti/common/tracing"
 	"github.com/openziti/ziti/controller/event"
 	"github.com/openziti/ziti/controller/raft"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"math"
 	"os"
 	"path/filepath"
 	"runtime/debug"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -81,8 +86,10 @@ type Network struct {
 	options                *Options
 	assembleAndCleanC      chan struct{}
 	linkController         *linkController
+	pathCache              *pathCache
 	forwardingFaults       chan struct{}
 	circuitController      *circuitController
+	terminatorDrains       *terminatorDrains
 	routeSenderController  *routeSenderController
 	sequence               *sequence.Sequence
 	eventDispatcher        event.Dispatcher
@@ -130,6 +137,7 @@ func NewNetwork(config Config) (*Network, error) {
 		options:               config.GetOptions(),
 		assembleAndCleanC:     make(chan struct{}, 1),
 		linkController:        newLinkController(config.GetOptions()),
+		pathCache:             newPathCache(DefaultPathCacheSize),
 		forwardingFaults:      make(chan struct{}, 1),
 		circuitController:     newCircuitController(),
 		routeSenderController: newRouteSenderController(),
@@ -170,6 +178,9 @@ func NewNetwork(config Config) (*Network, error) {
 	network.AddRouterPresenceHandler(network.Managers.RouterMessaging)
 	go network.Managers.RouterMessaging.run()
 
+	network.terminatorDrains = newTerminatorDrains(network)
+	go network.terminatorDrains.run()
+
 	return network, nil
 }
 
@@ -520,7 +531,7 @@ func (network *Network) RerouteLink(l *Link) {
 	}()
 }
 
-func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, error) {
+func (network *Network) CreateCircuit(params CreateCircuitParams) (_ *Circuit, err error) {
 	srcR := params.GetSourceRouter()
 	clientId := params.GetClientId()
 	service := params.GetServiceId()
@@ -529,6 +540,10 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 
 	startTime := time.Now()
 
+	traceCtx, span := tracing.Tracer().Start(params.GetTraceContext(), "circuit.create",
+		oteltrace.WithAttributes(tracing.ServiceIdKey.String(service), tracing.RouterIdKey.String(srcR.Id)))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	instanceId, serviceId := parseInstanceIdAndService(service)
 
 	// 1: Allocate Circuit Identifier
@@ -537,6 +552,7 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		network.CircuitFailedEvent(circuitId, params, startTime, nil, nil, CircuitFailureInvalidService)
 		return nil, err
 	}
+	span.SetAttributes(tracing.CircuitIdKey.String(circuitId))
 	ctx.WithFields(map[string]interface{}{
 		"circuitId":     circuitId,
 		"serviceId":     service,
@@ -559,7 +575,8 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		logger = logger.WithField("serviceName", svc.Name)
 
 		// 3: select terminator
-		strategy, terminator, pathNodes, circuitErr := network.selectPath(srcR, svc, instanceId, ctx)
+		dial := network.newDialContext(params, svc)
+		strategy, terminator, pathNodes, circuitErr := network.selectPath(traceCtx, srcR, svc, instanceId, dial, ctx)
 		if circuitErr != nil {
 			network.CircuitFailedEvent(circuitId, params, startTime, nil, nil, circuitErr.Cause())
 			network.ServiceDialOtherError(serviceId)
@@ -567,30 +584,51 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 		}
 
 		// 4: Create Path
+		_, pathSpan := tracing.Tracer().Start(traceCtx, "circuit.createPath", oteltrace.WithAttributes(tracing.AttemptKey.Int(int(attempt))))
 		path, pathErr := network.CreatePathWithNodes(pathNodes)
 		if pathErr != nil {
+			tracing.EndSpan(pathSpan, pathErr)
 			network.CircuitFailedEvent(circuitId, params, startTime, nil, terminator, pathErr.Cause())
 			network.ServiceDialOtherError(serviceId)
 			return nil, pathErr
 		}
+		path.policy = newPathPolicy(svc)
+		if isRedundantPathsEnabled(svc) {
+			network.setRedundantPath(path)
+		}
 
 		// get circuit tags
 		tags := params.GetCircuitTags(terminator)
+		setPayloadCompressionTag(svc, path, tags)
+		setSelectiveAckTag(path, tags)
+		setTrafficClassTag(svc, tags)
 
 		// 4a: Create Route Messages
 		rms := path.CreateRouteMessages(attempt, circuitId, terminator, deadline)
 		rms[len(rms)-1].Egress.PeerData = clientId.Data
+		pathSpan.SetAttributes(tracing.PathKey.String(path.String()))
+		pathSpan.End()
+
+		// 5: Routing
+		routeCtx, routeSpan := tracing.Tracer().Start(traceCtx, "circuit.route",
+			oteltrace.WithAttributes(tracing.AttemptKey.Int(int(attempt)), tracing.TerminatorIdKey.String(terminator.GetId())))
+		traceContext := tracing.Inject(routeCtx)
 		for _, msg := range rms {
 			msg.Context = &ctrl_pb.Context{
 				Fields:      ctx.GetStringFields(),
 				ChannelMask: ctx.GetChannelsMask(),
 			}
 			msg.Tags = tags
+			msg.TraceContext = traceContext
 		}
 
-		// 5: Routing
 		logger.Debug("route attempt for circuit")
 		peerData, cleanups, circuitErr := rs.route(attempt, path, rms, strategy, terminator, ctx.Clone())
+		if circuitErr != nil {
+			tracing.EndSpan(routeSpan, circuitErr)
+		} else {
+			routeSpan.End()
+		}
 		for k, v := range cleanups {
 			allCleanups[k] = v
 		}
@@ -623,7 +661,7 @@ func (network *Network) CreateCircuit(params CreateCircuitParams) (*Circuit, err
 
 		// 5.a: Unroute Abandoned Routers (from Previous Attempts)
 		usedRouters := make(map[string]struct{})
-		for _, r := range path.Nodes {
+		for _, r := range path.allNodes() {
 			usedRouters[r.Id] = struct{}{}
 		}
 		cleanupCount := 0
@@ -697,7 +735,16 @@ func parseInstanceIdAndService(service string) (string, string) {
 	return identityId, serviceId
 }
 
-func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string, ctx logcontext.Context) (xt.Strategy, xt.CostedTerminator, []*Router, CircuitError) {
+func (network *Network) selectPath(traceCtx context.Context, srcR *Router, svc *Service, instanceId string, dial xt.DialContext, ctx logcontext.Context) (_ xt.Strategy, _ xt.CostedTerminator, _ []*Router, circuitErr CircuitError) {
+	traceCtx, span := tracing.Tracer().Start(traceCtx, "circuit.selectPath", oteltrace.WithAttributes(tracing.StrategyKey.String(svc.TerminatorStrategy)))
+	defer func() {
+		if circuitErr != nil {
+			tracing.EndSpan(span, circuitErr)
+		} else {
+			span.End()
+		}
+	}()
+
 	paths := map[string]*PathAndCost{}
 	var weightedTerminators []xt.CostedTerminator
 	var errList []error
@@ -706,12 +753,20 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 
 	hasOfflineRouters := false
 	pathError := false
+	constraints := network.getPathConstraints(newPathPolicy(svc))
 
+	hasDraining := false
 	for _, terminator := range svc.Terminators {
 		if terminator.InstanceId != instanceId {
 			continue
 		}
 
+		// draining terminators keep their existing circuits, but don't get new ones
+		if terminator.Precedence.IsDraining() {
+			hasDraining = true
+			continue
+		}
+
 		pathAndCost, found := paths[terminator.Router]
 		if !found {
 			dstR := network.Routers.getConnected(terminator.GetRouterId())
@@ -725,7 +780,9 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 				continue
 			}
 
-			path, cost, err := network.shortestPath(srcR, dstR)
+			_, pathSpan := tracing.Tracer().Start(traceCtx, "circuit.shortestPath", oteltrace.WithAttributes(tracing.RouterIdKey.String(dstR.Id)))
+			path, cost, err := network.constrainedPath(srcR, dstR, constraints)
+			tracing.EndSpan(pathSpan, err)
 			if err != nil {
 				log.Debugf("error while calculating path for service %v: %v", svc.Id, err)
 				errList = append(errList, err)
@@ -737,14 +794,7 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 			paths[terminator.GetRouterId()] = pathAndCost
 		}
 
-		dynamicCost := xt.GlobalCosts().GetDynamicCost(terminator.Id)
-		unbiasedCost := uint32(terminator.Cost) + uint32(dynamicCost) + pathAndCost.cost
-		biasedCost := terminator.Precedence.GetBiasedCost(unbiasedCost)
-		costedTerminator := &RoutingTerminator{
-			Terminator: terminator,
-			RouteCost:  biasedCost,
-		}
-		weightedTerminators = append(weightedTerminators, costedTerminator)
+		weightedTerminators = append(weightedTerminators, newRoutingTerminator(terminator, pathAndCost.cost))
 	}
 
 	if len(svc.Terminators) == 0 {
@@ -760,6 +810,10 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 			return nil, nil, nil, newCircuitErrorf(CircuitFailureNoOnlineTerminators, "service %v has no online terminators for instanceId %v", svc.Id, instanceId)
 		}
 
+		if hasDraining {
+			return nil, nil, nil, newCircuitErrorf(CircuitFailureNoTerminators, "service %v has only draining terminators for instanceId %v", svc.Id, instanceId)
+		}
+
 		return nil, nil, nil, newCircuitErrorf(CircuitFailureNoTerminators, "service %v has no terminators for instanceId %v", svc.Id, instanceId)
 	}
 
@@ -768,11 +822,12 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 		return nil, nil, nil, newCircuitErrWrap(CircuitFailureInvalidStrategy, err)
 	}
 
-	sort.Slice(weightedTerminators, func(i, j int) bool {
-		return weightedTerminators[i].GetRouteCost() < weightedTerminators[j].GetRouteCost()
-	})
-
-	terminator, err := strategy.Select(weightedTerminators)
+	_, strategySpan := tracing.Tracer().Start(traceCtx, "circuit.strategySelect")
+	terminator, err := selectTerminator(strategy, dial, weightedTerminators)
+	if terminator != nil {
+		strategySpan.SetAttributes(tracing.TerminatorIdKey.String(terminator.GetId()))
+	}
+	tracing.EndSpan(strategySpan, err)
 
 	if err != nil {
 		return nil, nil, nil, newCircuitErrorf(CircuitFailureStrategyError, "strategy %v errored selecting terminator for service %v: %v", svc.TerminatorStrategy, svc.Id, err)
@@ -806,11 +861,33 @@ func (network *Network) selectPath(srcR *Router, svc *Service, instanceId string
 	return strategy, terminator, path, nil
 }
 
+// newRoutingTerminator costs the terminator for a path of the given cost, biased by the terminator's precedence
+func newRoutingTerminator(terminator *Terminator, pathCost uint32) *RoutingTerminator {
+	dynamicCost := xt.GlobalCosts().GetDynamicCost(terminator.Id)
+	unbiasedCost := uint32(terminator.Cost) + uint32(dynamicCost) + pathCost
+	return &RoutingTerminator{
+		Terminator: terminator,
+		RouteCost:  terminator.Precedence.GetBiasedCost(unbiasedCost),
+	}
+}
+
+// selectTerminator sorts the terminators by route cost and has the strategy pick one of them
+func selectTerminator(strategy xt.Strategy, dial xt.DialContext, terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	sort.Slice(terminators, func(i, j int) bool {
+		return terminators[i].GetRouteCost() < terminators[j].GetRouteCost()
+	})
+
+	if dialStrategy, ok := strategy.(xt.DialContextStrategy); ok && dial != nil {
+		return dialStrategy.SelectForDial(dial, terminators)
+	}
+	return strategy.Select(terminators)
+}
+
 func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 	log := pfxlog.Logger().WithField("circuitId", circuitId)
 
 	if circuit, found := network.circuitController.get(circuitId); found {
-		for _, r := range circuit.Path.Nodes {
+		for _, r := range circuit.Path.allNodes() {
 			err := sendUnroute(r, circuit.Id, now)
 			if err != nil {
 				log.Errorf("error sending unroute to [r/%s] (%s)", r.Id, err)
@@ -819,6 +896,7 @@ func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 
 		network.circuitController.remove(circuit)
 		network.CircuitEvent(event.CircuitDeleted, circuit, nil)
+		network.terminatorDrains.circuitRemoved(circuit)
 
 		if svc, err := network.Services.Read(circuit.ServiceId); err == nil {
 			if strategy, err := network.strategyRegistry.GetStrategy(svc.TerminatorStrategy); strategy != nil {
@@ -885,7 +963,7 @@ func (network *Network) CreatePathWithNodes(nodes []*Router) (*Path, CircuitErro
 func (network *Network) UpdatePath(path *Path) (*Path, error) {
 	srcR := path.Nodes[0]
 	dstR := path.Nodes[len(path.Nodes)-1]
-	nodes, _, err := network.shortestPath(srcR, dstR)
+	nodes, _, err := network.constrainedPath(srcR, dstR, network.getPathConstraints(path.policy))
 	if err != nil {
 		return nil, err
 	}
@@ -898,13 +976,48 @@ func (network *Network) UpdatePath(path *Path) (*Path, error) {
 		InitiatorRemoteAddr:  path.InitiatorRemoteAddr,
 		TerminatorLocalAddr:  path.TerminatorLocalAddr,
 		TerminatorRemoteAddr: path.TerminatorRemoteAddr,
+		policy:               path.policy,
 	}
 	if err := network.setLinks(path2); err != nil {
 		return nil, err
 	}
+	if path.Redundant != nil {
+		network.setRedundantPath(path2)
+	}
 	return path2, nil
 }
 
+// setRedundantPath adds the lowest cost path which shares no links with the given path, and which satisfies the
+// path's policy, if there is one. Paths without a disjoint alternative are left as they are, so circuits still
+// work, just without redundancy
+func (network *Network) setRedundantPath(path *Path) {
+	path.Redundant = nil
+	if len(path.Nodes) < 2 {
+		return
+	}
+
+	constraints := network.getPathConstraints(path.policy)
+	if constraints == nil {
+		constraints = &pathConstraints{}
+	}
+	paths := network.disjointPaths(&RouterPath{Nodes: path.Nodes}, constraints, 2)
+	if len(paths) < 2 {
+		pfxlog.Logger().Debugf("no redundant path available for %v", path)
+		return
+	}
+
+	redundant := &Path{
+		Nodes:     paths[1].Nodes,
+		IngressId: path.IngressId,
+		EgressId:  path.EgressId,
+	}
+	if err := network.setLinks(redundant); err != nil {
+		pfxlog.Logger().WithError(err).Debugf("no redundant path available for %v", path)
+		return
+	}
+	path.Redundant = redundant
+}
+
 func (network *Network) setLinks(path *Path) error {
 	if len(path.Nodes) > 1 {
 		for i := 0; i < len(path.Nodes)-1; i++ {
@@ -944,6 +1057,7 @@ func (network *Network) Run() {
 			network.assemble()
 			network.clean()
 			network.smart()
+			network.terminatorDrains.checkDeadlines()
 			network.linkController.scanForDeadLinks()
 
 		case <-network.closeNotify:
@@ -1064,7 +1178,7 @@ func (network *Network) rerouteLink(l *Link, deadline time.Time) error {
 			log := logrus.WithField("linkId", l.Id).
 				WithField("circuitId", circuit.Id)
 			log.Info("circuit uses link")
-			if err := network.rerouteCircuit(circuit, deadline); err != nil {
+			if err := network.rerouteCircuit(circuit, deadline, RerouteReasonLinkFailed); err != nil {
 				log.WithError(err).Error("error rerouting circuit, removing")
 				if err := network.RemoveCircuit(circuit.Id, true); err != nil {
 					log.WithError(err).Error("error removing circuit after reroute failure")
@@ -1076,17 +1190,20 @@ func (network *Network) rerouteLink(l *Link, deadline time.Time) error {
 	return nil
 }
 
-func (network *Network) rerouteCircuitWithTries(circuit *Circuit, retries int) bool {
+func (network *Network) rerouteCircuitWithTries(circuit *Circuit, retries int, reason RerouteReason) bool {
 	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
 
 	for i := 0; i < retries; i++ {
 		deadline := time.Now().Add(DefaultOptionsRouteTimeout)
-		err := network.rerouteCircuit(circuit, deadline)
+		err := network.rerouteCircuit(circuit, deadline, reason)
 		if err == nil {
 			return true
 		}
 
 		log.WithError(err).WithField("attempt", i).Error("error re-routing circuit")
+		if errors.Is(err, errRerouteNotAllowed) {
+			break
+		}
 	}
 
 	if err := network.RemoveCircuit(circuit.Id, true); err != nil {
@@ -1095,28 +1212,40 @@ func (network *Network) rerouteCircuitWithTries(circuit *Circuit, retries int) b
 	return false
 }
 
-func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) error {
+var errRerouteNotAllowed = errors.New("service reroute policy doesn't allow circuit to be rerouted")
+
+func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time, reason RerouteReason) error {
 	log := pfxlog.Logger().WithField("circuitId", circuit.Id)
+
+	if network.getCircuitReroutePolicy(circuit) == ReroutePolicyNever {
+		return errRerouteNotAllowed
+	}
+
 	if circuit.Rerouting.CompareAndSwap(false, true) {
 		defer circuit.Rerouting.Store(false)
 
 		log.Warn("rerouting circuit")
 
 		if cq, err := network.UpdatePath(circuit.Path); err == nil {
+			oldPath := circuit.Path
 			circuit.Path = cq
 			circuit.UpdatedAt = time.Now()
 
 			rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
 
-			for i := 0; i < len(cq.Nodes); i++ {
-				if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
-					log.WithError(err).Errorf("error sending route to [r/%s]", cq.Nodes[i].Id)
+			nodes := cq.allNodes()
+			for i := 0; i < len(nodes); i++ {
+				// routers new to the circuit need the tags to find the traffic class
+				rms[i].Tags = circuit.Tags
+				if _, err := sendRoute(nodes[i], rms[i], network.options.RouteTimeout); err != nil {
+					log.WithError(err).Errorf("error sending route to [r/%s]", nodes[i].Id)
 				}
 			}
 
 			log.Info("rerouted circuit")
 
 			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
+			network.CircuitReroutedEvent(circuit, oldPath, reason)
 			return nil
 		} else {
 			return err
@@ -1133,15 +1262,18 @@ func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.T
 	if circuit.Rerouting.CompareAndSwap(false, true) {
 		defer circuit.Rerouting.Store(false)
 
+		oldPath := circuit.Path
 		circuit.Path = cq
 		circuit.UpdatedAt = time.Now()
 
 		rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
 
-		for i := 0; i < len(cq.Nodes); i++ {
-			if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
+		nodes := cq.allNodes()
+		for i := 0; i < len(nodes); i++ {
+			rms[i].Tags = circuit.Tags
+			if _, err := sendRoute(nodes[i], rms[i], network.options.RouteTimeout); err != nil {
 				retry = true
-				log.WithField("routerId", cq.Nodes[i].Id).WithError(err).Error("error sending smart route update to router")
+				log.WithField("routerId", nodes[i].Id).WithError(err).Error("error sending smart route update to router")
 				break
 			}
 		}
@@ -1149,6 +1281,7 @@ func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.T
 		if !retry {
 			logrus.Debug("rerouted circuit")
 			network.CircuitEvent(event.CircuitUpdated, circuit, nil)
+			network.CircuitReroutedEvent(circuit, oldPath, RerouteReasonLowerCost)
 		}
 	}
 	return retry
@@ -1190,9 +1323,61 @@ func (network *Network) AcceptMetricsMsg(metrics *metrics_pb.MetricsMessage) {
 				log.Warnf("link not for router")
 			}
 		}
+
+		if loadCost, found := network.getLinkLoadCost(link, metrics); found {
+			if link.Src.Id == router.Id {
+				link.SetSrcLoadCost(loadCost)
+			} else if link.DstId == router.Id {
+				link.SetDstLoadCost(loadCost)
+			}
+		}
 	}
 }
 
+// getLinkLoadCost calculates the cost added to a link for the traffic reported by the router at one end of the
+// link. Utilization is outbound bytes over the configured capacity, with only the portion above the utilization
+// threshold counting. The retransmission and loss rates are relative to the messages sent over the link. Each is
+// scaled by its weight. Rates come from the one minute meter rates
+func (network *Network) getLinkLoadCost(link *Link, metrics *metrics_pb.MetricsMessage) (int64, bool) {
+	options := &network.options.LinkCost
+	if !options.Enabled {
+		return 0, false
+	}
+
+	txMsgs, found := metrics.Meters["link."+link.Id+".tx.msgrate"]
+	if !found {
+		return 0, false
+	}
+
+	var cost float64
+
+	if options.Capacity > 0 {
+		if txBytes, found := metrics.Meters["link."+link.Id+".tx.bytesrate"]; found {
+			utilization := math.Min(txBytes.M1Rate/float64(options.Capacity), 1)
+			if utilization > options.UtilizationThreshold {
+				cost += float64(options.UtilizationWeight) * (utilization - options.UtilizationThreshold) / (1 - options.UtilizationThreshold)
+			}
+		}
+	}
+
+	var retransmits, dropped float64
+	if meter, found := metrics.Meters["link."+link.Id+".retransmissions"]; found {
+		retransmits = meter.M1Rate
+	}
+	if meter, found := metrics.Meters["link.dropped_msgs:"+link.Id]; found {
+		dropped = meter.M1Rate
+	}
+
+	if txMsgs.M1Rate > 0 {
+		cost += float64(options.RetransmitWeight) * math.Min(retransmits/txMsgs.M1Rate, 1)
+	}
+	if attempted := txMsgs.M1Rate + dropped; attempted > 0 {
+		cost += float64(options.LossWeight) * dropped / attempted
+	}
+
+	return int64(math.Round(cost)), true
+}
+
 func sendRoute(r *Router, createMsg *ctrl_pb.Route, timeout time.Duration) (xt.PeerData, error) {
 	log := pfxlog.Logger().WithField("routerId", r.Id).
 		WithField("circuitId", createMsg.CircuitId)
@@ -1300,6 +1485,10 @@ func (network *Network) Inspect(name string) (*string, error) {
 			resultStr := string(result)
 			return &resultStr, nil
 		}
+	} else if strings.HasPrefix(lc, TerminatorCircuitsInspectPrefix) {
+		terminatorId := name[len(TerminatorCircuitsInspectPrefix):]
+		result := strconv.Itoa(network.circuitController.countForTerminator(terminatorId))
+		return &result, nil
 	} else if lc == "router-messaging" {
 		routerMessagingState, err := network.Managers.RouterMessaging.Inspect()
 		if err != nil {
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"github.com/openziti/ziti/controller/event"
 	"github.com/pkg/errors"
 	"runtime"
@@ -140,7 +141,7 @@ func TestCreateCircuit(t *testing.T) {
 		},
 	*/
 	lc := logcontext.NewContext()
-	_, _, _, cerr := network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr := network.selectPath(context.Background(), r0, svc, "", nil, lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoTerminators, cerr.Cause())
 
@@ -156,15 +157,15 @@ func TestCreateCircuit(t *testing.T) {
 		},
 	}
 
-	_, _, _, cerr = network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr = network.selectPath(context.Background(), r0, svc, "", nil, lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoOnlineTerminators, cerr.Cause())
 
 	network.Routers.markConnected(r0)
-	_, _, _, cerr = network.selectPath(r0, svc, "", lc)
+	_, _, _, cerr = network.selectPath(context.Background(), r0, svc, "", nil, lc)
 	assert.NoError(t, cerr)
 
-	_, _, _, cerr = network.selectPath(r0, svc, "test", lc)
+	_, _, _, cerr = network.selectPath(context.Background(), r0, svc, "test", nil, lc)
 	assert.Error(t, cerr)
 	assert.Equal(t, CircuitFailureNoTerminators, cerr.Cause())
 }
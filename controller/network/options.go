@@ -36,10 +36,18 @@ const (
 	DefaultOptionsRouterMessagingMaxWorkers = 100
 	DefaultOptionsRouterMessagingQueueSize  = 100
 	DefaultOptionsRouteTimeout              = 10 * time.Second
+	DefaultOptionsTerminatorDrainTimeout    = time.Hour
 
 	DefaultOptionsSmartRerouteCap          = 4
 	DefaultOptionsSmartRerouteFraction     = 0.02
 	DefaultOptionsSmartRerouteMinCostDelta = 15
+	DefaultOptionsSmartMinDwellTime        = 0
+	DefaultOptionsSmartImprovementCycles   = 1
+
+	DefaultOptionsLinkCostUtilizationThreshold = 0.5
+	DefaultOptionsLinkCostUtilizationWeight    = 100
+	DefaultOptionsLinkCostRetransmitWeight     = 1000
+	DefaultOptionsLinkCostLossWeight           = 1000
 
 	OptionsRouterCommMaxQueueSize = 1_000_000
 	OptionsRouterCommMaxWorkers   = 10_000
@@ -56,14 +64,32 @@ type Options struct {
 	PendingLinkTimeout      time.Duration
 	RouteTimeout            time.Duration
 	RouterConnectChurnLimit time.Duration
-	RouterComm              struct {
+	// TerminatorDrainTimeout is how long a draining terminator is kept, if it still has circuits, when no
+	// drain timeout was given when it was set to draining
+	TerminatorDrainTimeout time.Duration
+	RouterComm             struct {
 		QueueSize  uint32
 		MaxWorkers uint32
 	}
+	// Smart configures smart rerouting. A circuit is only moved to a lower cost path once it has been on its current
+	// path for at least MinDwellTime, and a path at least MinCostDelta cheaper has been found for ImprovementCycles
+	// cycles in a row
 	Smart struct {
-		RerouteFraction float32
-		RerouteCap      uint32
-		MinCostDelta    uint32
+		RerouteFraction   float32
+		RerouteCap        uint32
+		MinCostDelta      uint32
+		MinDwellTime      time.Duration
+		ImprovementCycles uint32
+	}
+	// LinkCost configures how link utilization, retransmissions and loss reported by routers are added to link
+	// costs. Each weight is the cost added when the given measure is at 100%
+	LinkCost struct {
+		Enabled              bool
+		Capacity             int64
+		UtilizationThreshold float64
+		UtilizationWeight    uint32
+		RetransmitWeight     uint32
+		LossWeight           uint32
 	}
 }
 
@@ -85,14 +111,32 @@ func DefaultOptions() *Options {
 		},
 		RouterConnectChurnLimit: DefaultOptionsRouterConnectChurnLimit,
 		RouteTimeout:            DefaultOptionsRouteTimeout,
+		TerminatorDrainTimeout:  DefaultOptionsTerminatorDrainTimeout,
 		Smart: struct {
-			RerouteFraction float32
-			RerouteCap      uint32
-			MinCostDelta    uint32
+			RerouteFraction   float32
+			RerouteCap        uint32
+			MinCostDelta      uint32
+			MinDwellTime      time.Duration
+			ImprovementCycles uint32
+		}{
+			RerouteFraction:   DefaultOptionsSmartRerouteFraction,
+			RerouteCap:        DefaultOptionsSmartRerouteCap,
+			MinCostDelta:      DefaultOptionsSmartRerouteMinCostDelta,
+			MinDwellTime:      DefaultOptionsSmartMinDwellTime,
+			ImprovementCycles: DefaultOptionsSmartImprovementCycles,
+		},
+		LinkCost: struct {
+			Enabled              bool
+			Capacity             int64
+			UtilizationThreshold float64
+			UtilizationWeight    uint32
+			RetransmitWeight     uint32
+			LossWeight           uint32
 		}{
-			RerouteFraction: DefaultOptionsSmartRerouteFraction,
-			RerouteCap:      DefaultOptionsSmartRerouteCap,
-			MinCostDelta:    DefaultOptionsSmartRerouteMinCostDelta,
+			UtilizationThreshold: DefaultOptionsLinkCostUtilizationThreshold,
+			UtilizationWeight:    DefaultOptionsLinkCostUtilizationWeight,
+			RetransmitWeight:     DefaultOptionsLinkCostRetransmitWeight,
+			LossWeight:           DefaultOptionsLinkCostLossWeight,
 		},
 	}
 	return options
@@ -153,11 +197,80 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 					return nil, errors.New("invalid value for 'minCostDelta'")
 				}
 			}
+
+			if value, found := submap["minDwellTime"]; found {
+				if minDwellTimeStr, ok := value.(string); ok {
+					val, err := time.ParseDuration(minDwellTimeStr)
+					if err != nil {
+						return nil, errors.Wrap(err, "invalid value for 'minDwellTime'")
+					}
+					options.Smart.MinDwellTime = val
+				} else {
+					return nil, errors.New("invalid value for 'minDwellTime'")
+				}
+			}
+
+			if value, found := submap["improvementCycles"]; found {
+				if improvementCycles, ok := value.(int); ok && improvementCycles >= 1 {
+					options.Smart.ImprovementCycles = uint32(improvementCycles)
+				} else {
+					return nil, errors.New("invalid value for 'improvementCycles', must be at least 1")
+				}
+			}
 		} else {
 			logrus.Errorf("invalid or empty 'smart' stanza")
 		}
 	}
 
+	if value, found := src["linkCost"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if value, found := submap["enabled"]; found {
+				if enabled, ok := value.(bool); ok {
+					options.LinkCost.Enabled = enabled
+				} else {
+					return nil, errors.New("invalid value for 'linkCost.enabled'")
+				}
+			}
+
+			if value, found := submap["capacity"]; found {
+				if capacity, ok := value.(int); ok && capacity >= 0 {
+					options.LinkCost.Capacity = int64(capacity)
+				} else {
+					return nil, errors.New("invalid value for 'linkCost.capacity', must be greater than or equal to 0")
+				}
+			}
+
+			if value, found := submap["utilizationThreshold"]; found {
+				threshold, ok := value.(float64)
+				if !ok {
+					if intVal, isInt := value.(int); isInt {
+						threshold, ok = float64(intVal), true
+					}
+				}
+				if !ok || threshold < 0 || threshold >= 1 {
+					return nil, errors.New("invalid value for 'linkCost.utilizationThreshold', must be at least 0 and less than 1")
+				}
+				options.LinkCost.UtilizationThreshold = threshold
+			}
+
+			for name, field := range map[string]*uint32{
+				"utilizationWeight": &options.LinkCost.UtilizationWeight,
+				"retransmitWeight":  &options.LinkCost.RetransmitWeight,
+				"lossWeight":        &options.LinkCost.LossWeight,
+			} {
+				if value, found := submap[name]; found {
+					if weight, ok := value.(int); ok && weight >= 0 && weight <= math.MaxUint16 {
+						*field = uint32(weight)
+					} else {
+						return nil, errors.Errorf("invalid value for 'linkCost.%v', must be between 0 and %v", name, math.MaxUint16)
+					}
+				}
+			}
+		} else {
+			return nil, errors.New("invalid 'linkCost' stanza")
+		}
+	}
+
 	if value, found := src["routerMessaging"]; found {
 		if submap, ok := value.(map[interface{}]interface{}); ok {
 			if value, found := submap["queueSize"]; found {
@@ -260,6 +373,18 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 		}
 	}
 
+	if value, found := src["terminatorDrainTimeout"]; found {
+		if sval, ok := value.(string); ok {
+			val, err := time.ParseDuration(sval)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid value for 'terminatorDrainTimeout'")
+			}
+			options.TerminatorDrainTimeout = val
+		} else {
+			return nil, errors.New("invalid value for 'terminatorDrainTimeout'")
+		}
+	}
+
 	if value, found := src["enableLegacyLinkMgmt"]; found {
 		if bval, ok := value.(bool); ok {
 			options.EnableLegacyLinkMgmt = bval
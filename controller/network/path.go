@@ -18,17 +18,50 @@ package network
 
 import (
 	"fmt"
-	"math"
 	"time"
 
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/pkg/errors"
 )
 
+// RedundantPathsTag is the service tag which, when true, has circuits for the service built over two link disjoint
+// paths. The initiating router sends payloads down both paths and the terminating xgress drops whichever copy
+// arrives second
+const RedundantPathsTag = "redundantPaths"
+
+func isRedundantPathsEnabled(svc *Service) bool {
+	switch val := svc.Tags[RedundantPathsTag].(type) {
+	case bool:
+		return val
+	case string:
+		return val == "true"
+	}
+	return false
+}
+
+func validateRedundantPathsTag(tags map[string]interface{}) error {
+	val, found := tags[RedundantPathsTag]
+	if !found {
+		return nil
+	}
+	switch v := val.(type) {
+	case bool:
+		return nil
+	case string:
+		if v == "true" || v == "false" {
+			return nil
+		}
+	}
+	return errorz.NewFieldError("redundantPaths must be true or false", "tags."+RedundantPathsTag, val)
+}
+
 type Path struct {
 	Nodes                []*Router
 	Links                []*Link
+	Redundant            *Path
+	policy               *pathPolicy
 	IngressId            string
 	EgressId             string
 	InitiatorLocalAddr   string
@@ -60,6 +93,9 @@ func (self *Path) String() string {
 		out += fmt.Sprintf("->[l/%s]", self.Links[i].Id)
 		out += fmt.Sprintf("->[r/%s]", self.Nodes[i+1].Id)
 	}
+	if self.Redundant != nil {
+		out += fmt.Sprintf(" (redundant: %s)", self.Redundant.String())
+	}
 	return out
 }
 
@@ -80,7 +116,26 @@ func (self *Path) EqualPath(other *Path) bool {
 			return false
 		}
 	}
-	return true
+	if self.Redundant == nil || other.Redundant == nil {
+		return self.Redundant == other.Redundant
+	}
+	return self.Redundant.EqualPath(other.Redundant)
+}
+
+// allNodes returns every router the path uses, once each, with the egress router last. Route messages from
+// CreateRouteMessages are in the same order
+func (self *Path) allNodes() []*Router {
+	if self.Redundant == nil || len(self.Nodes) < 2 {
+		return self.Nodes
+	}
+
+	result := append([]*Router{}, self.Nodes[:len(self.Nodes)-1]...)
+	for _, r := range self.Redundant.Nodes {
+		if !containsRouter(self.Nodes, r) {
+			result = append(result, r)
+		}
+	}
+	return append(result, self.EgressRouter())
 }
 
 func (self *Path) EgressRouter() *Router {
@@ -91,6 +146,34 @@ func (self *Path) EgressRouter() *Router {
 }
 
 func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time) []*ctrl_pb.Route {
+	routeMessages := self.createRouteMessages(attempt, circuitId, terminator, deadline)
+	if self.Redundant == nil || len(self.Nodes) < 2 {
+		return routeMessages
+	}
+
+	// merge the messages for both paths, so each router gets a single message. The ingress and egress routers end up
+	// with two forwards for their xgress address, which they treat as a duplicate destination
+	byRouter := map[*Router]*ctrl_pb.Route{}
+	for i, r := range self.Nodes {
+		byRouter[r] = routeMessages[i]
+	}
+	for i, msg := range self.Redundant.createRouteMessages(attempt, circuitId, terminator, deadline) {
+		r := self.Redundant.Nodes[i]
+		if existing, found := byRouter[r]; found {
+			existing.Forwards = append(existing.Forwards, msg.Forwards...)
+		} else {
+			byRouter[r] = msg
+		}
+	}
+
+	var result []*ctrl_pb.Route
+	for _, r := range self.allNodes() {
+		result = append(result, byRouter[r])
+	}
+	return result
+}
+
+func (self *Path) createRouteMessages(attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time) []*ctrl_pb.Route {
 	var routeMessages []*ctrl_pb.Route
 	remainingTime := time.Until(deadline)
 	if len(self.Links) == 0 {
@@ -180,10 +263,14 @@ func (self *Path) usesLink(l *Link) bool {
 			}
 		}
 	}
-	return false
+	return self.Redundant != nil && self.Redundant.usesLink(l)
 }
 
 func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int64, error) {
+	return network.constrainedShortestPath(srcR, dstR, nil)
+}
+
+func (network *Network) constrainedShortestPath(srcR *Router, dstR *Router, constraints *pathConstraints) ([]*Router, int64, error) {
 	if srcR == nil || dstR == nil {
 		return nil, 0, errors.New("not routable (!srcR||!dstR)")
 	}
@@ -192,84 +279,21 @@ func (network *Network) shortestPath(srcR *Router, dstR *Router) ([]*Router, int
 		return []*Router{srcR}, 0, nil
 	}
 
-	dist := make(map[*Router]int64)
-	prev := make(map[*Router]*Router)
-	unvisited := make(map[*Router]bool)
-
-	for _, r := range network.Routers.allConnected() {
-		dist[r] = math.MaxInt32
-		unvisited[r] = true
-	}
-	dist[srcR] = 0
-
-	minRouterCost := network.options.MinRouterCost
-
-	for len(unvisited) > 0 {
-		u := minCost(unvisited, dist)
-		if u == dstR { // if the dest router is the lowest cost next link, we can stop evaluating
-			break
-		}
-		delete(unvisited, u)
-
-		neighbors := network.linkController.connectedNeighborsOfRouter(u)
-		for _, r := range neighbors {
-			if _, found := unvisited[r]; found {
-				var cost int64 = math.MaxInt32 + 1
-				if l, found := network.linkController.leastExpensiveLink(r, u); found {
-					if !r.NoTraversal || r == srcR || r == dstR {
-						cost = l.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
-					}
-				}
-
-				alt := dist[u] + cost
-				if alt < dist[r] {
-					dist[r] = alt
-					prev[r] = u
-				}
-			}
-		}
-	}
-
-	/*
-	 * dist: (r2->r1->r0)
-	 *		r0 = 2 <- r1
-	 *		r1 = 1 <- r2
-	 *		r2 = 0 <- nil
-	 */
-
-	routerPath := make([]*Router, 0)
-	p := prev[dstR]
-	for p != nil {
-		routerPath = append([]*Router{p}, routerPath...)
-		p = prev[p]
+	if constraints != nil && constraints.maxHops > 0 {
+		return network.hopLimitedShortestPath(srcR, dstR, constraints)
 	}
-	routerPath = append(routerPath, dstR)
 
-	if routerPath[0] != srcR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v", srcR.Id, dstR.Id)
-	}
-	if routerPath[len(routerPath)-1] != dstR {
-		return nil, 0, fmt.Errorf("can't route from %v -> %v. destination unreachable", srcR.Id, dstR.Id)
-	}
-
-	return routerPath, dist[dstR], nil
+	tree := network.shortestPathTree(srcR, dstR, constraints)
+	return tree.pathTo(dstR)
 }
 
-func minCost(q map[*Router]bool, dist map[*Router]int64) *Router {
-	if dist == nil || len(dist) < 1 {
-		return nil
-	}
-
-	min := int64(math.MaxInt64)
-	var selected *Router
-	for r := range q {
-		d := dist[r]
-		if d <= min {
-			selected = r
-			min = d
+func containsRouter(nodes []*Router, r *Router) bool {
+	for _, node := range nodes {
+		if node == r {
+			return true
 		}
 	}
-	return selected
+	return false
 }
 
 func maxUint16(v1, v2 uint16) uint16 {
@@ -0,0 +1,232 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/ziti/controller/db"
+	"go.etcd.io/bbolt"
+)
+
+// pathPolicy holds the path constraints configured on a service. Router selectors are resolved against the connected
+// routers every time a path is calculated, so routers which connect, or are re-tagged, after a circuit is created are
+// still taken into account when the circuit is rerouted. Constraints only select routers. Links have no tags or
+// attributes and get new ids whenever they're re-established, so they can't be selected directly. A link is excluded
+// from a path when the router at either end of it is excluded
+type pathPolicy struct {
+	avoidRouters  []*db.RouterSelector
+	egressRouters []*db.RouterSelector
+	maxHops       int
+}
+
+// newPathPolicy returns the path policy for the service, or nil if the service doesn't constrain its paths
+func newPathPolicy(svc *Service) *pathPolicy {
+	if len(svc.AvoidRouters) == 0 && len(svc.EgressRouters) == 0 && svc.MaxHops <= 0 {
+		return nil
+	}
+
+	return &pathPolicy{
+		avoidRouters:  parseRouterSelectors(svc, svc.AvoidRouters),
+		egressRouters: parseRouterSelectors(svc, svc.EgressRouters),
+		maxHops:       int(svc.MaxHops),
+	}
+}
+
+func parseRouterSelectors(svc *Service, selectors []string) []*db.RouterSelector {
+	var result []*db.RouterSelector
+	for _, val := range selectors {
+		// selectors are validated when the service is saved, so this should never fail
+		if selector, err := db.ParseRouterSelector(val); err != nil {
+			pfxlog.Logger().WithError(err).WithField("serviceId", svc.Id).Error("invalid router selector on service")
+		} else {
+			result = append(result, selector)
+		}
+	}
+	return result
+}
+
+func (self *pathPolicy) usesAttributes() bool {
+	for _, list := range [][]*db.RouterSelector{self.avoidRouters, self.egressRouters} {
+		for _, selector := range list {
+			if selector.Attribute != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getPathConstraints resolves the policy against the currently connected routers
+func (network *Network) getPathConstraints(policy *pathPolicy) *pathConstraints {
+	if policy == nil {
+		return nil
+	}
+
+	result := &pathConstraints{
+		maxHops: policy.maxHops,
+	}
+
+	if len(policy.avoidRouters) == 0 && len(policy.egressRouters) == 0 {
+		return result
+	}
+
+	if len(policy.egressRouters) > 0 {
+		result.egressRouters = map[string]struct{}{}
+	}
+
+	routers := network.Routers.allConnected()
+	var attributes map[string][]string
+	if policy.usesAttributes() {
+		attributes = network.getRouterAttributes(routers)
+	}
+
+	for _, r := range routers {
+		if matchesRouter(policy.avoidRouters, r, attributes[r.Id]) {
+			result.excludeRouter(r)
+		}
+		if matchesRouter(policy.egressRouters, r, attributes[r.Id]) {
+			result.egressRouters[r.Id] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+func matchesRouter(selectors []*db.RouterSelector, r *Router, attributes []string) bool {
+	for _, selector := range selectors {
+		if selector.Matches(r.Id, r.Tags, attributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRouterAttributes loads the role attributes of the given routers. Routers which aren't edge routers don't have
+// role attributes
+func (network *Network) getRouterAttributes(routers []*Router) map[string][]string {
+	result := map[string][]string{}
+	err := network.GetDb().View(func(tx *bbolt.Tx) error {
+		for _, r := range routers {
+			edgeRouter, found, err := network.stores.EdgeRouter.FindById(tx, r.Id)
+			if err != nil {
+				return err
+			}
+			if found {
+				result[r.Id] = edgeRouter.RoleAttributes
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to load router role attributes")
+	}
+	return result
+}
+
+// constrainedPath returns the lowest cost path which satisfies the constraints, or the shortest path if there
+// aren't any. Cached alternate paths are used where they satisfy the constraints, so paths don't have to be searched
+// for each circuit
+func (network *Network) constrainedPath(srcR *Router, dstR *Router, constraints *pathConstraints) ([]*Router, int64, error) {
+	if constraints == nil {
+		return network.cachedShortestPath(srcR, dstR)
+	}
+	if srcR == nil || dstR == nil {
+		return nil, 0, fmt.Errorf("not routable (!srcR||!dstR)")
+	}
+	if !constraints.allowsRouter(srcR) {
+		return nil, 0, fmt.Errorf("path policy doesn't allow paths through r/%v", srcR.Id)
+	}
+	if !constraints.allowsEgress(dstR) {
+		return nil, 0, fmt.Errorf("path policy doesn't allow egress from r/%v", dstR.Id)
+	}
+	if nodes, cost, found := network.cachedConstrainedPath(srcR, dstR, constraints); found {
+		return nodes, cost, nil
+	}
+	return network.constrainedShortestPath(srcR, dstR, constraints)
+}
+
+type hopEntry struct {
+	cost int64
+	hops int
+	prev *Router
+}
+
+// hopLimitedShortestPath finds the lowest cost path which uses at most maxHops links. Dijkstra's algorithm can't
+// enforce a hop limit, since the cheapest way to reach an intermediate router may use too many hops, so this
+// relaxes every link once per hop instead, as Bellman-Ford does. layers[i] holds the cheapest paths of at most
+// i hops.
+func (network *Network) hopLimitedShortestPath(srcR *Router, dstR *Router, constraints *pathConstraints) ([]*Router, int64, error) {
+	minRouterCost := network.options.MinRouterCost
+	layers := []map[*Router]hopEntry{{srcR: {}}}
+
+	for i := 1; i <= constraints.maxHops; i++ {
+		last := layers[i-1]
+		layer := make(map[*Router]hopEntry, len(last))
+		for r, entry := range last {
+			layer[r] = entry
+		}
+
+		for u, entry := range last {
+			// routers which didn't improve last round have already been expanded
+			if entry.hops != i-1 || u == dstR || (u.NoTraversal && u != srcR) {
+				continue
+			}
+
+			for _, link := range u.routerLinks.GetLinks() {
+				if !link.IsUsable() {
+					continue
+				}
+
+				r := link.Src
+				if r == u {
+					r = link.GetDest()
+				}
+
+				if r == nil || !r.Connected.Load() || !constraints.allows(u, r) {
+					continue
+				}
+
+				alt := entry.cost + link.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
+				if current, found := layer[r]; !found || alt < current.cost {
+					layer[r] = hopEntry{cost: alt, hops: i, prev: u}
+				}
+			}
+		}
+
+		layers = append(layers, layer)
+	}
+
+	entry, found := layers[len(layers)-1][dstR]
+	if !found {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v in %v hops or less", srcR.Id, dstR.Id, constraints.maxHops)
+	}
+
+	cost := entry.cost
+	path := make([]*Router, entry.hops+1)
+	current := dstR
+	for i := entry.hops; i >= 0; i-- {
+		path[i] = current
+		if i > 0 {
+			current = entry.prev
+			entry = layers[i-1][current]
+		}
+	}
+
+	return path, cost, nil
+}
@@ -0,0 +1,86 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/controller/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathPolicy(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	r := test.routers
+	n := test.network
+
+	req.Nil(newPathPolicy(&Service{}))
+
+	// avoided routers are left out of paths
+	r[1].Tags = map[string]interface{}{"jurisdiction": "cn"}
+	policy := newPathPolicy(&Service{AvoidRouters: []string{"jurisdiction=cn"}})
+	path, cost, err := n.constrainedPath(r[0], r[3], n.getPathConstraints(policy))
+	req.NoError(err)
+	req.Equal([]string{"r0", "r2", "r3"}, test.ids(path))
+	req.Equal(int64(25), cost)
+
+	_, _, err = n.constrainedPath(r[1], r[3], n.getPathConstraints(policy))
+	req.Error(err)
+
+	// egress is limited to the selected routers
+	policy = newPathPolicy(&Service{EgressRouters: []string{"@r2"}})
+	_, _, err = n.constrainedPath(r[0], r[3], n.getPathConstraints(policy))
+	req.Error(err)
+	path, _, err = n.constrainedPath(r[0], r[2], n.getPathConstraints(policy))
+	req.NoError(err)
+	req.Equal([]string{"r0", "r2"}, test.ids(path))
+}
+
+func TestHopLimitedPath(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	r := test.routers
+	n := test.network
+
+	// make the three hop path through the middle the cheapest
+	test.links["l13"].SetStaticCost(100)
+	test.links["l02"].SetStaticCost(100)
+
+	path, cost, err := n.constrainedPath(r[0], r[3], n.getPathConstraints(newPathPolicy(&Service{})))
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r2", "r3"}, test.ids(path))
+	req.Equal(int64(34), cost)
+
+	path, cost, err = n.constrainedPath(r[0], r[3], n.getPathConstraints(newPathPolicy(&Service{MaxHops: 2})))
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, test.ids(path))
+	req.Equal(int64(121), cost)
+
+	_, _, err = n.constrainedPath(r[0], r[3], n.getPathConstraints(newPathPolicy(&Service{MaxHops: 1})))
+	req.Error(err)
+
+	path, _, err = n.constrainedPath(r[0], r[3], n.getPathConstraints(newPathPolicy(&Service{MaxHops: 3})))
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r2", "r3"}, test.ids(path))
+}
@@ -0,0 +1,539 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"container/heap"
+	"container/list"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPathCacheSize is the number of shortest path trees and alternate path sets which are cached
+const DefaultPathCacheSize = 1024
+
+// DefaultAlternatePathCount is the number of lowest cost paths cached between a pair of routers. Paths for circuits
+// with path policies are picked from these, falling back to a constrained search if none of them are allowed
+const DefaultAlternatePathCount = 4
+
+// RouterPath is a path through the network, as the list of routers it passes through, along with its cost
+type RouterPath struct {
+	Nodes []*Router
+	Cost  int64
+}
+
+func (self *RouterPath) String() string {
+	result := "["
+	for idx, r := range self.Nodes {
+		if idx > 0 {
+			result += "->"
+		}
+		result += "r/" + r.Id
+	}
+	return fmt.Sprintf("%v] (cost: %v)", result, self.Cost)
+}
+
+type hop struct {
+	srcId string
+	dstId string
+}
+
+// pathConstraints limits which routers and router to router hops a path may use. If egressRouters is set, paths
+// must end at one of the given routers. If maxHops is set, paths may not use more than that many links
+type pathConstraints struct {
+	excludedRouters map[string]struct{}
+	excludedHops    map[hop]struct{}
+	egressRouters   map[string]struct{}
+	maxHops         int
+}
+
+func (self *pathConstraints) excludeRouter(r *Router) {
+	if self.excludedRouters == nil {
+		self.excludedRouters = map[string]struct{}{}
+	}
+	self.excludedRouters[r.Id] = struct{}{}
+}
+
+func (self *pathConstraints) excludeHop(src, dst *Router) {
+	if self.excludedHops == nil {
+		self.excludedHops = map[hop]struct{}{}
+	}
+	self.excludedHops[hop{srcId: src.Id, dstId: dst.Id}] = struct{}{}
+}
+
+// excludePath excludes the hops between each pair of routers in the path, in both directions
+func (self *pathConstraints) excludePath(nodes []*Router) {
+	for i := 0; i < len(nodes)-1; i++ {
+		self.excludeHop(nodes[i], nodes[i+1])
+		self.excludeHop(nodes[i+1], nodes[i])
+	}
+}
+
+func (self *pathConstraints) allowsRouter(r *Router) bool {
+	if self == nil {
+		return true
+	}
+	_, found := self.excludedRouters[r.Id]
+	return !found
+}
+
+func (self *pathConstraints) allowsEgress(r *Router) bool {
+	if self == nil || self.egressRouters == nil {
+		return self.allowsRouter(r)
+	}
+	_, found := self.egressRouters[r.Id]
+	return found && self.allowsRouter(r)
+}
+
+// allowsPath returns true if every router and hop in the path is allowed, and the path isn't too long
+func (self *pathConstraints) allowsPath(nodes []*Router) bool {
+	if self == nil {
+		return true
+	}
+	if self.maxHops > 0 && len(nodes)-1 > self.maxHops {
+		return false
+	}
+	if !self.allowsRouter(nodes[0]) || !self.allowsEgress(nodes[len(nodes)-1]) {
+		return false
+	}
+	for i := 0; i < len(nodes)-1; i++ {
+		if !self.allows(nodes[i], nodes[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (self *pathConstraints) allows(src, dst *Router) bool {
+	if self == nil {
+		return true
+	}
+	if _, found := self.excludedRouters[dst.Id]; found {
+		return false
+	}
+	_, found := self.excludedHops[hop{srcId: src.Id, dstId: dst.Id}]
+	return !found
+}
+
+// shortestPathTree holds the lowest cost path from a source router to every reachable router
+type shortestPathTree struct {
+	src  *Router
+	dist map[*Router]int64
+	prev map[*Router]*Router
+}
+
+func (self *shortestPathTree) pathTo(dstR *Router) ([]*Router, int64, error) {
+	if dstR == self.src {
+		return []*Router{dstR}, 0, nil
+	}
+
+	if _, found := self.dist[dstR]; !found {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v", self.src.Id, dstR.Id)
+	}
+
+	routerPath := []*Router{dstR}
+	for p := self.prev[dstR]; p != nil; p = self.prev[p] {
+		routerPath = append(routerPath, p)
+	}
+
+	for i, j := 0, len(routerPath)-1; i < j; i, j = i+1, j-1 {
+		routerPath[i], routerPath[j] = routerPath[j], routerPath[i]
+	}
+
+	if routerPath[0] != self.src {
+		return nil, 0, fmt.Errorf("can't route from %v -> %v", self.src.Id, dstR.Id)
+	}
+
+	return routerPath, self.dist[dstR], nil
+}
+
+type routerQueueEntry struct {
+	router *Router
+	cost   int64
+}
+
+type routerQueue []routerQueueEntry
+
+func (self routerQueue) Len() int {
+	return len(self)
+}
+
+func (self routerQueue) Less(i, j int) bool {
+	return self[i].cost < self[j].cost
+}
+
+func (self routerQueue) Swap(i, j int) {
+	self[i], self[j] = self[j], self[i]
+}
+
+func (self *routerQueue) Push(x any) {
+	*self = append(*self, x.(routerQueueEntry))
+}
+
+func (self *routerQueue) Pop() any {
+	old := *self
+	n := len(old)
+	result := old[n-1]
+	*self = old[:n-1]
+	return result
+}
+
+// shortestPathTree runs Dijkstra's algorithm from the source router. If a destination router is given, it stops
+// once the destination is reached, otherwise it finds paths to every reachable router. Routers flagged with
+// no traversal can be the start or end of a path, but paths won't pass through them.
+func (network *Network) shortestPathTree(srcR *Router, dstR *Router, constraints *pathConstraints) *shortestPathTree {
+	result := &shortestPathTree{
+		src:  srcR,
+		dist: map[*Router]int64{srcR: 0},
+		prev: map[*Router]*Router{},
+	}
+
+	minRouterCost := network.options.MinRouterCost
+	visited := map[*Router]struct{}{}
+	queue := &routerQueue{{router: srcR}}
+
+	for queue.Len() > 0 {
+		entry := heap.Pop(queue).(routerQueueEntry)
+		u := entry.router
+		if _, found := visited[u]; found {
+			continue // stale entry, the router was already reached at a lower cost
+		}
+		visited[u] = struct{}{}
+
+		if u == dstR {
+			break
+		}
+
+		if u.NoTraversal && u != srcR {
+			continue
+		}
+
+		for _, link := range u.routerLinks.GetLinks() {
+			if !link.IsUsable() {
+				continue
+			}
+
+			r := link.Src
+			if r == u {
+				r = link.GetDest()
+			}
+
+			if r == nil || !r.Connected.Load() || !constraints.allows(u, r) {
+				continue
+			}
+
+			if _, found := visited[r]; found {
+				continue
+			}
+
+			alt := result.dist[u] + link.GetCost() + int64(maxUint16(r.Cost, minRouterCost))
+			if current, found := result.dist[r]; !found || alt < current {
+				result.dist[r] = alt
+				result.prev[r] = u
+				heap.Push(queue, routerQueueEntry{router: r, cost: alt})
+			}
+		}
+	}
+
+	return result
+}
+
+// pathCacheKey identifies a cache entry. Shortest path trees are cached by source router, with an empty dstId, and
+// alternate paths by source and destination router
+type pathCacheKey struct {
+	srcId string
+	dstId string
+}
+
+type pathCacheEntry struct {
+	key             pathCacheKey
+	tree            *shortestPathTree
+	alternates      []*RouterPath
+	topologyVersion uint64
+	costVersion     uint64
+}
+
+// pathCache holds shortest path trees and alternate paths, evicting the least recently used entries when full.
+// Entries are discarded when routers or links are added or removed. Link costs change with every latency report, so
+// cost changes only discard entries when the cache is refreshed, once per smart reroute cycle. In between, cached paths
+// are repaired when they're read, by checking that their links are still usable and recalculating their costs
+type pathCache struct {
+	lock    sync.Mutex
+	entries map[pathCacheKey]*list.Element
+	lru     *list.List
+	size    int
+	// refreshVersion is the link cost version as of the last refresh. Entries computed before it are discarded
+	refreshVersion uint64
+}
+
+func newPathCache(size int) *pathCache {
+	return &pathCache{
+		entries: map[pathCacheKey]*list.Element{},
+		lru:     list.New(),
+		size:    size,
+	}
+}
+
+func (self *pathCache) get(key pathCacheKey, topologyVersion uint64) *pathCacheEntry {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	elem, found := self.entries[key]
+	if !found {
+		return nil
+	}
+
+	entry := elem.Value.(*pathCacheEntry)
+	if entry.topologyVersion != topologyVersion || entry.costVersion < self.refreshVersion {
+		self.lru.Remove(elem)
+		delete(self.entries, key)
+		return nil
+	}
+
+	self.lru.MoveToFront(elem)
+	return entry
+}
+
+func (self *pathCache) put(entry *pathCacheEntry) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if elem, found := self.entries[entry.key]; found {
+		elem.Value = entry
+		self.lru.MoveToFront(elem)
+		return
+	}
+
+	self.entries[entry.key] = self.lru.PushFront(entry)
+	for self.lru.Len() > self.size {
+		oldest := self.lru.Back()
+		self.lru.Remove(oldest)
+		delete(self.entries, oldest.Value.(*pathCacheEntry).key)
+	}
+}
+
+// refresh discards entries computed before the given link cost version, so they're recomputed with current costs
+func (self *pathCache) refresh(costVersion uint64) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.refreshVersion = costVersion
+}
+
+// cachedShortestPath works like shortestPath, but reuses the shortest path tree for the source router. If the cached
+// path is no longer usable, the tree is recomputed
+func (network *Network) cachedShortestPath(srcR *Router, dstR *Router) ([]*Router, int64, error) {
+	if srcR == nil || dstR == nil {
+		return nil, 0, errors.New("not routable (!srcR||!dstR)")
+	}
+
+	key := pathCacheKey{srcId: srcR.Id}
+	topologyVersion := network.linkController.topologyVersion.Load()
+	if entry := network.pathCache.get(key, topologyVersion); entry != nil && entry.tree.src == srcR {
+		if nodes, _, err := entry.tree.pathTo(dstR); err == nil {
+			if cost, err := network.routerPathCost(nodes); err == nil {
+				return nodes, cost, nil
+			}
+		}
+	}
+
+	costVersion := network.linkController.costVersion.Load()
+	tree := network.shortestPathTree(srcR, nil, nil)
+	network.pathCache.put(&pathCacheEntry{
+		key:             key,
+		tree:            tree,
+		topologyVersion: topologyVersion,
+		costVersion:     costVersion,
+	})
+
+	return tree.pathTo(dstR)
+}
+
+// cachedConstrainedPath returns the lowest cost of the cached alternate paths between the routers which satisfies
+// the constraints. If none of them do, found is false
+func (network *Network) cachedConstrainedPath(srcR *Router, dstR *Router, constraints *pathConstraints) ([]*Router, int64, bool) {
+	key := pathCacheKey{srcId: srcR.Id, dstId: dstR.Id}
+	topologyVersion := network.linkController.topologyVersion.Load()
+
+	var alternates []*RouterPath
+	if entry := network.pathCache.get(key, topologyVersion); entry != nil {
+		alternates = entry.alternates
+	} else {
+		costVersion := network.linkController.costVersion.Load()
+		paths, err := network.KShortestPaths(srcR, dstR, DefaultAlternatePathCount)
+		if err != nil {
+			return nil, 0, false
+		}
+		alternates = paths
+		network.pathCache.put(&pathCacheEntry{
+			key:             key,
+			alternates:      alternates,
+			topologyVersion: topologyVersion,
+			costVersion:     costVersion,
+		})
+	}
+
+	var result *RouterPath
+	for _, alternate := range alternates {
+		if !constraints.allowsPath(alternate.Nodes) {
+			continue
+		}
+		cost, err := network.routerPathCost(alternate.Nodes)
+		if err != nil {
+			continue
+		}
+		if result == nil || cost < result.Cost {
+			result = &RouterPath{Nodes: alternate.Nodes, Cost: cost}
+		}
+	}
+
+	if result == nil {
+		return nil, 0, false
+	}
+	return result.Nodes, result.Cost, true
+}
+
+// KShortestPaths returns up to k loopless paths from the source router to the destination router, in increasing
+// order of cost, using Yen's algorithm
+func (network *Network) KShortestPaths(srcR *Router, dstR *Router, k int) ([]*RouterPath, error) {
+	nodes, cost, err := network.cachedShortestPath(srcR, dstR)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*RouterPath{{Nodes: nodes, Cost: cost}}
+	var candidates []*RouterPath
+
+	for len(result) < k {
+		last := result[len(result)-1]
+
+		for i := 0; i < len(last.Nodes)-1; i++ {
+			spur := last.Nodes[i]
+			root := last.Nodes[:i+1]
+
+			constraints := &pathConstraints{}
+			for _, p := range result {
+				if len(p.Nodes) > i+1 && routersEqual(p.Nodes[:i+1], root) {
+					constraints.excludeHop(p.Nodes[i], p.Nodes[i+1])
+				}
+			}
+			for _, r := range root[:i] {
+				constraints.excludeRouter(r)
+			}
+
+			spurNodes, _, err := network.constrainedShortestPath(spur, dstR, constraints)
+			if err != nil {
+				continue
+			}
+
+			candidateNodes := append(append([]*Router{}, root[:i]...), spurNodes...)
+			if containsPath(result, candidateNodes) || containsPath(candidates, candidateNodes) {
+				continue
+			}
+
+			candidateCost, err := network.routerPathCost(candidateNodes)
+			if err != nil {
+				continue
+			}
+
+			candidates = append(candidates, &RouterPath{Nodes: candidateNodes, Cost: candidateCost})
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].Cost < candidates[j].Cost
+		})
+		result = append(result, candidates[0])
+		candidates = candidates[1:]
+	}
+
+	return result, nil
+}
+
+// DisjointPaths returns up to k paths from the source router to the destination router which don't share any
+// router to router hops. Paths are found greedily, each one being the lowest cost path which avoids the hops used by
+// the paths before it, so fewer than k paths may be returned even if k disjoint paths exist
+func (network *Network) DisjointPaths(srcR *Router, dstR *Router, k int) ([]*RouterPath, error) {
+	nodes, cost, err := network.cachedShortestPath(srcR, dstR)
+	if err != nil {
+		return nil, err
+	}
+
+	return network.disjointPaths(&RouterPath{Nodes: nodes, Cost: cost}, &pathConstraints{}, k), nil
+}
+
+// disjointPaths returns the given path, followed by up to k-1 paths which don't share any router to router hops
+// with it or each other, and which satisfy the constraints. The hops of each path are added to the constraints
+func (network *Network) disjointPaths(first *RouterPath, constraints *pathConstraints, k int) []*RouterPath {
+	result := []*RouterPath{first}
+	if len(first.Nodes) < 2 {
+		return result
+	}
+
+	srcR := first.Nodes[0]
+	dstR := first.Nodes[len(first.Nodes)-1]
+	for len(result) < k {
+		constraints.excludePath(result[len(result)-1].Nodes)
+		nodes, cost, err := network.constrainedShortestPath(srcR, dstR, constraints)
+		if err != nil {
+			break
+		}
+		result = append(result, &RouterPath{Nodes: nodes, Cost: cost})
+	}
+
+	return result
+}
+
+// routerPathCost returns the cost of the given path, using the cheapest usable link for each hop
+func (network *Network) routerPathCost(nodes []*Router) (int64, error) {
+	minRouterCost := network.options.MinRouterCost
+	var cost int64
+	for i := 0; i < len(nodes)-1; i++ {
+		link, found := network.linkController.leastExpensiveLink(nodes[i], nodes[i+1])
+		if !found {
+			return 0, errors.Errorf("no link from r/%v to r/%v", nodes[i].Id, nodes[i+1].Id)
+		}
+		cost += link.GetCost() + int64(maxUint16(nodes[i+1].Cost, minRouterCost))
+	}
+	return cost, nil
+}
+
+func routersEqual(a, b []*Router) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsPath(paths []*RouterPath, nodes []*Router) bool {
+	for _, p := range paths {
+		if routersEqual(p.Nodes, nodes) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,290 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/stretchr/testify/require"
+)
+
+type multiPathTest struct {
+	network *Network
+	routers []*Router
+	links   map[string]*Link
+}
+
+// newMultiPathTest creates the following network, with link costs in brackets and a router cost of 10
+//
+//	   r1
+//	(1)/ | \(2)
+//	 r0 (1) r3
+//	(3)\ | /(2)
+//	   r2
+func newMultiPathTest(t *testing.T, ctx *db.TestContext) *multiPathTest {
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	t.Cleanup(func() { close(config.closeNotify) })
+	config.options.MinRouterCost = 10
+
+	network, err := NewNetwork(config)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	result := &multiPathTest{
+		network: network,
+		links:   map[string]*Link{},
+	}
+
+	for _, id := range []string{"r0", "r1", "r2", "r3"} {
+		r := newRouterForTest(id, "", transportAddr, nil, 0, false)
+		network.Routers.markConnected(r)
+		result.routers = append(result.routers, r)
+	}
+
+	addLink := func(id string, src, dst int, cost int32) {
+		l := newPathTestLink(network, id, result.routers[src], result.routers[dst])
+		l.SetStaticCost(cost)
+		result.links[id] = l
+	}
+
+	addLink("l01", 0, 1, 1)
+	addLink("l13", 1, 3, 2)
+	addLink("l02", 0, 2, 3)
+	addLink("l23", 2, 3, 2)
+	addLink("l12", 1, 2, 1)
+
+	return result
+}
+
+func (self *multiPathTest) ids(path []*Router) []string {
+	var result []string
+	for _, r := range path {
+		result = append(result, r.Id)
+	}
+	return result
+}
+
+func TestKShortestPaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	r := test.routers
+
+	paths, err := test.network.KShortestPaths(r[0], r[3], 10)
+	req.NoError(err)
+	req.Len(paths, 4)
+
+	req.Equal([]string{"r0", "r1", "r3"}, test.ids(paths[0].Nodes))
+	req.Equal(int64(23), paths[0].Cost)
+	req.Equal([]string{"r0", "r2", "r3"}, test.ids(paths[1].Nodes))
+	req.Equal(int64(25), paths[1].Cost)
+	req.Equal([]string{"r0", "r1", "r2", "r3"}, test.ids(paths[2].Nodes))
+	req.Equal(int64(34), paths[2].Cost)
+	req.Equal([]string{"r0", "r2", "r1", "r3"}, test.ids(paths[3].Nodes))
+	req.Equal(int64(36), paths[3].Cost)
+
+	paths, err = test.network.KShortestPaths(r[0], r[3], 2)
+	req.NoError(err)
+	req.Len(paths, 2)
+}
+
+func TestDisjointPaths(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	r := test.routers
+
+	paths, err := test.network.DisjointPaths(r[0], r[3], 3)
+	req.NoError(err)
+	req.Len(paths, 2)
+	req.Equal([]string{"r0", "r1", "r3"}, test.ids(paths[0].Nodes))
+	req.Equal([]string{"r0", "r2", "r3"}, test.ids(paths[1].Nodes))
+
+	test.links["l02"].SetDown(true)
+	paths, err = test.network.DisjointPaths(r[0], r[3], 3)
+	req.NoError(err)
+	req.Len(paths, 1)
+}
+
+func TestCachedShortestPath(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	r := test.routers
+
+	path, cost, err := test.network.cachedShortestPath(r[0], r[3])
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, test.ids(path))
+	req.Equal(int64(23), cost)
+
+	// the rest of the tree is served from the cache
+	key := pathCacheKey{srcId: r[0].Id}
+	req.NotNil(test.network.pathCache.get(key, test.network.linkController.topologyVersion.Load()))
+	path, _, err = test.network.cachedShortestPath(r[0], r[2])
+	req.NoError(err)
+	req.Equal([]string{"r0", "r2"}, test.ids(path))
+
+	// link cost changes don't invalidate the cache, but costs are kept current
+	test.links["l13"].SetStaticCost(20)
+	req.NotNil(test.network.pathCache.get(key, test.network.linkController.topologyVersion.Load()))
+	path, cost, err = test.network.cachedShortestPath(r[0], r[3])
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, test.ids(path))
+	req.Equal(int64(41), cost)
+
+	// lower cost paths are picked up once the cache is refreshed
+	test.network.pathCache.refresh(test.network.linkController.costVersion.Load())
+	req.Nil(test.network.pathCache.get(key, test.network.linkController.topologyVersion.Load()))
+	path, cost, err = test.network.cachedShortestPath(r[0], r[3])
+	req.NoError(err)
+	req.Equal([]string{"r0", "r2", "r3"}, test.ids(path))
+	req.Equal(int64(25), cost)
+
+	// cached paths which use failed links are recomputed
+	test.links["l23"].SetDown(true)
+	path, cost, err = test.network.cachedShortestPath(r[0], r[3])
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, test.ids(path))
+	req.Equal(int64(41), cost)
+
+	// link removals invalidate the cache
+	test.network.linkController.remove(test.links["l23"])
+	req.Nil(test.network.pathCache.get(key, test.network.linkController.topologyVersion.Load()))
+	path, _, err = test.network.cachedShortestPath(r[0], r[3])
+	req.NoError(err)
+	req.Equal([]string{"r0", "r1", "r3"}, test.ids(path))
+
+	// as do routers disconnecting
+	test.network.Routers.markDisconnected(r[1])
+	_, _, err = test.network.cachedShortestPath(r[0], r[3])
+	req.Error(err)
+}
+
+func TestPathCacheEviction(t *testing.T) {
+	req := require.New(t)
+
+	cache := newPathCache(2)
+	put := func(srcId string) {
+		cache.put(&pathCacheEntry{key: pathCacheKey{srcId: srcId}, tree: &shortestPathTree{}})
+	}
+
+	put("r0")
+	put("r1")
+	req.NotNil(cache.get(pathCacheKey{srcId: "r0"}, 0))
+
+	// r1 is the least recently used, so it's evicted
+	put("r2")
+	req.NotNil(cache.get(pathCacheKey{srcId: "r0"}, 0))
+	req.Nil(cache.get(pathCacheKey{srcId: "r1"}, 0))
+	req.NotNil(cache.get(pathCacheKey{srcId: "r2"}, 0))
+	req.Equal(2, cache.lru.Len())
+}
+
+func TestCachedConstrainedPath(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	r := test.routers
+
+	constraints := &pathConstraints{}
+	constraints.excludeRouter(r[1])
+	path, cost, found := test.network.cachedConstrainedPath(r[0], r[3], constraints)
+	req.True(found)
+	req.Equal([]string{"r0", "r2", "r3"}, test.ids(path))
+	req.Equal(int64(25), cost)
+
+	key := pathCacheKey{srcId: r[0].Id, dstId: r[3].Id}
+	entry := test.network.pathCache.get(key, test.network.linkController.topologyVersion.Load())
+	req.NotNil(entry)
+	req.Len(entry.alternates, DefaultAlternatePathCount)
+
+	// alternates with failed links are skipped
+	test.links["l02"].SetDown(true)
+	_, _, found = test.network.cachedConstrainedPath(r[0], r[3], constraints)
+	req.False(found)
+
+	// if none of the alternates are allowed, the constrained search is used
+	path, _, err := test.network.constrainedPath(r[0], r[3], &pathConstraints{maxHops: 1})
+	req.Error(err)
+	req.Nil(path)
+}
+
+func TestRedundantPath(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	r := test.routers
+
+	path, circuitErr := test.network.CreatePathWithNodes([]*Router{r[0], r[1], r[3]})
+	req.NoError(circuitErr)
+	test.network.setRedundantPath(path)
+	req.NotNil(path.Redundant)
+	req.Equal([]string{"r0", "r2", "r3"}, test.ids(path.Redundant.Nodes))
+	req.Equal([]string{"r0", "r1", "r2", "r3"}, test.ids(path.allNodes()))
+	req.True(path.usesLink(test.links["l23"]))
+	req.False(path.usesLink(test.links["l12"]))
+
+	terminator := &Terminator{Address: "tcp:localhost:1001", Binding: "transport"}
+	rms := path.CreateRouteMessages(0, "c0", terminator, time.Now().Add(DefaultOptionsRouteTimeout))
+	req.Len(rms, 4)
+
+	destinations := func(msg *ctrl_pb.Route, src string) []string {
+		var result []string
+		for _, fwd := range msg.Forwards {
+			if fwd.SrcAddress == src {
+				result = append(result, fwd.DstAddress)
+			}
+		}
+		return result
+	}
+
+	req.Equal([]string{"l01", "l02"}, destinations(rms[0], path.IngressId))
+	req.Equal([]string{path.IngressId}, destinations(rms[0], "l02"))
+	req.Equal([]string{"l13"}, destinations(rms[1], "l01"))
+	req.Equal([]string{"l23"}, destinations(rms[2], "l02"))
+	req.Equal([]string{"l13", "l23"}, destinations(rms[3], path.EgressId))
+	req.NotNil(rms[3].Egress)
+
+	// rerouting keeps the redundant path, and drops it if there's no longer a disjoint alternative
+	updated, err := test.network.UpdatePath(path)
+	req.NoError(err)
+	req.True(updated.EqualPath(path))
+
+	test.links["l02"].SetDown(true)
+	updated, err = test.network.UpdatePath(path)
+	req.NoError(err)
+	req.Nil(updated.Redundant)
+	req.False(updated.EqualPath(path))
+}
@@ -166,6 +166,7 @@ func (self *RouterManager) markConnected(r *Router) {
 
 	r.Connected.Store(true)
 	self.connected.Set(r.Id, r)
+	self.network.linkController.topologyChanged()
 }
 
 func (self *RouterManager) markDisconnected(r *Router) {
@@ -178,6 +179,7 @@ func (self *RouterManager) markDisconnected(r *Router) {
 		return exists
 	})
 	r.routerLinks.Clear()
+	self.network.linkController.topologyChanged()
 }
 
 func (self *RouterManager) IsConnected(id string) bool {
@@ -320,7 +322,8 @@ func (self *RouterManager) DequiesceRouter(entity *Router, ctx *change.Context)
 
 func (self *RouterManager) ApplyQuiesce(cmd *command.UpdateEntityCommand[*Router], ctx boltz.MutateContext) error {
 	return self.UpdateTerminators(cmd.Entity, ctx, func(terminator *db.Terminator) error {
-		if terminator.Precedence == xt.Precedences.Failed.String() {
+		// draining terminators already get no new circuits, and are left draining so they're still removed
+		if terminator.Precedence == xt.Precedences.Failed.String() || terminator.Precedence == xt.Precedences.Draining.String() {
 			return nil
 		}
 
@@ -419,6 +422,7 @@ func (self *RouterManager) UpdateCachedRouter(id string) {
 
 		self.cache.RemoveCb(id, updateCb)
 		self.connected.RemoveCb(id, updateCb)
+		self.network.linkController.topologyChanged()
 	}
 }
 
@@ -64,6 +64,7 @@ type routeSender struct {
 	attendance      map[string]bool
 	serviceCounters ServiceCounters
 	terminators     *TerminatorManager
+	routeStart      time.Time
 }
 
 func newRouteSender(circuitId string, timeout time.Duration, serviceCounters ServiceCounters, terminators *TerminatorManager) *routeSender {
@@ -80,9 +81,12 @@ func newRouteSender(circuitId string, timeout time.Duration, serviceCounters Ser
 func (self *routeSender) route(attempt uint32, path *Path, routeMsgs []*ctrl_pb.Route, strategy xt.Strategy, terminator xt.Terminator, ctx logcontext.Context) (peerData xt.PeerData, cleanups map[string]struct{}, err CircuitError) {
 	logger := pfxlog.ChannelLogger(logcontext.EstablishPath).Wire(ctx)
 
+	self.routeStart = time.Now()
+
 	// send route messages
-	for i := 0; i < len(path.Nodes); i++ {
-		r := path.Nodes[i]
+	nodes := path.allNodes()
+	for i := 0; i < len(nodes); i++ {
+		r := nodes[i]
 		msg := routeMsgs[i]
 		logger.Debugf("sending route message to [r/%s] for attempt [#%d]", r.Id, msg.Attempt)
 		go self.sendRoute(r, msg, ctx)
@@ -139,7 +143,7 @@ func (self *routeSender) handleRouteSend(attempt uint32, path *Path, strategy xt
 			self.attendance[status.Router.Id] = true
 			if status.Router.Id == terminator.GetRouterId() {
 				peerData = status.PeerData
-				strategy.NotifyEvent(xt.NewDialSucceeded(terminator))
+				strategy.NotifyEvent(xt.NewDialSucceededWithDuration(terminator, time.Since(self.routeStart)))
 				self.serviceCounters.ServiceDialSuccess(terminator.GetServiceId(), terminator.GetId())
 			}
 		} else {
@@ -221,7 +225,7 @@ func (self *routeSender) sendRoute(r *Router, routeMsg *ctrl_pb.Route, ctx logco
 
 func (self *routeSender) cleanups(path *Path) map[string]struct{} {
 	cleanups := make(map[string]struct{})
-	for _, r := range path.Nodes {
+	for _, r := range path.allNodes() {
 		success, found := self.attendance[r.Id]
 		if found && success {
 			cleanups[r.Id] = struct{}{}
@@ -0,0 +1,37 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/xgress"
+)
+
+// setSelectiveAckTag tells the xgress instances at each end of the circuit to send selective acks, if both routers
+// support them. Older routers in the middle of the path drop the selective ack information when forwarding acks,
+// which only means the senders fall back to retransmitting on timeouts, so they don't need to support it
+func setSelectiveAckTag(path *Path, tags map[string]string) {
+	if tags == nil {
+		return
+	}
+
+	ingress := path.Nodes[0]
+	egress := path.Nodes[len(path.Nodes)-1]
+	if ingress.HasCapability(ctrl_pb.RouterCapability_SelectiveAck) && egress.HasCapability(ctrl_pb.RouterCapability_SelectiveAck) {
+		tags[xgress.CircuitTagSelectiveAck] = "true"
+	}
+}
@@ -0,0 +1,56 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetSelectiveAckTag(t *testing.T) {
+	req := require.New(t)
+
+	capable := &ctrl_pb.RouterMetadata{
+		Capabilities: []ctrl_pb.RouterCapability{ctrl_pb.RouterCapability_SelectiveAck},
+	}
+
+	ingress := NewRouter("ingress", "ingress", "", 0, false)
+	transit := NewRouter("transit", "transit", "", 0, false)
+	egress := NewRouter("egress", "egress", "", 0, false)
+	ingress.SetMetadata(capable)
+	egress.SetMetadata(capable)
+
+	// transit routers don't need to support selective acks
+	path := &Path{Nodes: []*Router{ingress, transit, egress}}
+	tags := map[string]string{}
+	setSelectiveAckTag(path, tags)
+	req.Equal("true", tags[xgress.CircuitTagSelectiveAck])
+
+	// circuits which start and end on the same router
+	tags = map[string]string{}
+	setSelectiveAckTag(&Path{Nodes: []*Router{ingress}}, tags)
+	req.Equal("true", tags[xgress.CircuitTagSelectiveAck])
+
+	// both ends of the circuit have to support selective acks
+	egress.SetMetadata(nil)
+	tags = map[string]string{}
+	setSelectiveAckTag(path, tags)
+	req.NotContains(tags, xgress.CircuitTagSelectiveAck)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/fields"
 	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/xt_locality"
 	"github.com/orcaman/concurrent-map/v2"
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
@@ -39,6 +40,9 @@ type Service struct {
 	TerminatorStrategy string
 	Terminators        []*Terminator
 	MaxIdleTime        time.Duration
+	AvoidRouters       []string
+	EgressRouters      []string
+	MaxHops            int32
 }
 
 func (self *Service) GetName() string {
@@ -51,6 +55,9 @@ func (entity *Service) toBolt() *db.Service {
 		Name:               entity.Name,
 		MaxIdleTime:        entity.MaxIdleTime,
 		TerminatorStrategy: entity.TerminatorStrategy,
+		AvoidRouters:       entity.AvoidRouters,
+		EgressRouters:      entity.EgressRouters,
+		MaxHops:            entity.MaxHops,
 	}
 }
 
@@ -97,7 +104,28 @@ func (self *ServiceManager) NotifyTerminatorChanged(terminator *db.Terminator) *
 	return terminator
 }
 
+// ValidateServiceTags checks the values of the service tags which change how circuits for the service are built
+// and routed. Services created or updated through either the fabric or edge APIs are checked
+func ValidateServiceTags(tags map[string]interface{}) error {
+	validators := []func(map[string]interface{}) error{
+		validateRedundantPathsTag,
+		validateReroutePolicyTag,
+		validateTrafficClassTag,
+		validatePayloadCompressionTag,
+		xt_locality.ValidateTags,
+	}
+	for _, validate := range validators {
+		if err := validate(tags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (self *ServiceManager) Create(entity *Service, ctx *change.Context) error {
+	if err := ValidateServiceTags(entity.Tags); err != nil {
+		return err
+	}
 	return DispatchCreate[*Service](self, entity, ctx)
 }
 
@@ -120,6 +148,9 @@ func (self *ServiceManager) ApplyCreate(cmd *command.CreateEntityCommand[*Servic
 }
 
 func (self *ServiceManager) Update(entity *Service, updatedFields fields.UpdatedFields, ctx *change.Context) error {
+	if err := ValidateServiceTags(entity.Tags); err != nil {
+		return err
+	}
 	return DispatchUpdate[*Service](self, entity, updatedFields, ctx)
 }
 
@@ -173,6 +204,9 @@ func (self *ServiceManager) populateService(entity *Service, tx *bbolt.Tx, boltE
 	entity.Name = boltService.Name
 	entity.MaxIdleTime = boltService.MaxIdleTime
 	entity.TerminatorStrategy = boltService.TerminatorStrategy
+	entity.AvoidRouters = boltService.AvoidRouters
+	entity.EgressRouters = boltService.EgressRouters
+	entity.MaxHops = boltService.MaxHops
 	entity.FillCommon(boltService)
 
 	terminatorIds := self.store.GetRelatedEntitiesIdList(tx, entity.Id, db.EntityTypeTerminators)
@@ -214,6 +248,9 @@ func (self *ServiceManager) Marshall(entity *Service) ([]byte, error) {
 		MaxIdleTime:        int64(entity.MaxIdleTime),
 		TerminatorStrategy: entity.TerminatorStrategy,
 		Tags:               tags,
+		AvoidRouters:       entity.AvoidRouters,
+		EgressRouters:      entity.EgressRouters,
+		MaxHops:            entity.MaxHops,
 	}
 
 	return proto.Marshal(msg)
@@ -233,5 +270,8 @@ func (self *ServiceManager) Unmarshall(bytes []byte) (*Service, error) {
 		Name:               msg.Name,
 		MaxIdleTime:        time.Duration(msg.MaxIdleTime),
 		TerminatorStrategy: msg.TerminatorStrategy,
+		AvoidRouters:       msg.AvoidRouters,
+		EgressRouters:      msg.EgressRouters,
+		MaxHops:            msg.MaxHops,
 	}, nil
 }
@@ -0,0 +1,97 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/xt_locality"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateServiceTags(t *testing.T) {
+	req := require.New(t)
+
+	valid := []map[string]interface{}{
+		nil,
+		{"other": 5},
+		{RedundantPathsTag: true, ReroutePolicyTag: "onFailure", TrafficClassTag: "bulk"},
+		{RedundantPathsTag: "false", ReroutePolicyTag: "never", TrafficClassTag: "realtime"},
+		{PayloadCompressionTag: "zstd"},
+		{xt_locality.PolicyTag: "preferZone", xt_locality.SpilloverLoadTag: 10, xt_locality.SpilloverFailuresTag: "0"},
+	}
+	for _, tags := range valid {
+		req.NoError(ValidateServiceTags(tags), "tags: %v", tags)
+	}
+
+	invalid := map[string]map[string]interface{}{
+		"tags." + RedundantPathsTag: {RedundantPathsTag: "yes"},
+		"tags." + ReroutePolicyTag:  {ReroutePolicyTag: "sometimes"},
+		"tags." + TrafficClassTag:   {TrafficClassTag: "urgent"},
+
+		"tags." + PayloadCompressionTag: {PayloadCompressionTag: "gzip"},
+
+		"tags." + xt_locality.PolicyTag:            {xt_locality.PolicyTag: "nearby"},
+		"tags." + xt_locality.SpilloverLoadTag:     {xt_locality.SpilloverLoadTag: "lots"},
+		"tags." + xt_locality.SpilloverFailuresTag: {xt_locality.SpilloverFailuresTag: -1},
+	}
+	for field, tags := range invalid {
+		err := ValidateServiceTags(tags)
+		fieldErr := &errorz.FieldError{}
+		req.ErrorAs(err, &fieldErr, "tags: %v", tags)
+		req.Equal(field, fieldErr.FieldName)
+	}
+
+	// wrongly typed values are rejected too
+	req.Error(ValidateServiceTags(map[string]interface{}{ReroutePolicyTag: 1}))
+	req.Error(ValidateServiceTags(map[string]interface{}{TrafficClassTag: true}))
+	req.Error(ValidateServiceTags(map[string]interface{}{PayloadCompressionTag: 1}))
+}
+
+func TestServiceTagsValidatedOnWrite(t *testing.T) {
+	req := require.New(t)
+
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	svc := &Service{
+		BaseEntity:         models.BaseEntity{Id: "svc", Tags: map[string]interface{}{TrafficClassTag: "urgent"}},
+		Name:               "svc",
+		TerminatorStrategy: "smartrouting",
+	}
+	req.Error(n.Services.Create(svc, change.New()))
+
+	svc.Tags[TrafficClassTag] = "bulk"
+	req.NoError(n.Services.Create(svc, change.New()))
+
+	svc.Tags[RedundantPathsTag] = "maybe"
+	req.Error(n.Services.Update(svc, nil, change.New()))
+
+	stored, err := n.Services.Read(svc.Id)
+	req.NoError(err)
+	req.NotContains(stored.Tags, RedundantPathsTag)
+}
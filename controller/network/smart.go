@@ -17,13 +17,81 @@
 package network
 
 import (
+	"fmt"
 	log "github.com/sirupsen/logrus"
 	"sort"
 	"time"
 
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
 )
 
+// ReroutePolicyTag is the service tag which controls when circuits for the service may be rerouted. Valid values
+// are the ReroutePolicy constants. Services without the tag use ReroutePolicySmart
+const ReroutePolicyTag = "reroutePolicy"
+
+type ReroutePolicy string
+
+const (
+	// ReroutePolicySmart reroutes circuits when their path fails, and moves them to lower cost paths
+	ReroutePolicySmart ReroutePolicy = "smart"
+	// ReroutePolicyOnFailure only reroutes circuits when their path fails
+	ReroutePolicyOnFailure ReroutePolicy = "onFailure"
+	// ReroutePolicyNever never reroutes circuits. Circuits whose path fails are removed
+	ReroutePolicyNever ReroutePolicy = "never"
+)
+
+// RerouteReason is reported on circuit rerouted events
+type RerouteReason string
+
+const (
+	RerouteReasonLowerCost       RerouteReason = "lowerCost"
+	RerouteReasonLinkFailed      RerouteReason = "linkFailed"
+	RerouteReasonForwardingFault RerouteReason = "forwardingFault"
+)
+
+func getReroutePolicy(svc *Service) ReroutePolicy {
+	val, found := svc.Tags[ReroutePolicyTag]
+	if !found {
+		return ReroutePolicySmart
+	}
+
+	policy := ReroutePolicy(fmt.Sprint(val))
+	switch policy {
+	case ReroutePolicySmart, ReroutePolicyOnFailure, ReroutePolicyNever:
+		return policy
+	}
+
+	pfxlog.Logger().WithField("serviceId", svc.Id).WithField("reroutePolicy", val).
+		Warn("invalid reroute policy on service, using smart")
+	return ReroutePolicySmart
+}
+
+func validateReroutePolicyTag(tags map[string]interface{}) error {
+	val, found := tags[ReroutePolicyTag]
+	if !found {
+		return nil
+	}
+	if policy, ok := val.(string); ok {
+		switch ReroutePolicy(policy) {
+		case ReroutePolicySmart, ReroutePolicyOnFailure, ReroutePolicyNever:
+			return nil
+		}
+	}
+	return errorz.NewFieldError(fmt.Sprintf("reroutePolicy must be one of %v, %v or %v", ReroutePolicySmart,
+		ReroutePolicyOnFailure, ReroutePolicyNever), "tags."+ReroutePolicyTag, val)
+}
+
+// getCircuitReroutePolicy returns the reroute policy of the circuit's service. If the service can't be found, the
+// circuit may be rerouted
+func (network *Network) getCircuitReroutePolicy(circuit *Circuit) ReroutePolicy {
+	svc, err := network.Services.Read(circuit.ServiceId)
+	if err != nil {
+		return ReroutePolicySmart
+	}
+	return getReroutePolicy(svc)
+}
+
 func (network *Network) smart() {
 	log := pfxlog.Logger()
 	log.Trace("smart network processing")
@@ -32,12 +100,16 @@ func (network *Network) smart() {
 
 	for _, update := range candidates {
 		if retry := network.smartReroute(update.circuit, update.path, time.Now().Add(DefaultOptionsRouteTimeout)); retry {
-			go network.rerouteCircuitWithTries(update.circuit, DefaultOptionsCreateCircuitRetries)
+			go network.rerouteCircuitWithTries(update.circuit, DefaultOptionsCreateCircuitRetries, RerouteReasonLowerCost)
 		}
 	}
 }
 
 func (network *Network) getRerouteCandidates() []*newCircuitPath {
+	// paths are compared using current link costs, so cached paths computed with older costs are discarded. As
+	// this runs every cycle, it also keeps the path cache from getting too far out of date
+	network.pathCache.refresh(network.linkController.costVersion.Load())
+
 	/*
 	 * Order circuits in decreasing overall latency order
 	 */
@@ -77,16 +149,49 @@ func (network *Network) getRerouteCandidates() []*newCircuitPath {
 		ceiling = int(network.options.Smart.RerouteCap)
 	}
 	log.Tracef("smart reroute ceiling [%d]", ceiling)
+	policies := map[string]ReroutePolicy{}
 	for _, sId := range orderedCircuits {
 		if circuit, found := network.GetCircuit(sId); found {
+			policy, found := policies[circuit.ServiceId]
+			if !found {
+				policy = network.getCircuitReroutePolicy(circuit)
+				policies[circuit.ServiceId] = policy
+			}
+			if policy != ReroutePolicySmart {
+				circuit.improvedCycles = 0
+				continue
+			}
+
 			if updatedPath, err := network.UpdatePath(circuit.Path); err == nil {
 				pathChanged := !updatedPath.EqualPath(circuit.Path)
 				oldCost := circuit.Path.cost(minRouterCost)
 				newCost := updatedPath.cost(minRouterCost)
 				costDelta := oldCost - newCost
 				log.Tracef("old cost: %v, new cost: %v, delta: %v", oldCost, newCost, costDelta)
-				if count < ceiling && pathChanged && costDelta >= int64(network.options.Smart.MinCostDelta) {
+
+				if !pathChanged || costDelta < int64(network.options.Smart.MinCostDelta) {
+					circuit.improvedCycles = 0
+					continue
+				}
+
+				// a lower cost path has to be found for several cycles in a row, and the circuit has to have been
+				// on its path for a while, so circuits don't flap between paths with similar costs
+				circuit.improvedCycles++
+				if circuit.improvedCycles < network.options.Smart.ImprovementCycles {
+					log.Tracef("not rerouting [s/%s], lower cost path found for %d of %d cycles",
+						circuit.Id, circuit.improvedCycles, network.options.Smart.ImprovementCycles)
+					continue
+				}
+
+				if dwell := time.Since(circuit.UpdatedAt); dwell < network.options.Smart.MinDwellTime {
+					log.Tracef("not rerouting [s/%s], on current path for %v, less than min dwell time of %v",
+						circuit.Id, dwell, network.options.Smart.MinDwellTime)
+					continue
+				}
+
+				if count < ceiling {
 					count++
+					circuit.improvedCycles = 0
 					candidates = append(candidates, &newCircuitPath{
 						circuit: circuit,
 						path:    updatedPath,
@@ -1,12 +1,15 @@
 package network
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/openziti/metrics/metrics_pb"
 	"github.com/openziti/transport/v2/tcp"
 	"github.com/openziti/ziti/common/logcontext"
+	"github.com/openziti/ziti/controller/change"
 	"github.com/openziti/ziti/controller/db"
 	"github.com/openziti/ziti/controller/models"
 	"github.com/openziti/ziti/controller/xt"
@@ -66,7 +69,7 @@ func TestSmartRerouteMinCostDelta(t *testing.T) {
 		},
 	}
 
-	_, terminator, pathNodes, cerr := network.selectPath(r0, svc, "", lc)
+	_, terminator, pathNodes, cerr := network.selectPath(context.Background(), r0, svc, "", nil, lc)
 	assert.NoError(t, cerr)
 
 	path, pathErr := network.CreatePathWithNodes(pathNodes)
@@ -122,3 +125,164 @@ func TestSmartRerouteMinCostDelta(t *testing.T) {
 	assert.Equal(t, "l0", candidate.path.Links[0].Id)
 	assert.Equal(t, "l2", candidate.path.Links[1].Id)
 }
+
+func TestLinkLoadCost(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	test := newMultiPathTest(t, ctx)
+	r := test.routers
+	n := test.network
+
+	// routers must be stored for their metrics to be accepted
+	for _, router := range r {
+		assert.NoError(t, n.Routers.Create(router, change.New()))
+	}
+
+	meters := func(linkId string, bytes, msgs, retransmits, dropped float64) map[string]*metrics_pb.MetricsMessage_Meter {
+		return map[string]*metrics_pb.MetricsMessage_Meter{
+			"link." + linkId + ".tx.bytesrate":    {M1Rate: bytes},
+			"link." + linkId + ".tx.msgrate":      {M1Rate: msgs},
+			"link." + linkId + ".retransmissions": {M1Rate: retransmits},
+			"link.dropped_msgs:" + linkId:         {M1Rate: dropped},
+		}
+	}
+
+	msg := &metrics_pb.MetricsMessage{SourceId: "r0", Meters: meters("l01", 1000, 100, 0, 0)}
+
+	// load isn't factored in unless enabled
+	n.AcceptMetricsMsg(msg)
+	assert.Equal(t, int64(1), test.links["l01"].GetCost())
+
+	n.options.LinkCost.Enabled = true
+	n.options.LinkCost.Capacity = 1000
+
+	path, cost, err := n.cachedShortestPath(r[0], r[3])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"r0", "r1", "r3"}, test.ids(path))
+	assert.Equal(t, int64(23), cost)
+
+	// l01 is saturated, l02 is half utilized, which is at the threshold, and retransmits 1% of its messages
+	for id, meter := range meters("l02", 500, 100, 1, 0) {
+		msg.Meters[id] = meter
+	}
+	n.AcceptMetricsMsg(msg)
+	assert.Equal(t, int64(100), test.links["l01"].GetSrcLoadCost())
+	assert.Equal(t, int64(101), test.links["l01"].GetCost())
+	assert.Equal(t, int64(10), test.links["l02"].GetSrcLoadCost())
+	assert.Equal(t, int64(13), test.links["l02"].GetCost())
+
+	// cached paths pick up cost changes once the cache is refreshed
+	n.pathCache.refresh(n.linkController.costVersion.Load())
+	path, cost, err = n.cachedShortestPath(r[0], r[3])
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"r0", "r2", "r3"}, test.ids(path))
+	assert.Equal(t, int64(35), cost)
+
+	// r3 is the destination of l13, and is dropping 10% of the messages it sends over it
+	n.AcceptMetricsMsg(&metrics_pb.MetricsMessage{SourceId: "r3", Meters: meters("l13", 0, 90, 0, 10)})
+	assert.Equal(t, int64(0), test.links["l13"].GetSrcLoadCost())
+	assert.Equal(t, int64(100), test.links["l13"].GetDstLoadCost())
+	assert.Equal(t, int64(102), test.links["l13"].GetCost())
+}
+
+func TestSmartRerouteHysteresis(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	test := newMultiPathTest(t, ctx)
+	n := test.network
+	r := test.routers
+
+	n.options.Smart.MinCostDelta = 1
+	n.options.Smart.MinDwellTime = time.Minute
+	n.options.Smart.ImprovementCycles = 2
+
+	// r0 - r2 - r3 costs 25, while r0 - r1 - r3 costs 23
+	path, err := n.CreatePathWithNodes([]*Router{r[0], r[2], r[3]})
+	assert.NoError(t, err)
+
+	circuit := &Circuit{
+		Id:        uuid.NewString(),
+		ServiceId: "svc",
+		Path:      path,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	n.circuitController.add(circuit)
+
+	// first cycle with a lower cost path
+	assert.Equal(t, 0, len(n.getRerouteCandidates()))
+
+	// lower cost path found in enough cycles, but circuit hasn't been on its path long enough
+	assert.Equal(t, 0, len(n.getRerouteCandidates()))
+
+	circuit.UpdatedAt = time.Now().Add(-2 * time.Minute)
+	candidates := n.getRerouteCandidates()
+	assert.Equal(t, 1, len(candidates))
+	assert.Equal(t, []string{"r0", "r1", "r3"}, test.ids(candidates[0].path.Nodes))
+
+	// the improvement has to be sustained. If a lower cost path goes away, counting starts over
+	assert.Equal(t, 0, len(n.getRerouteCandidates()))
+	test.links["l13"].SetStaticCost(10)
+	assert.Equal(t, 0, len(n.getRerouteCandidates()))
+	assert.Equal(t, uint32(0), circuit.improvedCycles)
+
+	test.links["l13"].SetStaticCost(2)
+	assert.Equal(t, 0, len(n.getRerouteCandidates()))
+	assert.Equal(t, 1, len(n.getRerouteCandidates()))
+}
+
+func TestReroutePolicy(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	test := newMultiPathTest(t, ctx)
+	n := test.network
+	r := test.routers
+
+	n.options.Smart.MinCostDelta = 1
+	n.options.Smart.MinDwellTime = 0
+	n.options.Smart.ImprovementCycles = 1
+
+	svc := &Service{
+		BaseEntity: models.BaseEntity{
+			Id:   "svc",
+			Tags: map[string]interface{}{ReroutePolicyTag: string(ReroutePolicyOnFailure)},
+		},
+		Name:               "svc",
+		TerminatorStrategy: "smartrouting",
+	}
+	assert.NoError(t, n.Services.Create(svc, change.New()))
+
+	path, err := n.CreatePathWithNodes([]*Router{r[0], r[2], r[3]})
+	assert.NoError(t, err)
+
+	circuit := &Circuit{
+		Id:        uuid.NewString(),
+		ServiceId: svc.Id,
+		Path:      path,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	n.circuitController.add(circuit)
+
+	assert.Equal(t, ReroutePolicyOnFailure, n.getCircuitReroutePolicy(circuit))
+	assert.Equal(t, 0, len(n.getRerouteCandidates()))
+
+	svc.Tags[ReroutePolicyTag] = string(ReroutePolicyNever)
+	assert.NoError(t, n.Services.Update(svc, nil, change.New()))
+	assert.Equal(t, 0, len(n.getRerouteCandidates()))
+	assert.ErrorIs(t, n.rerouteCircuit(circuit, time.Now().Add(time.Second), RerouteReasonLinkFailed), errRerouteNotAllowed)
+
+	// invalid policies are rejected, leaving the existing policy in place
+	svc.Tags[ReroutePolicyTag] = "invalid"
+	assert.Error(t, n.Services.Update(svc, nil, change.New()))
+	assert.Equal(t, ReroutePolicyNever, n.getCircuitReroutePolicy(circuit))
+	assert.Equal(t, 0, len(n.getRerouteCandidates()))
+
+	svc.Tags[ReroutePolicyTag] = string(ReroutePolicySmart)
+	assert.NoError(t, n.Services.Update(svc, nil, change.New()))
+	assert.Equal(t, ReroutePolicySmart, n.getCircuitReroutePolicy(circuit))
+	assert.Equal(t, 1, len(n.getRerouteCandidates()))
+}
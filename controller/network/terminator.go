@@ -51,6 +51,7 @@ type Terminator struct {
 	PeerData        map[uint32][]byte
 	HostId          string
 	SavedPrecedence xt.Precedence
+	DrainDeadline   *time.Time
 }
 
 func (entity *Terminator) GetServiceId() string {
@@ -118,6 +119,7 @@ func (entity *Terminator) toBolt() *db.Terminator {
 		PeerData:        entity.PeerData,
 		HostId:          entity.HostId,
 		SavedPrecedence: savedPrecedence,
+		DrainDeadline:   entity.DrainDeadline,
 	}
 }
 
@@ -207,6 +209,11 @@ func (self *TerminatorManager) handlePrecedenceChange(terminatorId string, prece
 		return
 	}
 
+	// terminators are only moved out of draining by an explicit update
+	if terminator.Precedence.IsDraining() {
+		return
+	}
+
 	terminator.Precedence = precedence
 	checker := fields.UpdatedFieldsMap{
 		db.FieldTerminatorPrecedence: struct{}{},
@@ -218,9 +225,25 @@ func (self *TerminatorManager) handlePrecedenceChange(terminatorId string, prece
 }
 
 func (self *TerminatorManager) Update(entity *Terminator, updatedFields fields.UpdatedFields, ctx *change.Context) error {
+	self.setDrainDeadline(entity, updatedFields)
 	return DispatchUpdate[*Terminator](self, entity, updatedFields, ctx)
 }
 
+// setDrainDeadline gives terminators which are being moved to draining, without a drain deadline, the default
+// deadline. The deadline is set here, rather than when the update is applied, so all controllers agree on it
+func (self *TerminatorManager) setDrainDeadline(entity *Terminator, updatedFields fields.UpdatedFields) {
+	if updatedFields != nil && !updatedFields.IsUpdated(db.FieldTerminatorPrecedence) {
+		return
+	}
+
+	if entity.Precedence == nil || !entity.Precedence.IsDraining() {
+		entity.DrainDeadline = nil
+	} else if entity.DrainDeadline == nil {
+		deadline := time.Now().Add(self.network.options.TerminatorDrainTimeout)
+		entity.DrainDeadline = &deadline
+	}
+}
+
 func (self *TerminatorManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Terminator], ctx boltz.MutateContext) error {
 	terminator := cmd.Entity
 	return self.db.Update(ctx, func(ctx boltz.MutateContext) error {
@@ -281,6 +304,7 @@ func (self *TerminatorManager) populateTerminator(entity *Terminator, _ *bbolt.T
 	if boltTerminator.SavedPrecedence != nil {
 		entity.SavedPrecedence = xt.GetPrecedenceForName(*boltTerminator.SavedPrecedence)
 	}
+	entity.DrainDeadline = boltTerminator.DrainDeadline
 
 	return nil
 }
@@ -297,6 +321,8 @@ func (self *TerminatorManager) Marshall(entity *Terminator) ([]byte, error) {
 			precedence = 1
 		} else if entity.Precedence.IsRequired() {
 			precedence = 2
+		} else if entity.Precedence.IsDraining() {
+			precedence = 3
 		}
 	}
 
@@ -308,9 +334,16 @@ func (self *TerminatorManager) Marshall(entity *Terminator) ([]byte, error) {
 			savedPrecedence = 2
 		} else if entity.SavedPrecedence.IsDefault() {
 			savedPrecedence = 3
+		} else if entity.SavedPrecedence.IsDraining() {
+			savedPrecedence = 4
 		}
 	}
 
+	var drainDeadline int64
+	if entity.DrainDeadline != nil {
+		drainDeadline = entity.DrainDeadline.UnixMilli()
+	}
+
 	msg := &cmd_pb.Terminator{
 		Id:              entity.Id,
 		ServiceId:       entity.GetServiceId(),
@@ -326,6 +359,7 @@ func (self *TerminatorManager) Marshall(entity *Terminator) ([]byte, error) {
 		HostId:          entity.HostId,
 		IsSystem:        entity.IsSystem,
 		SavedPrecedence: savedPrecedence,
+		DrainDeadline:   drainDeadline,
 	}
 
 	return proto.Marshal(msg)
@@ -342,6 +376,8 @@ func (self *TerminatorManager) Unmarshall(bytes []byte) (*Terminator, error) {
 		precedence = xt.Precedences.Failed
 	} else if msg.Precedence == 2 {
 		precedence = xt.Precedences.Required
+	} else if msg.Precedence == 3 {
+		precedence = xt.Precedences.Draining
 	}
 
 	var savedPrecedence xt.Precedence
@@ -351,6 +387,14 @@ func (self *TerminatorManager) Unmarshall(bytes []byte) (*Terminator, error) {
 		savedPrecedence = xt.Precedences.Required
 	} else if msg.SavedPrecedence == 3 {
 		savedPrecedence = xt.Precedences.Default
+	} else if msg.SavedPrecedence == 4 {
+		savedPrecedence = xt.Precedences.Draining
+	}
+
+	var drainDeadline *time.Time
+	if msg.DrainDeadline != 0 {
+		deadline := time.UnixMilli(msg.DrainDeadline)
+		drainDeadline = &deadline
 	}
 
 	result := &Terminator{
@@ -370,6 +414,7 @@ func (self *TerminatorManager) Unmarshall(bytes []byte) (*Terminator, error) {
 		PeerData:        msg.PeerData,
 		HostId:          msg.HostId,
 		SavedPrecedence: savedPrecedence,
+		DrainDeadline:   drainDeadline,
 	}
 
 	return result, nil
@@ -0,0 +1,224 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/v2/protobufs"
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/orcaman/concurrent-map/v2"
+	"github.com/pkg/errors"
+)
+
+// TerminatorCircuitsInspectPrefix is the inspect value used to ask a controller how many of its circuits use a
+// terminator. It's followed by the terminator id
+const TerminatorCircuitsInspectPrefix = "terminator-circuits:"
+
+func newTerminatorDrains(network *Network) *terminatorDrains {
+	result := &terminatorDrains{
+		network:   network,
+		deadlines: cmap.New[time.Time](),
+		pending:   cmap.New[struct{}](),
+		notifyC:   make(chan struct{}, 1),
+	}
+
+	network.stores.Terminator.AddEntityEventListenerF(result.terminatorChanged, boltz.EntityCreated, boltz.EntityUpdated)
+	network.stores.Terminator.AddEntityIdListener(result.deadlines.Remove, boltz.EntityDeleted)
+
+	return result
+}
+
+// terminatorDrains tracks draining terminators and removes them once they have no circuits, or their drain deadline
+// has passed. Circuits aren't replicated, so a terminator is only removed once every controller reports that it has
+// no circuits using it. Every controller tracks draining terminators, and checks one when its last local circuit
+// using the terminator ends, so whichever controller has the last circuit triggers the removal. The leader also
+// checks terminators when they start draining and when their drain deadline passes
+type terminatorDrains struct {
+	network   *Network
+	deadlines cmap.ConcurrentMap[string, time.Time]
+	pending   cmap.ConcurrentMap[string, struct{}]
+	notifyC   chan struct{}
+}
+
+func (self *terminatorDrains) terminatorChanged(terminator *db.Terminator) {
+	if terminator.Precedence != xt.Precedences.Draining.String() {
+		self.deadlines.Remove(terminator.Id)
+		return
+	}
+
+	var deadline time.Time
+	if terminator.DrainDeadline != nil {
+		deadline = *terminator.DrainDeadline
+	}
+
+	wasDraining := self.deadlines.Has(terminator.Id)
+	self.deadlines.Set(terminator.Id, deadline)
+	if !wasDraining && self.network.Dispatcher.IsLeaderOrLeaderless() {
+		self.queueCheck(terminator.Id)
+	}
+}
+
+// circuitRemoved is called when a local circuit ends. If it was the last local circuit for a draining terminator,
+// the terminator is checked
+func (self *terminatorDrains) circuitRemoved(circuit *Circuit) {
+	terminatorId := circuit.Terminator.GetId()
+	if self.deadlines.Has(terminatorId) && self.network.circuitController.countForTerminator(terminatorId) == 0 {
+		self.queueCheck(terminatorId)
+	}
+}
+
+// checkDeadlines queues checks for draining terminators whose drain deadline has passed. Only the leader checks
+// deadlines, since the deadline is replicated and one controller removing the terminator is enough
+func (self *terminatorDrains) checkDeadlines() {
+	if !self.network.Dispatcher.IsLeaderOrLeaderless() {
+		return
+	}
+
+	now := time.Now()
+	for terminatorId, deadline := range self.deadlines.Items() {
+		if !deadline.IsZero() && now.After(deadline) {
+			self.queueCheck(terminatorId)
+		}
+	}
+}
+
+// queueCheck doesn't block, as it's called from store listeners and while circuits are removed. Checks for the
+// same terminator which are queued before the check runs are merged
+func (self *terminatorDrains) queueCheck(terminatorId string) {
+	self.pending.Set(terminatorId, struct{}{})
+	select {
+	case self.notifyC <- struct{}{}:
+	default:
+	}
+}
+
+// loadDraining picks up terminators which were set to draining before this controller started. Their circuits may
+// have ended while it was down, so each one is checked
+func (self *terminatorDrains) loadDraining() {
+	result, err := self.network.Terminators.BaseList(fmt.Sprintf(`precedence = "%v" limit none`, xt.Precedences.Draining.String()))
+	if err != nil {
+		pfxlog.Logger().WithError(err).Error("unable to list draining terminators")
+		return
+	}
+
+	for _, terminator := range result.Entities {
+		var deadline time.Time
+		if terminator.DrainDeadline != nil {
+			deadline = *terminator.DrainDeadline
+		}
+		self.deadlines.SetIfAbsent(terminator.Id, deadline)
+		self.queueCheck(terminator.Id)
+	}
+}
+
+func (self *terminatorDrains) run() {
+	self.loadDraining()
+
+	for {
+		select {
+		case <-self.notifyC:
+			for _, terminatorId := range self.pending.Keys() {
+				self.pending.Remove(terminatorId)
+				self.check(terminatorId)
+			}
+		case <-self.network.closeNotify:
+			return
+		}
+	}
+}
+
+// check removes the terminator if its drain deadline has passed, or if no controller has circuits using it. If
+// some controller can't be asked, the terminator is kept. It will be checked again when a circuit using it ends, and
+// removed once its drain deadline passes
+func (self *terminatorDrains) check(terminatorId string) {
+	deadline, draining := self.deadlines.Get(terminatorId)
+	if !draining {
+		return
+	}
+
+	log := pfxlog.Logger().WithField("terminatorId", terminatorId)
+
+	expired := !deadline.IsZero() && time.Now().After(deadline)
+	if !expired {
+		circuitCount, err := self.countCircuits(terminatorId)
+		if err != nil {
+			log.WithError(err).Info("unable to get circuit counts from all controllers, not removing draining terminator")
+			return
+		}
+		if circuitCount > 0 {
+			return
+		}
+	}
+
+	ctx := change.New().SetChangeAuthorType(change.AuthorTypeController).SetSourceMethod("terminator.drain")
+	if err := self.network.Terminators.Delete(terminatorId, ctx); err != nil {
+		if boltz.IsErrNotFoundErr(err) {
+			log.Debug("drained terminator already removed")
+		} else {
+			log.WithError(err).Error("unable to remove drained terminator")
+		}
+	} else if expired {
+		log.Info("removed draining terminator, drain deadline passed")
+	} else {
+		log.Info("removed draining terminator, no circuits remaining")
+	}
+}
+
+// countCircuits returns the number of circuits using the terminator, summed across this controller and its peers
+func (self *terminatorDrains) countCircuits(terminatorId string) (int, error) {
+	result := self.network.circuitController.countForTerminator(terminatorId)
+
+	inspectValue := TerminatorCircuitsInspectPrefix + terminatorId
+	for peerId, ch := range self.network.Dispatcher.GetPeers() {
+		request := &ctrl_pb.InspectRequest{RequestedValues: []string{inspectValue}}
+		resp := &ctrl_pb.InspectResponse{}
+		respMsg, err := protobufs.MarshalTyped(request).WithTimeout(10 * time.Second).SendForReply(ch)
+		if err = protobufs.TypedResponse(resp).Unmarshall(respMsg, err); err != nil {
+			return 0, errors.Wrapf(err, "unable to get circuit count from controller %v", peerId)
+		}
+
+		if !resp.Success {
+			return 0, errors.Errorf("unable to get circuit count from controller %v (%v)", peerId, resp.Errors)
+		}
+
+		found := false
+		for _, val := range resp.Values {
+			if val.Name == inspectValue {
+				count, err := strconv.Atoi(val.Value)
+				if err != nil {
+					return 0, errors.Wrapf(err, "invalid circuit count from controller %v", peerId)
+				}
+				result += count
+				found = true
+			}
+		}
+
+		if !found {
+			return 0, errors.Errorf("controller %v didn't report a circuit count", peerId)
+		}
+	}
+
+	return result, nil
+}
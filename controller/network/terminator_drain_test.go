@@ -0,0 +1,192 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/storage/boltz"
+	"github.com/openziti/ziti/controller/change"
+	"github.com/openziti/ziti/controller/db"
+	"github.com/openziti/ziti/controller/fields"
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_smartrouting"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainingTerminators(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+	req := require.New(t)
+
+	test := newMultiPathTest(t, ctx)
+	n := test.network
+	for _, r := range test.routers {
+		req.NoError(n.Routers.Create(r, change.New()))
+	}
+
+	svc := &Service{
+		BaseEntity:         models.BaseEntity{Id: "drain-svc"},
+		Name:               "drain",
+		TerminatorStrategy: xt_smartrouting.Name,
+	}
+	req.NoError(n.Services.Create(svc, change.New()))
+
+	newTerminator := func(id, routerId string) *Terminator {
+		terminator := &Terminator{
+			BaseEntity: models.BaseEntity{Id: id},
+			Service:    svc.Id,
+			Router:     routerId,
+			Binding:    "transport",
+			Address:    "tcp:localhost:1234",
+			Precedence: xt.Precedences.Default,
+		}
+		req.NoError(n.Terminators.Create(terminator, change.New()))
+		return terminator
+	}
+
+	setDraining := func(terminator *Terminator, deadline *time.Time) {
+		terminator.Precedence = xt.Precedences.Draining
+		terminator.DrainDeadline = deadline
+		checker := fields.UpdatedFieldsMap{db.FieldTerminatorPrecedence: struct{}{}}
+		req.NoError(n.Terminators.Update(terminator, checker, change.New()))
+	}
+
+	addCircuit := func(id string, terminator *Terminator) *Circuit {
+		circuit := &Circuit{
+			Id:         id,
+			ServiceId:  svc.Id,
+			Terminator: &RoutingTerminator{Terminator: terminator},
+		}
+		n.circuitController.add(circuit)
+		return circuit
+	}
+
+	requireRemoved := func(terminatorId string) {
+		req.Eventually(func() bool {
+			_, err := n.Terminators.Read(terminatorId)
+			return boltz.IsErrNotFoundErr(err)
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	tR2 := newTerminator("t-r2", "r2")
+	newTerminator("t-r3", "r3")
+	circuit := addCircuit("c-r2", tR2)
+
+	// draining without a deadline gets the default deadline
+	setDraining(tR2, nil)
+	tR2, err := n.Terminators.Read(tR2.Id)
+	req.NoError(err)
+	req.True(tR2.Precedence.IsDraining())
+	req.NotNil(tR2.DrainDeadline)
+	req.WithinDuration(time.Now().Add(n.options.TerminatorDrainTimeout), *tR2.DrainDeadline, time.Minute)
+
+	// draining terminators get no new circuits, even if they're the lowest cost
+	result, err := n.DryRunCircuit(&DryRunCircuitParams{ServiceId: svc.Id, RouterId: "r0"})
+	req.NoError(err)
+	req.Equal("t-r3", result.Ingresses[0].TerminatorId)
+	for _, terminator := range result.Ingresses[0].Terminators {
+		if terminator.Id == tR2.Id {
+			req.Equal("terminator is draining", terminator.Rejected)
+		}
+	}
+
+	// draining terminators are kept while they have circuits
+	n.terminatorDrains.check(tR2.Id)
+	_, err = n.Terminators.Read(tR2.Id)
+	req.NoError(err)
+
+	val, err := n.Inspect(TerminatorCircuitsInspectPrefix + tR2.Id)
+	req.NoError(err)
+	req.Equal("1", *val)
+
+	// the end of the last circuit triggers the removal
+	n.circuitController.remove(circuit)
+	n.terminatorDrains.circuitRemoved(circuit)
+	requireRemoved(tR2.Id)
+	req.False(n.terminatorDrains.deadlines.Has(tR2.Id))
+
+	// draining terminators are removed once the deadline passes, even if they still have circuits
+	tR1 := newTerminator("t-r1", "r1")
+	addCircuit("c-r1", tR1)
+	deadline := time.Now().Add(-time.Second)
+	setDraining(tR1, &deadline)
+	n.terminatorDrains.checkDeadlines()
+	requireRemoved(tR1.Id)
+
+	// terminators which are set to draining without circuits are removed straight away
+	tR0 := newTerminator("t-r0", "r0")
+	setDraining(tR0, nil)
+	requireRemoved(tR0.Id)
+
+	// draining terminators found at startup are checked, as their circuits may have ended while the controller was down
+	tR1 = newTerminator("t-r1-restart", "r1")
+	circuit = addCircuit("c-r1-restart", tR1)
+	setDraining(tR1, nil)
+	n.terminatorDrains.deadlines.Remove(tR1.Id)
+	n.circuitController.remove(circuit)
+	req.Eventually(n.terminatorDrains.pending.IsEmpty, time.Second, 10*time.Millisecond)
+	_, err = n.Terminators.Read(tR1.Id)
+	req.NoError(err)
+	n.terminatorDrains.loadDraining()
+	requireRemoved(tR1.Id)
+
+	// terminators which aren't draining are left alone
+	_, err = n.Terminators.Read("t-r3")
+	req.NoError(err)
+
+	// moving a terminator out of draining clears the deadline and stops tracking it
+	tR3, err := n.Terminators.Read("t-r3")
+	req.NoError(err)
+	addCircuit("c-r3", tR3)
+	setDraining(tR3, nil)
+	req.True(n.terminatorDrains.deadlines.Has(tR3.Id))
+	tR3.Precedence = xt.Precedences.Default
+	req.NoError(n.Terminators.Update(tR3, nil, change.New()))
+	tR3, err = n.Terminators.Read("t-r3")
+	req.NoError(err)
+	req.True(tR3.Precedence.IsDefault())
+	req.Nil(tR3.DrainDeadline)
+	req.False(n.terminatorDrains.deadlines.Has(tR3.Id))
+}
+
+func TestDrainingTerminatorMarshalling(t *testing.T) {
+	req := require.New(t)
+
+	deadline := time.UnixMilli(time.Now().UnixMilli())
+	terminator := &Terminator{
+		BaseEntity:      models.BaseEntity{Id: "t1"},
+		Service:         "svc",
+		Router:          "r1",
+		Precedence:      xt.Precedences.Draining,
+		SavedPrecedence: xt.Precedences.Draining,
+		DrainDeadline:   &deadline,
+	}
+
+	manager := &TerminatorManager{}
+	data, err := manager.Marshall(terminator)
+	req.NoError(err)
+
+	result, err := manager.Unmarshall(data)
+	req.NoError(err)
+	req.True(result.Precedence.IsDraining())
+	req.True(result.SavedPrecedence.IsDraining())
+	req.NotNil(result.DrainDeadline)
+	req.True(deadline.Equal(*result.DrainDeadline))
+}
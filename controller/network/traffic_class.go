@@ -0,0 +1,62 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/router/xgress"
+)
+
+// TrafficClassTag is the service tag which selects how the payloads of the service's circuits are scheduled on links
+// shared with other circuits. Valid values are realtime, interactive and bulk. Services without the tag are treated
+// as interactive
+const TrafficClassTag = "trafficClass"
+
+// setTrafficClassTag passes the service's traffic class to the routers on the circuit's path. Routers which don't
+// support traffic classes ignore the tag and send payloads in the order they arrive
+func setTrafficClassTag(svc *Service, tags map[string]string) {
+	val, found := svc.Tags[TrafficClassTag]
+	if !found || tags == nil {
+		return
+	}
+
+	class, err := xgress.ParseTrafficClass(fmt.Sprint(val))
+	if err != nil {
+		pfxlog.Logger().WithField("serviceId", svc.Id).WithError(err).Warn("invalid traffic class on service")
+		return
+	}
+
+	tags[xgress.CircuitTagTrafficClass] = class.String()
+}
+
+func validateTrafficClassTag(tags map[string]interface{}) error {
+	val, found := tags[TrafficClassTag]
+	if !found {
+		return nil
+	}
+	name, ok := val.(string)
+	if !ok {
+		return errorz.NewFieldError("trafficClass must be a string", "tags."+TrafficClassTag, val)
+	}
+	if _, err := xgress.ParseTrafficClass(name); err != nil {
+		return errorz.NewFieldError(err.Error(), "tags."+TrafficClassTag, val)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/controller/models"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTrafficClassTag(t *testing.T) {
+	req := require.New(t)
+
+	newService := func(tags map[string]interface{}) *Service {
+		return &Service{BaseEntity: models.BaseEntity{Id: "svc", Tags: tags}}
+	}
+
+	tags := map[string]string{}
+	setTrafficClassTag(newService(map[string]interface{}{TrafficClassTag: "bulk"}), tags)
+	req.Equal("bulk", tags[xgress.CircuitTagTrafficClass])
+
+	tags = map[string]string{}
+	setTrafficClassTag(newService(map[string]interface{}{TrafficClassTag: "realtime"}), tags)
+	req.Equal("realtime", tags[xgress.CircuitTagTrafficClass])
+
+	// services without a class, or with an invalid class, don't set the tag
+	tags = map[string]string{}
+	setTrafficClassTag(newService(nil), tags)
+	req.NotContains(tags, xgress.CircuitTagTrafficClass)
+
+	setTrafficClassTag(newService(map[string]interface{}{TrafficClassTag: "urgent"}), tags)
+	req.NotContains(tags, xgress.CircuitTagTrafficClass)
+}
@@ -0,0 +1,463 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/controller/event"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	UsageRollupHourly = "hourly"
+	UsageRollupDaily  = "daily"
+
+	UsageRollupByIdentity = "identity"
+	UsageRollupByService  = "service"
+	UsageRollupByRouter   = "router"
+
+	DefaultUsageRollupFlushInterval   = time.Minute
+	DefaultUsageRollupHourlyRetention = 7 * 24 * time.Hour
+	DefaultUsageRollupDailyRetention  = 90 * 24 * time.Hour
+
+	usageRollupsBucket = "usageRollups"
+)
+
+var usageRollupIntervals = map[string]time.Duration{
+	UsageRollupHourly: time.Hour,
+	UsageRollupDaily:  24 * time.Hour,
+}
+
+var usageRollupGroupings = []string{UsageRollupByIdentity, UsageRollupByService, UsageRollupByRouter}
+
+// UsageRollup holds the usage totals for a single entity over a single hourly or daily interval
+type UsageRollup struct {
+	Interval      string    `json:"interval"`
+	GroupBy       string    `json:"groupBy"`
+	EntityId      string    `json:"entityId"`
+	IntervalStart time.Time `json:"intervalStart"`
+	IngressRx     uint64    `json:"ingressRx"`
+	IngressTx     uint64    `json:"ingressTx"`
+	EgressRx      uint64    `json:"egressRx"`
+	EgressTx      uint64    `json:"egressTx"`
+	FabricRx      uint64    `json:"fabricRx"`
+	FabricTx      uint64    `json:"fabricTx"`
+	Circuits      uint64    `json:"circuits"`
+}
+
+func (self *UsageRollup) key() usageRollupKey {
+	return usageRollupKey{
+		interval:      self.Interval,
+		groupBy:       self.GroupBy,
+		entityId:      self.EntityId,
+		intervalStart: self.IntervalStart.Unix(),
+	}
+}
+
+func (self *UsageRollup) addUsage(usageType string, value uint64) {
+	switch usageType {
+	case "ingress.rx":
+		self.IngressRx += value
+	case "ingress.tx":
+		self.IngressTx += value
+	case "egress.rx":
+		self.EgressRx += value
+	case "egress.tx":
+		self.EgressTx += value
+	case "fabric.rx":
+		self.FabricRx += value
+	case "fabric.tx":
+		self.FabricTx += value
+	}
+}
+
+type usageRollupKey struct {
+	interval      string
+	groupBy       string
+	entityId      string
+	intervalStart int64
+}
+
+func (self usageRollupKey) bytes() []byte {
+	return []byte(fmt.Sprintf("%s/%s/%020d/%s", self.interval, self.groupBy, self.intervalStart, self.entityId))
+}
+
+// UsageRollupQuery selects rollups for a single interval and grouping. EntityId, Since and Until are optional
+type UsageRollupQuery struct {
+	Interval string
+	GroupBy  string
+	EntityId string
+	Since    *time.Time
+	Until    *time.Time
+}
+
+type UsageRollupConfig struct {
+	Path            string
+	FlushInterval   time.Duration
+	HourlyRetention time.Duration
+	DailyRetention  time.Duration
+}
+
+func ParseUsageRollupConfig(config map[interface{}]interface{}) (*UsageRollupConfig, error) {
+	result := &UsageRollupConfig{
+		FlushInterval:   DefaultUsageRollupFlushInterval,
+		HourlyRetention: DefaultUsageRollupHourlyRetention,
+		DailyRetention:  DefaultUsageRollupDailyRetention,
+	}
+
+	if value, found := config["path"]; found {
+		if s, ok := value.(string); ok && s != "" {
+			result.Path = s
+		} else {
+			return nil, errors.Errorf("invalid usage rollups path %v", value)
+		}
+	}
+
+	parseDuration := func(name string, target *time.Duration) error {
+		value, found := config[name]
+		if !found {
+			return nil
+		}
+		s, ok := value.(string)
+		if !ok {
+			return errors.Errorf("invalid usage rollups %v %v, must be a duration", name, value)
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			return errors.Errorf("invalid usage rollups %v %v, must be a positive duration", name, value)
+		}
+		*target = d
+		return nil
+	}
+
+	if err := parseDuration("flushInterval", &result.FlushInterval); err != nil {
+		return nil, err
+	}
+	if err := parseDuration("hourlyRetention", &result.HourlyRetention); err != nil {
+		return nil, err
+	}
+	if err := parseDuration("dailyRetention", &result.DailyRetention); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func newUsageRollupManager(network *Network) *UsageRollupManager {
+	return &UsageRollupManager{
+		network: network,
+		rollups: map[usageRollupKey]*UsageRollup{},
+		dirty:   map[usageRollupKey]struct{}{},
+	}
+}
+
+// UsageRollupManager aggregates usage and circuit events into hourly and daily totals per identity, service and
+// router. It's disabled unless configured. Usage is attributed to the router which reported it and to the service
+// from the usage tags. Ingress usage is attributed to the client identity and egress usage to the hosting identity.
+// If a path is configured, rollups are persisted to a bolt db on an interval, so totals survive restarts.
+type UsageRollupManager struct {
+	network *Network
+	config  *UsageRollupConfig
+	lock    sync.Mutex
+	rollups map[usageRollupKey]*UsageRollup
+	dirty   map[usageRollupKey]struct{}
+	removed []usageRollupKey
+	db      *bbolt.DB
+}
+
+func (self *UsageRollupManager) IsEnabled() bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.config != nil
+}
+
+// Enable starts aggregating usage using the given config
+func (self *UsageRollupManager) Enable(config *UsageRollupConfig) error {
+	self.lock.Lock()
+	self.config = config
+	self.lock.Unlock()
+
+	if config.Path != "" {
+		if err := self.open(config.Path); err != nil {
+			return err
+		}
+	}
+
+	dispatcher := self.network.GetEventDispatcher()
+	dispatcher.AddUsageEventV3Handler(self)
+	dispatcher.AddCircuitEventHandler(self)
+
+	go self.run(self.network.GetCloseNotify())
+
+	return nil
+}
+
+func (self *UsageRollupManager) AcceptUsageEventV3(evt *event.UsageEventV3) {
+	intervalStart := time.Unix(evt.IntervalStartUTC, 0)
+	serviceId := evt.Tags["serviceId"]
+	clientId := evt.Tags["clientId"]
+	hostId := evt.Tags["hostId"]
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for usageType, value := range evt.Usage {
+		for interval := range usageRollupIntervals {
+			self.getRollup(interval, UsageRollupByRouter, evt.SourceId, intervalStart).addUsage(usageType, value)
+			self.getRollup(interval, UsageRollupByService, serviceId, intervalStart).addUsage(usageType, value)
+
+			switch usageType {
+			case "ingress.rx", "ingress.tx":
+				self.getRollup(interval, UsageRollupByIdentity, clientId, intervalStart).addUsage(usageType, value)
+			case "egress.rx", "egress.tx":
+				self.getRollup(interval, UsageRollupByIdentity, hostId, intervalStart).addUsage(usageType, value)
+			}
+		}
+	}
+}
+
+func (self *UsageRollupManager) AcceptCircuitEvent(evt *event.CircuitEvent) {
+	if evt.EventType != event.CircuitCreated {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for interval := range usageRollupIntervals {
+		self.getRollup(interval, UsageRollupByService, evt.ServiceId, evt.Timestamp).Circuits++
+		self.getRollup(interval, UsageRollupByIdentity, evt.Tags["clientId"], evt.Timestamp).Circuits++
+		for _, routerId := range evt.Path.Nodes {
+			self.getRollup(interval, UsageRollupByRouter, routerId, evt.Timestamp).Circuits++
+		}
+	}
+}
+
+// getRollup returns the rollup for the given entity and the interval containing the given time, creating it if
+// needed. Usage without an entity id is dropped into a throwaway rollup. Must be called with the lock held
+func (self *UsageRollupManager) getRollup(interval, groupBy, entityId string, t time.Time) *UsageRollup {
+	if entityId == "" {
+		return &UsageRollup{}
+	}
+
+	key := usageRollupKey{
+		interval:      interval,
+		groupBy:       groupBy,
+		entityId:      entityId,
+		intervalStart: t.UTC().Truncate(usageRollupIntervals[interval]).Unix(),
+	}
+
+	self.dirty[key] = struct{}{}
+	result, found := self.rollups[key]
+	if !found {
+		result = &UsageRollup{
+			Interval:      interval,
+			GroupBy:       groupBy,
+			EntityId:      entityId,
+			IntervalStart: time.Unix(key.intervalStart, 0).UTC(),
+		}
+		self.rollups[key] = result
+	}
+	return result
+}
+
+// List returns copies of the rollups matching the query, ordered by interval start and then entity id
+func (self *UsageRollupManager) List(query *UsageRollupQuery) ([]*UsageRollup, error) {
+	if _, found := usageRollupIntervals[query.Interval]; !found {
+		return nil, errorz.NewFieldError("interval must be one of hourly or daily", "interval", query.Interval)
+	}
+
+	if !isValidUsageRollupGrouping(query.GroupBy) {
+		return nil, errorz.NewFieldError("groupBy must be one of identity, service or router", "groupBy", query.GroupBy)
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.config == nil {
+		return nil, errors.New("usage rollups are not enabled on this controller")
+	}
+
+	var result []*UsageRollup
+	for key, rollup := range self.rollups {
+		if key.interval != query.Interval || key.groupBy != query.GroupBy {
+			continue
+		}
+		if query.EntityId != "" && key.entityId != query.EntityId {
+			continue
+		}
+		if query.Since != nil && rollup.IntervalStart.Before(query.Since.UTC().Truncate(usageRollupIntervals[key.interval])) {
+			continue
+		}
+		if query.Until != nil && !rollup.IntervalStart.Before(*query.Until) {
+			continue
+		}
+		rollupCopy := *rollup
+		result = append(result, &rollupCopy)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].IntervalStart.Equal(result[j].IntervalStart) {
+			return result[i].EntityId < result[j].EntityId
+		}
+		return result[i].IntervalStart.Before(result[j].IntervalStart)
+	})
+
+	return result, nil
+}
+
+func isValidUsageRollupGrouping(groupBy string) bool {
+	for _, grouping := range usageRollupGroupings {
+		if grouping == groupBy {
+			return true
+		}
+	}
+	return false
+}
+
+// prune removes rollups for intervals which have passed out of the retention window
+func (self *UsageRollupManager) prune(now time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	retention := map[string]time.Duration{
+		UsageRollupHourly: self.config.HourlyRetention,
+		UsageRollupDaily:  self.config.DailyRetention,
+	}
+
+	for key, rollup := range self.rollups {
+		intervalEnd := rollup.IntervalStart.Add(usageRollupIntervals[key.interval])
+		if now.Sub(intervalEnd) > retention[key.interval] {
+			delete(self.rollups, key)
+			delete(self.dirty, key)
+			if self.db != nil {
+				self.removed = append(self.removed, key)
+			}
+		}
+	}
+}
+
+func (self *UsageRollupManager) run(closeNotify <-chan struct{}) {
+	ticker := time.NewTicker(self.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.prune(time.Now())
+			if err := self.flush(); err != nil {
+				pfxlog.Logger().WithError(err).Error("failed to persist usage rollups")
+			}
+		case <-closeNotify:
+			if err := self.flush(); err != nil {
+				pfxlog.Logger().WithError(err).Error("failed to persist usage rollups")
+			}
+			if self.db != nil {
+				if err := self.db.Close(); err != nil {
+					pfxlog.Logger().WithError(err).Error("failed to close usage rollups db")
+				}
+			}
+			return
+		}
+	}
+}
+
+func (self *UsageRollupManager) open(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "unable to create directory for usage rollups '%v'", path)
+	}
+
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return errors.Wrapf(err, "unable to open usage rollups '%v'", path)
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(usageRollupsBucket))
+		if err != nil {
+			return err
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			rollup := &UsageRollup{}
+			if err := json.Unmarshal(v, rollup); err != nil {
+				return errors.Wrapf(err, "unable to decode usage rollup %v", string(k))
+			}
+			self.rollups[rollup.key()] = rollup
+			return nil
+		})
+	})
+
+	if err != nil {
+		_ = db.Close()
+		return errors.Wrapf(err, "unable to load usage rollups '%v'", path)
+	}
+
+	self.db = db
+
+	pfxlog.Logger().WithField("path", path).WithField("rollups", len(self.rollups)).Info("usage rollups loaded")
+
+	return nil
+}
+
+func (self *UsageRollupManager) flush() error {
+	if self.db == nil {
+		return nil
+	}
+
+	self.lock.Lock()
+	var updated []*UsageRollup
+	for key := range self.dirty {
+		rollupCopy := *self.rollups[key]
+		updated = append(updated, &rollupCopy)
+	}
+	removed := self.removed
+	self.dirty = map[usageRollupKey]struct{}{}
+	self.removed = nil
+	self.lock.Unlock()
+
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(usageRollupsBucket))
+		for _, key := range removed {
+			if err := bucket.Delete(key.bytes()); err != nil {
+				return err
+			}
+		}
+		for _, rollup := range updated {
+			val, err := json.Marshal(rollup)
+			if err != nil {
+				return err
+			}
+			if err = bucket.Put(rollup.key().bytes(), val); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
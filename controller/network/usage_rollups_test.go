@@ -0,0 +1,180 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/event"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUsageRollupManager(config *UsageRollupConfig) *UsageRollupManager {
+	result := newUsageRollupManager(nil)
+	result.config = config
+	return result
+}
+
+func TestUsageRollups(t *testing.T) {
+	req := require.New(t)
+
+	mgr := newTestUsageRollupManager(&UsageRollupConfig{HourlyRetention: 2 * time.Hour, DailyRetention: 48 * time.Hour})
+
+	start := time.Date(2024, 3, 1, 10, 15, 0, 0, time.UTC)
+
+	mgr.AcceptCircuitEvent(&event.CircuitEvent{
+		EventType: event.CircuitCreated,
+		Timestamp: start,
+		ServiceId: "s1",
+		Path:      event.CircuitPath{Nodes: []string{"r1", "r2"}},
+		Tags:      map[string]string{"clientId": "i1"},
+	})
+
+	mgr.AcceptCircuitEvent(&event.CircuitEvent{
+		EventType: event.CircuitDeleted,
+		Timestamp: start,
+		ServiceId: "s1",
+	})
+
+	mgr.AcceptUsageEventV3(&event.UsageEventV3{
+		SourceId:         "r1",
+		Usage:            map[string]uint64{"ingress.rx": 100, "ingress.tx": 200, "fabric.tx": 100},
+		IntervalStartUTC: start.Unix(),
+		Tags:             map[string]string{"serviceId": "s1", "clientId": "i1", "hostId": "i2"},
+	})
+
+	mgr.AcceptUsageEventV3(&event.UsageEventV3{
+		SourceId:         "r2",
+		Usage:            map[string]uint64{"egress.rx": 300, "egress.tx": 400},
+		IntervalStartUTC: start.Add(time.Hour).Unix(),
+		Tags:             map[string]string{"serviceId": "s1", "clientId": "i1", "hostId": "i2"},
+	})
+
+	hourly, err := mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: UsageRollupByService})
+	req.NoError(err)
+	req.Len(hourly, 2)
+	req.Equal(start.Truncate(time.Hour), hourly[0].IntervalStart)
+	req.Equal(uint64(100), hourly[0].IngressRx)
+	req.Equal(uint64(200), hourly[0].IngressTx)
+	req.Equal(uint64(100), hourly[0].FabricTx)
+	req.Equal(uint64(1), hourly[0].Circuits)
+	req.Equal(uint64(300), hourly[1].EgressRx)
+	req.Equal(uint64(0), hourly[1].Circuits)
+
+	daily, err := mgr.List(&UsageRollupQuery{Interval: UsageRollupDaily, GroupBy: UsageRollupByService, EntityId: "s1"})
+	req.NoError(err)
+	req.Len(daily, 1)
+	req.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), daily[0].IntervalStart)
+	req.Equal(uint64(100), daily[0].IngressRx)
+	req.Equal(uint64(400), daily[0].EgressTx)
+
+	identities, err := mgr.List(&UsageRollupQuery{Interval: UsageRollupDaily, GroupBy: UsageRollupByIdentity})
+	req.NoError(err)
+	req.Len(identities, 2)
+	req.Equal("i1", identities[0].EntityId)
+	req.Equal(uint64(100), identities[0].IngressRx)
+	req.Equal(uint64(0), identities[0].EgressRx)
+	req.Equal(uint64(1), identities[0].Circuits)
+	req.Equal("i2", identities[1].EntityId)
+	req.Equal(uint64(300), identities[1].EgressRx)
+
+	routers, err := mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: UsageRollupByRouter, EntityId: "r2"})
+	req.NoError(err)
+	req.Len(routers, 2)
+	req.Equal(uint64(1), routers[0].Circuits)
+	req.Equal(uint64(400), routers[1].EgressTx)
+
+	since := start.Add(time.Hour)
+	hourly, err = mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: UsageRollupByService, Since: &since})
+	req.NoError(err)
+	req.Len(hourly, 1)
+
+	until := start.Add(time.Hour).Truncate(time.Hour)
+	hourly, err = mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: UsageRollupByService, Until: &until})
+	req.NoError(err)
+	req.Len(hourly, 1)
+
+	_, err = mgr.List(&UsageRollupQuery{Interval: "weekly", GroupBy: UsageRollupByService})
+	req.Error(err)
+
+	_, err = mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: "terminator"})
+	req.Error(err)
+
+	// the first hourly interval ended more than 2h ago, the second ended just over an hour ago
+	mgr.prune(start.Add(3 * time.Hour))
+	hourly, err = mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: UsageRollupByService})
+	req.NoError(err)
+	req.Len(hourly, 1)
+
+	daily, err = mgr.List(&UsageRollupQuery{Interval: UsageRollupDaily, GroupBy: UsageRollupByService})
+	req.NoError(err)
+	req.Len(daily, 1)
+}
+
+func TestUsageRollupsDisabled(t *testing.T) {
+	mgr := newUsageRollupManager(nil)
+	_, err := mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: UsageRollupByService})
+	require.Error(t, err)
+}
+
+func TestUsageRollupPersistence(t *testing.T) {
+	req := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "usage.db")
+	config := &UsageRollupConfig{Path: path, HourlyRetention: time.Hour, DailyRetention: 24 * time.Hour}
+
+	start := time.Now().Truncate(time.Hour)
+	usage := &event.UsageEventV3{
+		SourceId:         "r1",
+		Usage:            map[string]uint64{"ingress.rx": 100},
+		IntervalStartUTC: start.Unix(),
+		Tags:             map[string]string{"serviceId": "s1"},
+	}
+
+	mgr := newTestUsageRollupManager(config)
+	req.NoError(mgr.open(path))
+	mgr.AcceptUsageEventV3(usage)
+	req.NoError(mgr.flush())
+	req.NoError(mgr.db.Close())
+
+	mgr = newTestUsageRollupManager(config)
+	req.NoError(mgr.open(path))
+	mgr.AcceptUsageEventV3(usage)
+
+	rollups, err := mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: UsageRollupByService})
+	req.NoError(err)
+	req.Len(rollups, 1)
+	req.Equal(uint64(200), rollups[0].IngressRx)
+
+	mgr.prune(start.Add(3 * time.Hour))
+	req.NoError(mgr.flush())
+	req.NoError(mgr.db.Close())
+
+	mgr = newTestUsageRollupManager(config)
+	req.NoError(mgr.open(path))
+	defer func() { _ = mgr.db.Close() }()
+
+	rollups, err = mgr.List(&UsageRollupQuery{Interval: UsageRollupHourly, GroupBy: UsageRollupByService})
+	req.NoError(err)
+	req.Empty(rollups)
+
+	rollups, err = mgr.List(&UsageRollupQuery{Interval: UsageRollupDaily, GroupBy: UsageRollupByService})
+	req.NoError(err)
+	req.Len(rollups, 1)
+}
@@ -278,7 +278,7 @@ func (s *HybridStorage) VerifyTotp(ctx *change.Context, code string, id string)
 		return nil, errors.New("totp not found")
 	}
 
-	ok, _ = s.env.GetManagers().Mfa.Verify(totp, code, ctx)
+	ok, _ = s.env.GetManagers().Authenticator.VerifyMfa(totp, code, ctx)
 
 	if !ok {
 		return nil, apierror.NewInvalidMfaTokenError()
@@ -0,0 +1,175 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// NewCreateAlertRuleParams creates a new CreateAlertRuleParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateAlertRuleParams() *CreateAlertRuleParams {
+	return &CreateAlertRuleParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateAlertRuleParamsWithTimeout creates a new CreateAlertRuleParams object
+// with the ability to set a timeout on a request.
+func NewCreateAlertRuleParamsWithTimeout(timeout time.Duration) *CreateAlertRuleParams {
+	return &CreateAlertRuleParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateAlertRuleParamsWithContext creates a new CreateAlertRuleParams object
+// with the ability to set a context for a request.
+func NewCreateAlertRuleParamsWithContext(ctx context.Context) *CreateAlertRuleParams {
+	return &CreateAlertRuleParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateAlertRuleParamsWithHTTPClient creates a new CreateAlertRuleParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateAlertRuleParamsWithHTTPClient(client *http.Client) *CreateAlertRuleParams {
+	return &CreateAlertRuleParams{
+		HTTPClient: client,
+	}
+}
+
+/* CreateAlertRuleParams contains all the parameters to send to the API endpoint
+   for the create alert rule operation.
+
+   Typically these are written to a http.Request.
+*/
+type CreateAlertRuleParams struct {
+
+	/* AlertRule.
+
+	   An alert rule to create
+	*/
+	AlertRule *rest_model.AlertRuleCreate
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create alert rule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateAlertRuleParams) WithDefaults() *CreateAlertRuleParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create alert rule params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateAlertRuleParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create alert rule params
+func (o *CreateAlertRuleParams) WithTimeout(timeout time.Duration) *CreateAlertRuleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create alert rule params
+func (o *CreateAlertRuleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create alert rule params
+func (o *CreateAlertRuleParams) WithContext(ctx context.Context) *CreateAlertRuleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create alert rule params
+func (o *CreateAlertRuleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create alert rule params
+func (o *CreateAlertRuleParams) WithHTTPClient(client *http.Client) *CreateAlertRuleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create alert rule params
+func (o *CreateAlertRuleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAlertRule adds the alertRule to the create alert rule params
+func (o *CreateAlertRuleParams) WithAlertRule(alertRule *rest_model.AlertRuleCreate) *CreateAlertRuleParams {
+	o.SetAlertRule(alertRule)
+	return o
+}
+
+// SetAlertRule adds the alertRule to the create alert rule params
+func (o *CreateAlertRuleParams) SetAlertRule(alertRule *rest_model.AlertRuleCreate) {
+	o.AlertRule = alertRule
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateAlertRuleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.AlertRule != nil {
+		if err := r.SetBodyParam(o.AlertRule); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
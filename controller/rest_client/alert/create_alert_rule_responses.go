@@ -0,0 +1,205 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// CreateAlertRuleReader is a Reader for the CreateAlertRule structure.
+type CreateAlertRuleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateAlertRuleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateAlertRuleCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateAlertRuleBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewCreateAlertRuleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 429:
+		result := NewCreateAlertRuleTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewCreateAlertRuleCreated creates a CreateAlertRuleCreated with default headers values
+func NewCreateAlertRuleCreated() *CreateAlertRuleCreated {
+	return &CreateAlertRuleCreated{}
+}
+
+/* CreateAlertRuleCreated describes a response with status code 201, with default header values.
+
+The create request was successful and the resource has been added at the following location
+*/
+type CreateAlertRuleCreated struct {
+	Payload *rest_model.CreateEnvelope
+}
+
+func (o *CreateAlertRuleCreated) Error() string {
+	return fmt.Sprintf("[POST /alert-rules][%d] createAlertRuleCreated  %+v", 201, o.Payload)
+}
+func (o *CreateAlertRuleCreated) GetPayload() *rest_model.CreateEnvelope {
+	return o.Payload
+}
+
+func (o *CreateAlertRuleCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.CreateEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateAlertRuleBadRequest creates a CreateAlertRuleBadRequest with default headers values
+func NewCreateAlertRuleBadRequest() *CreateAlertRuleBadRequest {
+	return &CreateAlertRuleBadRequest{}
+}
+
+/* CreateAlertRuleBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type CreateAlertRuleBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *CreateAlertRuleBadRequest) Error() string {
+	return fmt.Sprintf("[POST /alert-rules][%d] createAlertRuleBadRequest  %+v", 400, o.Payload)
+}
+func (o *CreateAlertRuleBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CreateAlertRuleBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateAlertRuleUnauthorized creates a CreateAlertRuleUnauthorized with default headers values
+func NewCreateAlertRuleUnauthorized() *CreateAlertRuleUnauthorized {
+	return &CreateAlertRuleUnauthorized{}
+}
+
+/* CreateAlertRuleUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type CreateAlertRuleUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *CreateAlertRuleUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /alert-rules][%d] createAlertRuleUnauthorized  %+v", 401, o.Payload)
+}
+func (o *CreateAlertRuleUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CreateAlertRuleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateAlertRuleTooManyRequests creates a CreateAlertRuleTooManyRequests with default headers values
+func NewCreateAlertRuleTooManyRequests() *CreateAlertRuleTooManyRequests {
+	return &CreateAlertRuleTooManyRequests{}
+}
+
+/* CreateAlertRuleTooManyRequests describes a response with status code 429, with default header values.
+
+The resource requested is rate limited and the rate limit has been exceeded
+*/
+type CreateAlertRuleTooManyRequests struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *CreateAlertRuleTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /alert-rules][%d] createAlertRuleTooManyRequests  %+v", 429, o.Payload)
+}
+func (o *CreateAlertRuleTooManyRequests) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *CreateAlertRuleTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// DeleteAlertRuleReader is a Reader for the DeleteAlertRule structure.
+type DeleteAlertRuleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteAlertRuleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteAlertRuleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDeleteAlertRuleBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewDeleteAlertRuleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDeleteAlertRuleNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 429:
+		result := NewDeleteAlertRuleTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewDeleteAlertRuleOK creates a DeleteAlertRuleOK with default headers values
+func NewDeleteAlertRuleOK() *DeleteAlertRuleOK {
+	return &DeleteAlertRuleOK{}
+}
+
+/* DeleteAlertRuleOK describes a response with status code 200, with default header values.
+
+The delete request was successful and the resource has been removed
+*/
+type DeleteAlertRuleOK struct {
+	Payload *rest_model.Empty
+}
+
+func (o *DeleteAlertRuleOK) Error() string {
+	return fmt.Sprintf("[DELETE /alert-rules/{id}][%d] deleteAlertRuleOK  %+v", 200, o.Payload)
+}
+func (o *DeleteAlertRuleOK) GetPayload() *rest_model.Empty {
+	return o.Payload
+}
+
+func (o *DeleteAlertRuleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.Empty)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAlertRuleBadRequest creates a DeleteAlertRuleBadRequest with default headers values
+func NewDeleteAlertRuleBadRequest() *DeleteAlertRuleBadRequest {
+	return &DeleteAlertRuleBadRequest{}
+}
+
+/* DeleteAlertRuleBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type DeleteAlertRuleBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *DeleteAlertRuleBadRequest) Error() string {
+	return fmt.Sprintf("[DELETE /alert-rules/{id}][%d] deleteAlertRuleBadRequest  %+v", 400, o.Payload)
+}
+func (o *DeleteAlertRuleBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DeleteAlertRuleBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAlertRuleUnauthorized creates a DeleteAlertRuleUnauthorized with default headers values
+func NewDeleteAlertRuleUnauthorized() *DeleteAlertRuleUnauthorized {
+	return &DeleteAlertRuleUnauthorized{}
+}
+
+/* DeleteAlertRuleUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type DeleteAlertRuleUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *DeleteAlertRuleUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /alert-rules/{id}][%d] deleteAlertRuleUnauthorized  %+v", 401, o.Payload)
+}
+func (o *DeleteAlertRuleUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DeleteAlertRuleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAlertRuleNotFound creates a DeleteAlertRuleNotFound with default headers values
+func NewDeleteAlertRuleNotFound() *DeleteAlertRuleNotFound {
+	return &DeleteAlertRuleNotFound{}
+}
+
+/* DeleteAlertRuleNotFound describes a response with status code 404, with default header values.
+
+The requested resource does not exist
+*/
+type DeleteAlertRuleNotFound struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *DeleteAlertRuleNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /alert-rules/{id}][%d] deleteAlertRuleNotFound  %+v", 404, o.Payload)
+}
+func (o *DeleteAlertRuleNotFound) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DeleteAlertRuleNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAlertRuleTooManyRequests creates a DeleteAlertRuleTooManyRequests with default headers values
+func NewDeleteAlertRuleTooManyRequests() *DeleteAlertRuleTooManyRequests {
+	return &DeleteAlertRuleTooManyRequests{}
+}
+
+/* DeleteAlertRuleTooManyRequests describes a response with status code 429, with default header values.
+
+The resource requested is rate limited and the rate limit has been exceeded
+*/
+type DeleteAlertRuleTooManyRequests struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *DeleteAlertRuleTooManyRequests) Error() string {
+	return fmt.Sprintf("[DELETE /alert-rules/{id}][%d] deleteAlertRuleTooManyRequests  %+v", 429, o.Payload)
+}
+func (o *DeleteAlertRuleTooManyRequests) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *DeleteAlertRuleTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// ListAlertRulesReader is a Reader for the ListAlertRules structure.
+type ListAlertRulesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAlertRulesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAlertRulesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListAlertRulesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 429:
+		result := NewListAlertRulesTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListAlertRulesOK creates a ListAlertRulesOK with default headers values
+func NewListAlertRulesOK() *ListAlertRulesOK {
+	return &ListAlertRulesOK{}
+}
+
+/* ListAlertRulesOK describes a response with status code 200, with default header values.
+
+A list of alert rules
+*/
+type ListAlertRulesOK struct {
+	Payload *rest_model.ListAlertRulesEnvelope
+}
+
+func (o *ListAlertRulesOK) Error() string {
+	return fmt.Sprintf("[GET /alert-rules][%d] listAlertRulesOK  %+v", 200, o.Payload)
+}
+func (o *ListAlertRulesOK) GetPayload() *rest_model.ListAlertRulesEnvelope {
+	return o.Payload
+}
+
+func (o *ListAlertRulesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.ListAlertRulesEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAlertRulesUnauthorized creates a ListAlertRulesUnauthorized with default headers values
+func NewListAlertRulesUnauthorized() *ListAlertRulesUnauthorized {
+	return &ListAlertRulesUnauthorized{}
+}
+
+/* ListAlertRulesUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type ListAlertRulesUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *ListAlertRulesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /alert-rules][%d] listAlertRulesUnauthorized  %+v", 401, o.Payload)
+}
+func (o *ListAlertRulesUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListAlertRulesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAlertRulesTooManyRequests creates a ListAlertRulesTooManyRequests with default headers values
+func NewListAlertRulesTooManyRequests() *ListAlertRulesTooManyRequests {
+	return &ListAlertRulesTooManyRequests{}
+}
+
+/* ListAlertRulesTooManyRequests describes a response with status code 429, with default header values.
+
+The resource requested is rate limited and the rate limit has been exceeded
+*/
+type ListAlertRulesTooManyRequests struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *ListAlertRulesTooManyRequests) Error() string {
+	return fmt.Sprintf("[GET /alert-rules][%d] listAlertRulesTooManyRequests  %+v", 429, o.Payload)
+}
+func (o *ListAlertRulesTooManyRequests) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListAlertRulesTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
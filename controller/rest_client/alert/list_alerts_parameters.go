@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAlertsParams creates a new ListAlertsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListAlertsParams() *ListAlertsParams {
+	return &ListAlertsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAlertsParamsWithTimeout creates a new ListAlertsParams object
+// with the ability to set a timeout on a request.
+func NewListAlertsParamsWithTimeout(timeout time.Duration) *ListAlertsParams {
+	return &ListAlertsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListAlertsParamsWithContext creates a new ListAlertsParams object
+// with the ability to set a context for a request.
+func NewListAlertsParamsWithContext(ctx context.Context) *ListAlertsParams {
+	return &ListAlertsParams{
+		Context: ctx,
+	}
+}
+
+// NewListAlertsParamsWithHTTPClient creates a new ListAlertsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListAlertsParamsWithHTTPClient(client *http.Client) *ListAlertsParams {
+	return &ListAlertsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListAlertsParams contains all the parameters to send to the API endpoint
+   for the list alerts operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListAlertsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list alerts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAlertsParams) WithDefaults() *ListAlertsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list alerts params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAlertsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list alerts params
+func (o *ListAlertsParams) WithTimeout(timeout time.Duration) *ListAlertsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list alerts params
+func (o *ListAlertsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list alerts params
+func (o *ListAlertsParams) WithContext(ctx context.Context) *ListAlertsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list alerts params
+func (o *ListAlertsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list alerts params
+func (o *ListAlertsParams) WithHTTPClient(client *http.Client) *ListAlertsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list alerts params
+func (o *ListAlertsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAlertsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -58,6 +58,8 @@ type ClientService interface {
 
 	DetailCircuit(params *DetailCircuitParams, opts ...ClientOption) (*DetailCircuitOK, error)
 
+	DryRunCircuit(params *DryRunCircuitParams, opts ...ClientOption) (*DryRunCircuitOK, error)
+
 	ListCircuits(params *ListCircuitsParams, opts ...ClientOption) (*ListCircuitsOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -143,6 +145,50 @@ func (a *Client) DetailCircuit(params *DetailCircuitParams, opts ...ClientOption
 	panic(msg)
 }
 
+/*
+  DryRunCircuit evaluates circuit creation without creating a circuit
+
+  Runs terminator and path selection for a dial of the given service, without creating a circuit. Returns the
+candidate terminators with their costs, the terminator the service's strategy selects, the path with per hop
+costs, and why other terminators were rejected. If no ingress router is given, every edge router the identity
+can use to reach the service is evaluated. Requires admin access.
+
+*/
+func (a *Client) DryRunCircuit(params *DryRunCircuitParams, opts ...ClientOption) (*DryRunCircuitOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDryRunCircuitParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "dryRunCircuit",
+		Method:             "POST",
+		PathPattern:        "/circuit-dry-run",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DryRunCircuitReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DryRunCircuitOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for dryRunCircuit: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListCircuits lists circuits
 
@@ -0,0 +1,343 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package usage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListUsageParams creates a new ListUsageParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListUsageParams() *ListUsageParams {
+	return &ListUsageParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListUsageParamsWithTimeout creates a new ListUsageParams object
+// with the ability to set a timeout on a request.
+func NewListUsageParamsWithTimeout(timeout time.Duration) *ListUsageParams {
+	return &ListUsageParams{
+		timeout: timeout,
+	}
+}
+
+// NewListUsageParamsWithContext creates a new ListUsageParams object
+// with the ability to set a context for a request.
+func NewListUsageParamsWithContext(ctx context.Context) *ListUsageParams {
+	return &ListUsageParams{
+		Context: ctx,
+	}
+}
+
+// NewListUsageParamsWithHTTPClient creates a new ListUsageParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListUsageParamsWithHTTPClient(client *http.Client) *ListUsageParams {
+	return &ListUsageParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListUsageParams contains all the parameters to send to the API endpoint
+   for the list usage operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListUsageParams struct {
+
+	/* EntityID.
+
+	   Only return usage for the given identity, service or router id
+	*/
+	EntityID *string
+
+	/* GroupBy.
+
+	   The entity type usage is grouped by
+
+	   Default: "service"
+	*/
+	GroupBy *string
+
+	/* Interval.
+
+	   The rollup interval
+
+	   Default: "hourly"
+	*/
+	Interval *string
+
+	/* Since.
+
+	   Only return intervals which end after the given time
+
+	   Format: date-time
+	*/
+	Since *strfmt.DateTime
+
+	/* Until.
+
+	   Only return intervals which start before the given time
+
+	   Format: date-time
+	*/
+	Until *strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list usage params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListUsageParams) WithDefaults() *ListUsageParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list usage params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListUsageParams) SetDefaults() {
+	var (
+		groupByDefault = string("service")
+
+		intervalDefault = string("hourly")
+	)
+
+	val := ListUsageParams{
+		GroupBy:  &groupByDefault,
+		Interval: &intervalDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the list usage params
+func (o *ListUsageParams) WithTimeout(timeout time.Duration) *ListUsageParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list usage params
+func (o *ListUsageParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list usage params
+func (o *ListUsageParams) WithContext(ctx context.Context) *ListUsageParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list usage params
+func (o *ListUsageParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list usage params
+func (o *ListUsageParams) WithHTTPClient(client *http.Client) *ListUsageParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list usage params
+func (o *ListUsageParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithEntityID adds the entityID to the list usage params
+func (o *ListUsageParams) WithEntityID(entityID *string) *ListUsageParams {
+	o.SetEntityID(entityID)
+	return o
+}
+
+// SetEntityID adds the entityId to the list usage params
+func (o *ListUsageParams) SetEntityID(entityID *string) {
+	o.EntityID = entityID
+}
+
+// WithGroupBy adds the groupBy to the list usage params
+func (o *ListUsageParams) WithGroupBy(groupBy *string) *ListUsageParams {
+	o.SetGroupBy(groupBy)
+	return o
+}
+
+// SetGroupBy adds the groupBy to the list usage params
+func (o *ListUsageParams) SetGroupBy(groupBy *string) {
+	o.GroupBy = groupBy
+}
+
+// WithInterval adds the interval to the list usage params
+func (o *ListUsageParams) WithInterval(interval *string) *ListUsageParams {
+	o.SetInterval(interval)
+	return o
+}
+
+// SetInterval adds the interval to the list usage params
+func (o *ListUsageParams) SetInterval(interval *string) {
+	o.Interval = interval
+}
+
+// WithSince adds the since to the list usage params
+func (o *ListUsageParams) WithSince(since *strfmt.DateTime) *ListUsageParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the list usage params
+func (o *ListUsageParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithUntil adds the until to the list usage params
+func (o *ListUsageParams) WithUntil(until *strfmt.DateTime) *ListUsageParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the list usage params
+func (o *ListUsageParams) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListUsageParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.EntityID != nil {
+
+		// query param entityId
+		var qrEntityID string
+
+		if o.EntityID != nil {
+			qrEntityID = *o.EntityID
+		}
+		qEntityID := qrEntityID
+		if qEntityID != "" {
+
+			if err := r.SetQueryParam("entityId", qEntityID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.GroupBy != nil {
+
+		// query param groupBy
+		var qrGroupBy string
+
+		if o.GroupBy != nil {
+			qrGroupBy = *o.GroupBy
+		}
+		qGroupBy := qrGroupBy
+		if qGroupBy != "" {
+
+			if err := r.SetQueryParam("groupBy", qGroupBy); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Interval != nil {
+
+		// query param interval
+		var qrInterval string
+
+		if o.Interval != nil {
+			qrInterval = *o.Interval
+		}
+		qInterval := qrInterval
+		if qInterval != "" {
+
+			if err := r.SetQueryParam("interval", qInterval); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
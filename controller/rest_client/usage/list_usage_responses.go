@@ -0,0 +1,205 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package usage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// ListUsageReader is a Reader for the ListUsage structure.
+type ListUsageReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListUsageReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListUsageOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListUsageBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewListUsageUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 429:
+		result := NewListUsageTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewListUsageOK creates a ListUsageOK with default headers values
+func NewListUsageOK() *ListUsageOK {
+	return &ListUsageOK{}
+}
+
+/* ListUsageOK describes a response with status code 200, with default header values.
+
+A list of usage rollups
+*/
+type ListUsageOK struct {
+	Payload *rest_model.ListUsageEnvelope
+}
+
+func (o *ListUsageOK) Error() string {
+	return fmt.Sprintf("[GET /usage][%d] listUsageOK  %+v", 200, o.Payload)
+}
+func (o *ListUsageOK) GetPayload() *rest_model.ListUsageEnvelope {
+	return o.Payload
+}
+
+func (o *ListUsageOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.ListUsageEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUsageBadRequest creates a ListUsageBadRequest with default headers values
+func NewListUsageBadRequest() *ListUsageBadRequest {
+	return &ListUsageBadRequest{}
+}
+
+/* ListUsageBadRequest describes a response with status code 400, with default header values.
+
+The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+*/
+type ListUsageBadRequest struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *ListUsageBadRequest) Error() string {
+	return fmt.Sprintf("[GET /usage][%d] listUsageBadRequest  %+v", 400, o.Payload)
+}
+func (o *ListUsageBadRequest) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListUsageBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUsageUnauthorized creates a ListUsageUnauthorized with default headers values
+func NewListUsageUnauthorized() *ListUsageUnauthorized {
+	return &ListUsageUnauthorized{}
+}
+
+/* ListUsageUnauthorized describes a response with status code 401, with default header values.
+
+The currently supplied session does not have the correct access rights to request this resource
+*/
+type ListUsageUnauthorized struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *ListUsageUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /usage][%d] listUsageUnauthorized  %+v", 401, o.Payload)
+}
+func (o *ListUsageUnauthorized) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListUsageUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUsageTooManyRequests creates a ListUsageTooManyRequests with default headers values
+func NewListUsageTooManyRequests() *ListUsageTooManyRequests {
+	return &ListUsageTooManyRequests{}
+}
+
+/* ListUsageTooManyRequests describes a response with status code 429, with default header values.
+
+The resource requested is rate limited and the rate limit has been exceeded
+*/
+type ListUsageTooManyRequests struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *ListUsageTooManyRequests) Error() string {
+	return fmt.Sprintf("[GET /usage][%d] listUsageTooManyRequests  %+v", 429, o.Payload)
+}
+func (o *ListUsageTooManyRequests) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *ListUsageTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
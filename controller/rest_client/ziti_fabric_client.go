@@ -34,6 +34,7 @@ import (
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/openziti/ziti/controller/rest_client/alert"
 	"github.com/openziti/ziti/controller/rest_client/circuit"
 	"github.com/openziti/ziti/controller/rest_client/database"
 	"github.com/openziti/ziti/controller/rest_client/inspect"
@@ -42,6 +43,7 @@ import (
 	"github.com/openziti/ziti/controller/rest_client/router"
 	"github.com/openziti/ziti/controller/rest_client/service"
 	"github.com/openziti/ziti/controller/rest_client/terminator"
+	"github.com/openziti/ziti/controller/rest_client/usage"
 )
 
 // Default ziti fabric HTTP client.
@@ -86,6 +88,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *ZitiFabric
 
 	cli := new(ZitiFabric)
 	cli.Transport = transport
+	cli.Alert = alert.New(transport, formats)
 	cli.Circuit = circuit.New(transport, formats)
 	cli.Database = database.New(transport, formats)
 	cli.Inspect = inspect.New(transport, formats)
@@ -94,6 +97,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *ZitiFabric
 	cli.Router = router.New(transport, formats)
 	cli.Service = service.New(transport, formats)
 	cli.Terminator = terminator.New(transport, formats)
+	cli.Usage = usage.New(transport, formats)
 	return cli
 }
 
@@ -138,6 +142,8 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // ZitiFabric is a client for ziti fabric
 type ZitiFabric struct {
+	Alert alert.ClientService
+
 	Circuit circuit.ClientService
 
 	Database database.ClientService
@@ -154,12 +160,15 @@ type ZitiFabric struct {
 
 	Terminator terminator.ClientService
 
+	Usage usage.ClientService
+
 	Transport runtime.ClientTransport
 }
 
 // SetTransport changes the transport on the client and all its subresources
 func (c *ZitiFabric) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
+	c.Alert.SetTransport(transport)
 	c.Circuit.SetTransport(transport)
 	c.Database.SetTransport(transport)
 	c.Inspect.SetTransport(transport)
@@ -168,4 +177,5 @@ func (c *ZitiFabric) SetTransport(transport runtime.ClientTransport) {
 	c.Router.SetTransport(transport)
 	c.Service.SetTransport(transport)
 	c.Terminator.SetTransport(transport)
+	c.Usage.SetTransport(transport)
 }
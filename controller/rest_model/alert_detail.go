@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AlertDetail alert detail
+//
+// swagger:model alertDetail
+type AlertDetail struct {
+
+	// entity Id
+	// Required: true
+	EntityID *string `json:"entityId"`
+
+	// entity type
+	// Required: true
+	EntityType *string `json:"entityType"`
+
+	// fired at
+	// Required: true
+	// Format: date-time
+	FiredAt *strfmt.DateTime `json:"firedAt"`
+
+	// message
+	// Required: true
+	Message *string `json:"message"`
+
+	// rule Id
+	// Required: true
+	RuleID *string `json:"ruleId"`
+
+	// rule type
+	// Required: true
+	RuleType *string `json:"ruleType"`
+
+	// threshold
+	// Required: true
+	Threshold *float64 `json:"threshold"`
+
+	// value
+	// Required: true
+	Value *float64 `json:"value"`
+}
+
+// Validate validates this alert detail
+func (m *AlertDetail) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEntityID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEntityType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFiredAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuleID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuleType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateThreshold(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValue(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AlertDetail) validateEntityID(formats strfmt.Registry) error {
+
+	if err := validate.Required("entityId", "body", m.EntityID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertDetail) validateEntityType(formats strfmt.Registry) error {
+
+	if err := validate.Required("entityType", "body", m.EntityType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertDetail) validateFiredAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("firedAt", "body", m.FiredAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("firedAt", "body", "date-time", m.FiredAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertDetail) validateMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("message", "body", m.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertDetail) validateRuleID(formats strfmt.Registry) error {
+
+	if err := validate.Required("ruleId", "body", m.RuleID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertDetail) validateRuleType(formats strfmt.Registry) error {
+
+	if err := validate.Required("ruleType", "body", m.RuleType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertDetail) validateThreshold(formats strfmt.Registry) error {
+
+	if err := validate.Required("threshold", "body", m.Threshold); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertDetail) validateValue(formats strfmt.Registry) error {
+
+	if err := validate.Required("value", "body", m.Value); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this alert detail based on context it is used
+func (m *AlertDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AlertDetail) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AlertDetail) UnmarshalBinary(b []byte) error {
+	var res AlertDetail
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
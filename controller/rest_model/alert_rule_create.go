@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AlertRuleCreate alert rule create
+//
+// swagger:model alertRuleCreate
+type AlertRuleCreate struct {
+
+	// How long the condition must hold before the alert fires, as a duration such as 5m
+	For string `json:"for,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// The minimum number of circuits attempted in the window before a circuitFailureRate rule is evaluated
+	MinCircuits int64 `json:"minCircuits,omitempty"`
+
+	// The link p95 latency in milliseconds for linkLatency rules, or the failure percentage for circuitFailureRate rules
+	Threshold float64 `json:"threshold,omitempty"`
+
+	// type
+	// Required: true
+	// Enum: [routerOffline noHealthyTerminators linkLatency circuitFailureRate]
+	Type *string `json:"type"`
+
+	// The window circuit failure rates are computed over, as a duration such as 5m
+	Window string `json:"window,omitempty"`
+}
+
+// Validate validates this alert rule create
+func (m *AlertRuleCreate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var alertRuleCreateTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["routerOffline","noHealthyTerminators","linkLatency","circuitFailureRate"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		alertRuleCreateTypeTypePropEnum = append(alertRuleCreateTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// AlertRuleCreateTypeRouterOffline captures enum value "routerOffline"
+	AlertRuleCreateTypeRouterOffline string = "routerOffline"
+
+	// AlertRuleCreateTypeNoHealthyTerminators captures enum value "noHealthyTerminators"
+	AlertRuleCreateTypeNoHealthyTerminators string = "noHealthyTerminators"
+
+	// AlertRuleCreateTypeLinkLatency captures enum value "linkLatency"
+	AlertRuleCreateTypeLinkLatency string = "linkLatency"
+
+	// AlertRuleCreateTypeCircuitFailureRate captures enum value "circuitFailureRate"
+	AlertRuleCreateTypeCircuitFailureRate string = "circuitFailureRate"
+)
+
+// prop value enum
+func (m *AlertRuleCreate) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, alertRuleCreateTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AlertRuleCreate) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this alert rule create based on context it is used
+func (m *AlertRuleCreate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AlertRuleCreate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AlertRuleCreate) UnmarshalBinary(b []byte) error {
+	var res AlertRuleCreate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
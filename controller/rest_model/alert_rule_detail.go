@@ -0,0 +1,233 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AlertRuleDetail alert rule detail
+//
+// swagger:model alertRuleDetail
+type AlertRuleDetail struct {
+
+	// How long the condition must hold before the alert fires, as a duration such as 5m
+	// Required: true
+	For *string `json:"for"`
+
+	// id
+	// Required: true
+	ID *string `json:"id"`
+
+	// min circuits
+	// Required: true
+	MinCircuits *int64 `json:"minCircuits"`
+
+	// source
+	// Required: true
+	// Enum: [config api]
+	Source *string `json:"source"`
+
+	// threshold
+	// Required: true
+	Threshold *float64 `json:"threshold"`
+
+	// type
+	// Required: true
+	Type *string `json:"type"`
+
+	// The window circuit failure rates are computed over, as a duration such as 5m
+	// Required: true
+	Window *string `json:"window"`
+}
+
+// Validate validates this alert rule detail
+func (m *AlertRuleDetail) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinCircuits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateThreshold(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWindow(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AlertRuleDetail) validateFor(formats strfmt.Registry) error {
+
+	if err := validate.Required("for", "body", m.For); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertRuleDetail) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertRuleDetail) validateMinCircuits(formats strfmt.Registry) error {
+
+	if err := validate.Required("minCircuits", "body", m.MinCircuits); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var alertRuleDetailTypeSourcePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["config","api"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		alertRuleDetailTypeSourcePropEnum = append(alertRuleDetailTypeSourcePropEnum, v)
+	}
+}
+
+const (
+
+	// AlertRuleDetailSourceConfig captures enum value "config"
+	AlertRuleDetailSourceConfig string = "config"
+
+	// AlertRuleDetailSourceAPI captures enum value "api"
+	AlertRuleDetailSourceAPI string = "api"
+)
+
+// prop value enum
+func (m *AlertRuleDetail) validateSourceEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, alertRuleDetailTypeSourcePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AlertRuleDetail) validateSource(formats strfmt.Registry) error {
+
+	if err := validate.Required("source", "body", m.Source); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateSourceEnum("source", "body", *m.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertRuleDetail) validateThreshold(formats strfmt.Registry) error {
+
+	if err := validate.Required("threshold", "body", m.Threshold); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertRuleDetail) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AlertRuleDetail) validateWindow(formats strfmt.Registry) error {
+
+	if err := validate.Required("window", "body", m.Window); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this alert rule detail based on context it is used
+func (m *AlertRuleDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AlertRuleDetail) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AlertRuleDetail) UnmarshalBinary(b []byte) error {
+	var res AlertRuleDetail
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
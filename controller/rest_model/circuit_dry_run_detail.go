@@ -0,0 +1,205 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CircuitDryRunDetail circuit dry run detail
+//
+// swagger:model circuitDryRunDetail
+type CircuitDryRunDetail struct {
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// identity Id
+	IdentityID string `json:"identityId,omitempty"`
+
+	// ingresses
+	// Required: true
+	Ingresses []*CircuitDryRunIngress `json:"ingresses"`
+
+	// service Id
+	// Required: true
+	ServiceID *string `json:"serviceId"`
+
+	// service name
+	// Required: true
+	ServiceName *string `json:"serviceName"`
+
+	// strategy
+	// Required: true
+	Strategy *string `json:"strategy"`
+}
+
+// Validate validates this circuit dry run detail
+func (m *CircuitDryRunDetail) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateIngresses(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStrategy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CircuitDryRunDetail) validateIngresses(formats strfmt.Registry) error {
+
+	if err := validate.Required("ingresses", "body", m.Ingresses); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Ingresses); i++ {
+		if swag.IsZero(m.Ingresses[i]) { // not required
+			continue
+		}
+
+		if m.Ingresses[i] != nil {
+			if err := m.Ingresses[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ingresses" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ingresses" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunDetail) validateServiceID(formats strfmt.Registry) error {
+
+	if err := validate.Required("serviceId", "body", m.ServiceID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunDetail) validateServiceName(formats strfmt.Registry) error {
+
+	if err := validate.Required("serviceName", "body", m.ServiceName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunDetail) validateStrategy(formats strfmt.Registry) error {
+
+	if err := validate.Required("strategy", "body", m.Strategy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this circuit dry run detail based on the context it is used
+func (m *CircuitDryRunDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateIngresses(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CircuitDryRunDetail) contextValidateIngresses(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Ingresses); i++ {
+
+		if m.Ingresses[i] != nil {
+
+			if swag.IsZero(m.Ingresses[i]) { // not required
+				return nil
+			}
+
+			if err := m.Ingresses[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ingresses" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ingresses" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CircuitDryRunDetail) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CircuitDryRunDetail) UnmarshalBinary(b []byte) error {
+	var res CircuitDryRunDetail
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
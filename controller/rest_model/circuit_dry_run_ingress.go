@@ -0,0 +1,236 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CircuitDryRunIngress circuit dry run ingress
+//
+// swagger:model circuitDryRunIngress
+type CircuitDryRunIngress struct {
+
+	// Why a circuit couldn't be created through this router
+	Error string `json:"error,omitempty"`
+
+	// path
+	Path []*CircuitDryRunHop `json:"path"`
+
+	// path cost
+	PathCost int64 `json:"pathCost,omitempty"`
+
+	// router Id
+	// Required: true
+	RouterID *string `json:"routerId"`
+
+	// The terminator selected by the service's strategy
+	TerminatorID string `json:"terminatorId,omitempty"`
+
+	// terminators
+	// Required: true
+	Terminators []*CircuitDryRunTerminator `json:"terminators"`
+}
+
+// Validate validates this circuit dry run ingress
+func (m *CircuitDryRunIngress) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRouterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTerminators(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CircuitDryRunIngress) validatePath(formats strfmt.Registry) error {
+	if swag.IsZero(m.Path) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Path); i++ {
+		if swag.IsZero(m.Path[i]) { // not required
+			continue
+		}
+
+		if m.Path[i] != nil {
+			if err := m.Path[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("path" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("path" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunIngress) validateRouterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("routerId", "body", m.RouterID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunIngress) validateTerminators(formats strfmt.Registry) error {
+
+	if err := validate.Required("terminators", "body", m.Terminators); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Terminators); i++ {
+		if swag.IsZero(m.Terminators[i]) { // not required
+			continue
+		}
+
+		if m.Terminators[i] != nil {
+			if err := m.Terminators[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("terminators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("terminators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this circuit dry run ingress based on the context it is used
+func (m *CircuitDryRunIngress) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePath(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTerminators(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CircuitDryRunIngress) contextValidatePath(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Path); i++ {
+
+		if m.Path[i] != nil {
+
+			if swag.IsZero(m.Path[i]) { // not required
+				return nil
+			}
+
+			if err := m.Path[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("path" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("path" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunIngress) contextValidateTerminators(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Terminators); i++ {
+
+		if m.Terminators[i] != nil {
+
+			if swag.IsZero(m.Terminators[i]) { // not required
+				return nil
+			}
+
+			if err := m.Terminators[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("terminators" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("terminators" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CircuitDryRunIngress) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CircuitDryRunIngress) UnmarshalBinary(b []byte) error {
+	var res CircuitDryRunIngress
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,226 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CircuitDryRunTerminator circuit dry run terminator
+//
+// swagger:model circuitDryRunTerminator
+type CircuitDryRunTerminator struct {
+
+	// address
+	// Required: true
+	Address *string `json:"address"`
+
+	// binding
+	// Required: true
+	Binding *string `json:"binding"`
+
+	// dynamic cost
+	// Required: true
+	DynamicCost *int64 `json:"dynamicCost"`
+
+	// id
+	// Required: true
+	ID *string `json:"id"`
+
+	// instance Id
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// path cost
+	PathCost int64 `json:"pathCost,omitempty"`
+
+	// precedence
+	// Required: true
+	Precedence *string `json:"precedence"`
+
+	// Why the terminator wasn't selected
+	Rejected string `json:"rejected,omitempty"`
+
+	// The cost used to compare terminators, including the path cost and biased by precedence
+	RouteCost int64 `json:"routeCost,omitempty"`
+
+	// router Id
+	// Required: true
+	RouterID *string `json:"routerId"`
+
+	// selected
+	// Required: true
+	Selected *bool `json:"selected"`
+
+	// static cost
+	// Required: true
+	StaticCost *int64 `json:"staticCost"`
+}
+
+// Validate validates this circuit dry run terminator
+func (m *CircuitDryRunTerminator) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateBinding(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDynamicCost(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrecedence(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRouterID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSelected(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStaticCost(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CircuitDryRunTerminator) validateAddress(formats strfmt.Registry) error {
+
+	if err := validate.Required("address", "body", m.Address); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunTerminator) validateBinding(formats strfmt.Registry) error {
+
+	if err := validate.Required("binding", "body", m.Binding); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunTerminator) validateDynamicCost(formats strfmt.Registry) error {
+
+	if err := validate.Required("dynamicCost", "body", m.DynamicCost); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunTerminator) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunTerminator) validatePrecedence(formats strfmt.Registry) error {
+
+	if err := validate.Required("precedence", "body", m.Precedence); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunTerminator) validateRouterID(formats strfmt.Registry) error {
+
+	if err := validate.Required("routerId", "body", m.RouterID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunTerminator) validateSelected(formats strfmt.Registry) error {
+
+	if err := validate.Required("selected", "body", m.Selected); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CircuitDryRunTerminator) validateStaticCost(formats strfmt.Registry) error {
+
+	if err := validate.Required("staticCost", "body", m.StaticCost); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this circuit dry run terminator based on context it is used
+func (m *CircuitDryRunTerminator) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CircuitDryRunTerminator) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CircuitDryRunTerminator) UnmarshalBinary(b []byte) error {
+	var res CircuitDryRunTerminator
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -43,6 +43,16 @@ import (
 // swagger:model serviceCreate
 type ServiceCreate struct {
 
+	// avoid routers
+	AvoidRouters RouterSelectors `json:"avoidRouters,omitempty"`
+
+	// egress routers
+	EgressRouters RouterSelectors `json:"egressRouters,omitempty"`
+
+	// max hops
+	// Minimum: 0
+	MaxHops *int64 `json:"maxHops,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
@@ -58,6 +68,18 @@ type ServiceCreate struct {
 func (m *ServiceCreate) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAvoidRouters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEgressRouters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxHops(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -72,6 +94,52 @@ func (m *ServiceCreate) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceCreate) validateAvoidRouters(formats strfmt.Registry) error {
+	if swag.IsZero(m.AvoidRouters) { // not required
+		return nil
+	}
+
+	if err := m.AvoidRouters.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("avoidRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("avoidRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceCreate) validateEgressRouters(formats strfmt.Registry) error {
+	if swag.IsZero(m.EgressRouters) { // not required
+		return nil
+	}
+
+	if err := m.EgressRouters.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("egressRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("egressRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceCreate) validateMaxHops(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxHops) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("maxHops", "body", *m.MaxHops, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServiceCreate) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -104,6 +172,14 @@ func (m *ServiceCreate) validateTags(formats strfmt.Registry) error {
 func (m *ServiceCreate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateAvoidRouters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateEgressRouters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -114,6 +190,34 @@ func (m *ServiceCreate) ContextValidate(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *ServiceCreate) contextValidateAvoidRouters(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.AvoidRouters.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("avoidRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("avoidRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceCreate) contextValidateEgressRouters(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.EgressRouters.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("egressRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("egressRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServiceCreate) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Tags != nil {
@@ -44,6 +44,15 @@ import (
 type ServiceDetail struct {
 	BaseEntity
 
+	// avoid routers
+	AvoidRouters RouterSelectors `json:"avoidRouters,omitempty"`
+
+	// egress routers
+	EgressRouters RouterSelectors `json:"egressRouters,omitempty"`
+
+	// max hops
+	MaxHops int64 `json:"maxHops,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
@@ -64,6 +73,12 @@ func (m *ServiceDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		AvoidRouters RouterSelectors `json:"avoidRouters,omitempty"`
+
+		EgressRouters RouterSelectors `json:"egressRouters,omitempty"`
+
+		MaxHops int64 `json:"maxHops,omitempty"`
+
 		Name *string `json:"name"`
 
 		TerminatorStrategy *string `json:"terminatorStrategy"`
@@ -72,6 +87,12 @@ func (m *ServiceDetail) UnmarshalJSON(raw []byte) error {
 		return err
 	}
 
+	m.AvoidRouters = dataAO1.AvoidRouters
+
+	m.EgressRouters = dataAO1.EgressRouters
+
+	m.MaxHops = dataAO1.MaxHops
+
 	m.Name = dataAO1.Name
 
 	m.TerminatorStrategy = dataAO1.TerminatorStrategy
@@ -89,11 +110,23 @@ func (m ServiceDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		AvoidRouters RouterSelectors `json:"avoidRouters,omitempty"`
+
+		EgressRouters RouterSelectors `json:"egressRouters,omitempty"`
+
+		MaxHops int64 `json:"maxHops,omitempty"`
+
 		Name *string `json:"name"`
 
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 
+	dataAO1.AvoidRouters = m.AvoidRouters
+
+	dataAO1.EgressRouters = m.EgressRouters
+
+	dataAO1.MaxHops = m.MaxHops
+
 	dataAO1.Name = m.Name
 
 	dataAO1.TerminatorStrategy = m.TerminatorStrategy
@@ -115,6 +148,14 @@ func (m *ServiceDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAvoidRouters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEgressRouters(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -129,6 +170,42 @@ func (m *ServiceDetail) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceDetail) validateAvoidRouters(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AvoidRouters) { // not required
+		return nil
+	}
+
+	if err := m.AvoidRouters.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("avoidRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("avoidRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) validateEgressRouters(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EgressRouters) { // not required
+		return nil
+	}
+
+	if err := m.EgressRouters.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("egressRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("egressRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServiceDetail) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -156,12 +233,48 @@ func (m *ServiceDetail) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateAvoidRouters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateEgressRouters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *ServiceDetail) contextValidateAvoidRouters(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.AvoidRouters.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("avoidRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("avoidRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidateEgressRouters(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.EgressRouters.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("egressRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("egressRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ServiceDetail) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -35,6 +35,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ServicePatch service patch
@@ -42,6 +43,16 @@ import (
 // swagger:model servicePatch
 type ServicePatch struct {
 
+	// avoid routers
+	AvoidRouters RouterSelectors `json:"avoidRouters,omitempty"`
+
+	// egress routers
+	EgressRouters RouterSelectors `json:"egressRouters,omitempty"`
+
+	// max hops
+	// Minimum: 0
+	MaxHops *int64 `json:"maxHops,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -56,6 +67,18 @@ type ServicePatch struct {
 func (m *ServicePatch) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAvoidRouters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEgressRouters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxHops(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTags(formats); err != nil {
 		res = append(res, err)
 	}
@@ -66,6 +89,52 @@ func (m *ServicePatch) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServicePatch) validateAvoidRouters(formats strfmt.Registry) error {
+	if swag.IsZero(m.AvoidRouters) { // not required
+		return nil
+	}
+
+	if err := m.AvoidRouters.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("avoidRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("avoidRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) validateEgressRouters(formats strfmt.Registry) error {
+	if swag.IsZero(m.EgressRouters) { // not required
+		return nil
+	}
+
+	if err := m.EgressRouters.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("egressRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("egressRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) validateMaxHops(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxHops) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("maxHops", "body", *m.MaxHops, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServicePatch) validateTags(formats strfmt.Registry) error {
 	if swag.IsZero(m.Tags) { // not required
 		return nil
@@ -89,6 +158,14 @@ func (m *ServicePatch) validateTags(formats strfmt.Registry) error {
 func (m *ServicePatch) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateAvoidRouters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateEgressRouters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -99,6 +176,34 @@ func (m *ServicePatch) ContextValidate(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *ServicePatch) contextValidateAvoidRouters(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.AvoidRouters.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("avoidRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("avoidRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServicePatch) contextValidateEgressRouters(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.EgressRouters.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("egressRouters")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("egressRouters")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServicePatch) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Tags != nil {
@@ -56,6 +56,10 @@ type TerminatorDetail struct {
 	// Required: true
 	Cost *TerminatorCost `json:"cost"`
 
+	// When a draining terminator will be removed, even if it still has circuits
+	// Format: date-time
+	DrainDeadline strfmt.DateTime `json:"drainDeadline,omitempty"`
+
 	// dynamic cost
 	// Required: true
 	DynamicCost *TerminatorCost `json:"dynamicCost"`
@@ -106,6 +110,8 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 		Cost *TerminatorCost `json:"cost"`
 
+		DrainDeadline strfmt.DateTime `json:"drainDeadline,omitempty"`
+
 		DynamicCost *TerminatorCost `json:"dynamicCost"`
 
 		HostID *string `json:"hostId"`
@@ -132,6 +138,8 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 	m.Cost = dataAO1.Cost
 
+	m.DrainDeadline = dataAO1.DrainDeadline
+
 	m.DynamicCost = dataAO1.DynamicCost
 
 	m.HostID = dataAO1.HostID
@@ -167,6 +175,8 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 		Cost *TerminatorCost `json:"cost"`
 
+		DrainDeadline strfmt.DateTime `json:"drainDeadline,omitempty"`
+
 		DynamicCost *TerminatorCost `json:"dynamicCost"`
 
 		HostID *string `json:"hostId"`
@@ -190,6 +200,8 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.Cost = m.Cost
 
+	dataAO1.DrainDeadline = m.DrainDeadline
+
 	dataAO1.DynamicCost = m.DynamicCost
 
 	dataAO1.HostID = m.HostID
@@ -235,6 +247,10 @@ func (m *TerminatorDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDrainDeadline(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDynamicCost(formats); err != nil {
 		res = append(res, err)
 	}
@@ -315,6 +331,19 @@ func (m *TerminatorDetail) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateDrainDeadline(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DrainDeadline) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("drainDeadline", "body", "date-time", m.DrainDeadline.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *TerminatorDetail) validateDynamicCost(formats strfmt.Registry) error {
 
 	if err := validate.Required("dynamicCost", "body", m.DynamicCost); err != nil {
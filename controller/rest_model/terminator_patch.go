@@ -35,6 +35,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // TerminatorPatch terminator patch
@@ -51,6 +52,10 @@ type TerminatorPatch struct {
 	// cost
 	Cost *TerminatorCost `json:"cost,omitempty"`
 
+	// When setting the precedence to draining, how long the terminator may keep existing circuits before it is removed. Uses the controller default if not set
+	// Minimum: 0
+	DrainTimeoutSeconds *int64 `json:"drainTimeoutSeconds,omitempty"`
+
 	// host Id
 	HostID string `json:"hostId,omitempty"`
 
@@ -75,6 +80,10 @@ func (m *TerminatorPatch) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDrainTimeoutSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
@@ -108,6 +117,18 @@ func (m *TerminatorPatch) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorPatch) validateDrainTimeoutSeconds(formats strfmt.Registry) error {
+	if swag.IsZero(m.DrainTimeoutSeconds) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("drainTimeoutSeconds", "body", *m.DrainTimeoutSeconds, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *TerminatorPatch) validatePrecedence(formats strfmt.Registry) error {
 	if swag.IsZero(m.Precedence) { // not required
 		return nil
@@ -62,6 +62,9 @@ const (
 
 	// TerminatorPrecedenceFailed captures enum value "failed"
 	TerminatorPrecedenceFailed TerminatorPrecedence = "failed"
+
+	// TerminatorPrecedenceDraining captures enum value "draining"
+	TerminatorPrecedenceDraining TerminatorPrecedence = "draining"
 )
 
 // for schema
@@ -69,7 +72,7 @@ var terminatorPrecedenceEnum []interface{}
 
 func init() {
 	var res []TerminatorPrecedence
-	if err := json.Unmarshal([]byte(`["default","required","failed"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["default","required","failed","draining"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -54,6 +54,10 @@ type TerminatorUpdate struct {
 	// cost
 	Cost *TerminatorCost `json:"cost,omitempty"`
 
+	// When setting the precedence to draining, how long the terminator may keep existing circuits before it is removed. Uses the controller default if not set
+	// Minimum: 0
+	DrainTimeoutSeconds *int64 `json:"drainTimeoutSeconds,omitempty"`
+
 	// host Id
 	HostID string `json:"hostId,omitempty"`
 
@@ -88,6 +92,10 @@ func (m *TerminatorUpdate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDrainTimeoutSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePrecedence(formats); err != nil {
 		res = append(res, err)
 	}
@@ -147,6 +155,18 @@ func (m *TerminatorUpdate) validateCost(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorUpdate) validateDrainTimeoutSeconds(formats strfmt.Registry) error {
+	if swag.IsZero(m.DrainTimeoutSeconds) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("drainTimeoutSeconds", "body", *m.DrainTimeoutSeconds, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *TerminatorUpdate) validatePrecedence(formats strfmt.Registry) error {
 	if swag.IsZero(m.Precedence) { // not required
 		return nil
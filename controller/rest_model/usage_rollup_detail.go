@@ -0,0 +1,270 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package rest_model
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// UsageRollupDetail usage rollup detail
+//
+// swagger:model usageRollupDetail
+type UsageRollupDetail struct {
+
+	// circuits
+	// Required: true
+	Circuits *int64 `json:"circuits"`
+
+	// egress rx
+	// Required: true
+	EgressRx *int64 `json:"egressRx"`
+
+	// egress tx
+	// Required: true
+	EgressTx *int64 `json:"egressTx"`
+
+	// entity Id
+	// Required: true
+	EntityID *string `json:"entityId"`
+
+	// fabric rx
+	// Required: true
+	FabricRx *int64 `json:"fabricRx"`
+
+	// fabric tx
+	// Required: true
+	FabricTx *int64 `json:"fabricTx"`
+
+	// group by
+	// Required: true
+	GroupBy *string `json:"groupBy"`
+
+	// ingress rx
+	// Required: true
+	IngressRx *int64 `json:"ingressRx"`
+
+	// ingress tx
+	// Required: true
+	IngressTx *int64 `json:"ingressTx"`
+
+	// interval
+	// Required: true
+	Interval *string `json:"interval"`
+
+	// interval start
+	// Required: true
+	// Format: date-time
+	IntervalStart *strfmt.DateTime `json:"intervalStart"`
+}
+
+// Validate validates this usage rollup detail
+func (m *UsageRollupDetail) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCircuits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEgressRx(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEgressTx(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEntityID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFabricRx(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFabricTx(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGroupBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIngressRx(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIngressTx(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInterval(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIntervalStart(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UsageRollupDetail) validateCircuits(formats strfmt.Registry) error {
+
+	if err := validate.Required("circuits", "body", m.Circuits); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateEgressRx(formats strfmt.Registry) error {
+
+	if err := validate.Required("egressRx", "body", m.EgressRx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateEgressTx(formats strfmt.Registry) error {
+
+	if err := validate.Required("egressTx", "body", m.EgressTx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateEntityID(formats strfmt.Registry) error {
+
+	if err := validate.Required("entityId", "body", m.EntityID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateFabricRx(formats strfmt.Registry) error {
+
+	if err := validate.Required("fabricRx", "body", m.FabricRx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateFabricTx(formats strfmt.Registry) error {
+
+	if err := validate.Required("fabricTx", "body", m.FabricTx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateGroupBy(formats strfmt.Registry) error {
+
+	if err := validate.Required("groupBy", "body", m.GroupBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateIngressRx(formats strfmt.Registry) error {
+
+	if err := validate.Required("ingressRx", "body", m.IngressRx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateIngressTx(formats strfmt.Registry) error {
+
+	if err := validate.Required("ingressTx", "body", m.IngressTx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateInterval(formats strfmt.Registry) error {
+
+	if err := validate.Required("interval", "body", m.Interval); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UsageRollupDetail) validateIntervalStart(formats strfmt.Registry) error {
+
+	if err := validate.Required("intervalStart", "body", m.IntervalStart); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("intervalStart", "body", "date-time", m.IntervalStart.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this usage rollup detail based on context it is used
+func (m *UsageRollupDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UsageRollupDetail) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UsageRollupDetail) UnmarshalBinary(b []byte) error {
+	var res UsageRollupDetail
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
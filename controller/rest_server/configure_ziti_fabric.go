@@ -35,6 +35,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 
 	"github.com/openziti/ziti/controller/rest_server/operations"
+	"github.com/openziti/ziti/controller/rest_server/operations/alert"
 	"github.com/openziti/ziti/controller/rest_server/operations/circuit"
 	"github.com/openziti/ziti/controller/rest_server/operations/database"
 	"github.com/openziti/ziti/controller/rest_server/operations/inspect"
@@ -43,6 +44,7 @@ import (
 	"github.com/openziti/ziti/controller/rest_server/operations/router"
 	"github.com/openziti/ziti/controller/rest_server/operations/service"
 	"github.com/openziti/ziti/controller/rest_server/operations/terminator"
+	"github.com/openziti/ziti/controller/rest_server/operations/usage"
 )
 
 //go:generate swagger generate server --target ../../controller --name ZitiFabric --spec ../specs/swagger.yml --model-package rest_model --server-package rest_server --principal interface{} --exclude-main
@@ -74,6 +76,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation database.CheckDataIntegrity has not yet been implemented")
 		})
 	}
+	if api.AlertCreateAlertRuleHandler == nil {
+		api.AlertCreateAlertRuleHandler = alert.CreateAlertRuleHandlerFunc(func(params alert.CreateAlertRuleParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.CreateAlertRule has not yet been implemented")
+		})
+	}
 	if api.DatabaseCreateDatabaseSnapshotHandler == nil {
 		api.DatabaseCreateDatabaseSnapshotHandler = database.CreateDatabaseSnapshotHandlerFunc(func(params database.CreateDatabaseSnapshotParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshot has not yet been implemented")
@@ -104,6 +111,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation database.DataIntegrityResults has not yet been implemented")
 		})
 	}
+	if api.AlertDeleteAlertRuleHandler == nil {
+		api.AlertDeleteAlertRuleHandler = alert.DeleteAlertRuleHandlerFunc(func(params alert.DeleteAlertRuleParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.DeleteAlertRule has not yet been implemented")
+		})
+	}
 	if api.CircuitDeleteCircuitHandler == nil {
 		api.CircuitDeleteCircuitHandler = circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.DeleteCircuit has not yet been implemented")
@@ -164,6 +176,16 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		})
 	}
+	if api.AlertListAlertRulesHandler == nil {
+		api.AlertListAlertRulesHandler = alert.ListAlertRulesHandlerFunc(func(params alert.ListAlertRulesParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlertRules has not yet been implemented")
+		})
+	}
+	if api.AlertListAlertsHandler == nil {
+		api.AlertListAlertsHandler = alert.ListAlertsHandlerFunc(func(params alert.ListAlertsParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlerts has not yet been implemented")
+		})
+	}
 	if api.CircuitListCircuitsHandler == nil {
 		api.CircuitListCircuitsHandler = circuit.ListCircuitsHandlerFunc(func(params circuit.ListCircuitsParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.ListCircuits has not yet been implemented")
@@ -199,6 +221,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.ListTerminators has not yet been implemented")
 		})
 	}
+	if api.UsageListUsageHandler == nil {
+		api.UsageListUsageHandler = usage.ListUsageHandlerFunc(func(params usage.ListUsageParams) middleware.Responder {
+			return middleware.NotImplemented("operation usage.ListUsage has not yet been implemented")
+		})
+	}
 	if api.LinkPatchLinkHandler == nil {
 		api.LinkPatchLinkHandler = link.PatchLinkHandlerFunc(func(params link.PatchLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.PatchLink has not yet been implemented")
@@ -69,6 +69,151 @@ func init() {
   "host": "demo.ziti.dev",
   "basePath": "/fabric/v1",
   "paths": {
+    "/alert-rules": {
+      "get": {
+        "description": "Retrieves the alert rules, including rules from the controller configuration. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "List alert rules",
+        "operationId": "listAlertRules",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listAlertRules"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "Create an alert rule. Rules created through the API are persisted and replicated to every controller in a\ncluster. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "Create an alert rule",
+        "operationId": "createAlertRule",
+        "parameters": [
+          {
+            "description": "An alert rule to create",
+            "name": "alertRule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRuleCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "$ref": "#/responses/createResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
+    "/alert-rules/{id}": {
+      "delete": {
+        "description": "Delete an alert rule by id. Rules from the controller configuration can't be deleted. Any alerts firing for\nthe rule are resolved. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "Delete an alert rule",
+        "operationId": "deleteAlertRule",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
+    "/alerts": {
+      "get": {
+        "description": "Retrieves the alerts which are currently firing. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "List firing alerts",
+        "operationId": "listAlerts",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listAlerts"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
+    "/circuit-dry-run": {
+      "post": {
+        "description": "Runs terminator and path selection for a dial of the given service, without creating a circuit. Returns the\ncandidate terminators with their costs, the terminator the service's strategy selects, the path with per hop\ncosts, and why other terminators were rejected. If no ingress router is given, every edge router the identity\ncan use to reach the service is evaluated. Requires admin access.\n",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Evaluate circuit creation without creating a circuit",
+        "operationId": "dryRunCircuit",
+        "parameters": [
+          {
+            "description": "The dial to evaluate",
+            "name": "request",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/circuitDryRunRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/circuitDryRun"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
+    },
     "/circuits": {
       "get": {
         "description": "Retrieves a list of circuit resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
@@ -1242,9 +1387,207 @@ func init() {
           "$ref": "#/parameters/id"
         }
       ]
+    },
+    "/usage": {
+      "get": {
+        "description": "Retrieves hourly or daily usage totals per identity, service or router. Usage rollups must be enabled in the\ncontroller configuration. Requires admin access.\n",
+        "tags": [
+          "Usage"
+        ],
+        "summary": "List usage rollups",
+        "operationId": "listUsage",
+        "parameters": [
+          {
+            "enum": [
+              "hourly",
+              "daily"
+            ],
+            "type": "string",
+            "default": "hourly",
+            "description": "The rollup interval",
+            "name": "interval",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "identity",
+              "service",
+              "router"
+            ],
+            "type": "string",
+            "default": "service",
+            "description": "The entity type usage is grouped by",
+            "name": "groupBy",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return usage for the given identity, service or router id",
+            "name": "entityId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return intervals which end after the given time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return intervals which start before the given time",
+            "name": "until",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listUsage"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
+          "429": {
+            "$ref": "#/responses/rateLimitedResponse"
+          }
+        }
+      }
     }
   },
   "definitions": {
+    "alertDetail": {
+      "type": "object",
+      "required": [
+        "ruleId",
+        "ruleType",
+        "entityType",
+        "entityId",
+        "value",
+        "threshold",
+        "firedAt",
+        "message"
+      ],
+      "properties": {
+        "entityId": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "firedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "message": {
+          "type": "string"
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "ruleType": {
+          "type": "string"
+        },
+        "threshold": {
+          "type": "number"
+        },
+        "value": {
+          "type": "number"
+        }
+      }
+    },
+    "alertList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertDetail"
+      }
+    },
+    "alertRuleCreate": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "for": {
+          "description": "How long the condition must hold before the alert fires, as a duration such as 5m",
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "minCircuits": {
+          "description": "The minimum number of circuits attempted in the window before a circuitFailureRate rule is evaluated",
+          "type": "integer"
+        },
+        "threshold": {
+          "description": "The link p95 latency in milliseconds for linkLatency rules, or the failure percentage for circuitFailureRate rules",
+          "type": "number"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "routerOffline",
+            "noHealthyTerminators",
+            "linkLatency",
+            "circuitFailureRate"
+          ]
+        },
+        "window": {
+          "description": "The window circuit failure rates are computed over, as a duration such as 5m",
+          "type": "string"
+        }
+      }
+    },
+    "alertRuleDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "for",
+        "threshold",
+        "window",
+        "minCircuits",
+        "source"
+      ],
+      "properties": {
+        "for": {
+          "description": "How long the condition must hold before the alert fires, as a duration such as 5m",
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "minCircuits": {
+          "type": "integer"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "config",
+            "api"
+          ]
+        },
+        "threshold": {
+          "type": "number"
+        },
+        "type": {
+          "type": "string"
+        },
+        "window": {
+          "description": "The window circuit failure rates are computed over, as a duration such as 5m",
+          "type": "string"
+        }
+      }
+    },
+    "alertRuleList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertRuleDetail"
+      }
+    },
     "apiError": {
       "type": "object",
       "properties": {
@@ -1389,24 +1732,205 @@ func init() {
         }
       ]
     },
-    "circuitList": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/circuitDetail"
+    "circuitDryRunDetail": {
+      "type": "object",
+      "required": [
+        "serviceId",
+        "serviceName",
+        "strategy",
+        "ingresses"
+      ],
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "identityId": {
+          "type": "string"
+        },
+        "ingresses": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitDryRunIngress"
+          }
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "serviceName": {
+          "type": "string"
+        },
+        "strategy": {
+          "type": "string"
+        }
       }
     },
-    "createEnvelope": {
+    "circuitDryRunEnvelope": {
       "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/createLocation"
+          "$ref": "#/definitions/circuitDryRunDetail"
         },
         "meta": {
           "$ref": "#/definitions/meta"
         }
       }
     },
-    "createLocation": {
+    "circuitDryRunHop": {
+      "type": "object",
+      "required": [
+        "routerId",
+        "routerCost"
+      ],
+      "properties": {
+        "linkCost": {
+          "type": "integer"
+        },
+        "linkId": {
+          "description": "The link to the next router on the path",
+          "type": "string"
+        },
+        "routerCost": {
+          "type": "integer"
+        },
+        "routerId": {
+          "type": "string"
+        }
+      }
+    },
+    "circuitDryRunIngress": {
+      "type": "object",
+      "required": [
+        "routerId",
+        "terminators"
+      ],
+      "properties": {
+        "error": {
+          "description": "Why a circuit couldn't be created through this router",
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitDryRunHop"
+          }
+        },
+        "pathCost": {
+          "type": "integer"
+        },
+        "routerId": {
+          "type": "string"
+        },
+        "terminatorId": {
+          "description": "The terminator selected by the service's strategy",
+          "type": "string"
+        },
+        "terminators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitDryRunTerminator"
+          }
+        }
+      }
+    },
+    "circuitDryRunRequest": {
+      "type": "object",
+      "required": [
+        "service"
+      ],
+      "properties": {
+        "identityId": {
+          "description": "The id of the dialing identity",
+          "type": "string"
+        },
+        "instanceId": {
+          "description": "The instance id to dial, for addressable terminators",
+          "type": "string"
+        },
+        "routerId": {
+          "description": "The id of the ingress router. If not set, all edge routers usable by the identity are evaluated",
+          "type": "string"
+        },
+        "service": {
+          "description": "The id or name of the service to dial",
+          "type": "string"
+        }
+      }
+    },
+    "circuitDryRunTerminator": {
+      "type": "object",
+      "required": [
+        "id",
+        "routerId",
+        "binding",
+        "address",
+        "precedence",
+        "staticCost",
+        "dynamicCost",
+        "selected"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "binding": {
+          "type": "string"
+        },
+        "dynamicCost": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "pathCost": {
+          "type": "integer"
+        },
+        "precedence": {
+          "type": "string"
+        },
+        "rejected": {
+          "description": "Why the terminator wasn't selected",
+          "type": "string"
+        },
+        "routeCost": {
+          "description": "The cost used to compare terminators, including the path cost and biased by precedence",
+          "type": "integer"
+        },
+        "routerId": {
+          "type": "string"
+        },
+        "selected": {
+          "type": "boolean"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
+    "circuitList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/circuitDetail"
+      }
+    },
+    "createEnvelope": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/createLocation"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "createLocation": {
       "type": "object",
       "properties": {
         "_links": {
@@ -1793,6 +2317,36 @@ func init() {
       },
       "x-omitempty": false
     },
+    "listAlertRulesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertRuleList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listAlertsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listCircuitsEnvelope": {
       "type": "object",
       "required": [
@@ -1868,6 +2422,21 @@ func init() {
         }
       }
     },
+    "listUsageEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/usageRollupList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "meta": {
       "type": "object",
       "properties": {
@@ -2140,6 +2709,13 @@ func init() {
         }
       }
     },
+    "routerSelectors": {
+      "description": "Routers to match, given as #attribute for edge router role attributes, @id for a router id or key=value for a router tag",
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
     "routerUpdate": {
       "type": "object",
       "required": [
@@ -2177,6 +2753,16 @@ func init() {
         "name"
       ],
       "properties": {
+        "avoidRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "egressRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "maxHops": {
+          "type": "integer",
+          "x-nullable": true
+        },
         "name": {
           "type": "string"
         },
@@ -2201,6 +2787,15 @@ func init() {
             "terminatorStrategy"
           ],
           "properties": {
+            "avoidRouters": {
+              "$ref": "#/definitions/routerSelectors"
+            },
+            "egressRouters": {
+              "$ref": "#/definitions/routerSelectors"
+            },
+            "maxHops": {
+              "type": "integer"
+            },
             "name": {
               "type": "string"
             },
@@ -2220,6 +2815,16 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
+        "avoidRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "egressRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "maxHops": {
+          "type": "integer",
+          "x-nullable": true
+        },
         "name": {
           "type": "string"
         },
@@ -2237,6 +2842,16 @@ func init() {
         "name"
       ],
       "properties": {
+        "avoidRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "egressRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "maxHops": {
+          "type": "integer",
+          "x-nullable": true
+        },
         "name": {
           "type": "string"
         },
@@ -2346,6 +2961,11 @@ func init() {
             "cost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "drainDeadline": {
+              "description": "When a draining terminator will be removed, even if it still has circuits",
+              "type": "string",
+              "format": "date-time"
+            },
             "dynamicCost": {
               "$ref": "#/definitions/terminatorCost"
             },
@@ -2392,6 +3012,10 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "drainTimeoutSeconds": {
+          "description": "When setting the precedence to draining, how long the terminator may keep existing circuits before it is removed. Uses the controller default if not set",
+          "type": "integer"
+        },
         "hostId": {
           "type": "string"
         },
@@ -2414,7 +3038,8 @@ func init() {
       "enum": [
         "default",
         "required",
-        "failed"
+        "failed",
+        "draining"
       ]
     },
     "terminatorPrecedenceMap": {
@@ -2441,6 +3066,10 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "drainTimeoutSeconds": {
+          "description": "When setting the precedence to draining, how long the terminator may keep existing circuits before it is removed. Uses the controller default if not set",
+          "type": "integer"
+        },
         "hostId": {
           "type": "string"
         },
@@ -2458,6 +3087,64 @@ func init() {
         }
       }
     },
+    "usageRollupDetail": {
+      "type": "object",
+      "required": [
+        "interval",
+        "groupBy",
+        "entityId",
+        "intervalStart",
+        "ingressRx",
+        "ingressTx",
+        "egressRx",
+        "egressTx",
+        "fabricRx",
+        "fabricTx",
+        "circuits"
+      ],
+      "properties": {
+        "circuits": {
+          "type": "integer"
+        },
+        "egressRx": {
+          "type": "integer"
+        },
+        "egressTx": {
+          "type": "integer"
+        },
+        "entityId": {
+          "type": "string"
+        },
+        "fabricRx": {
+          "type": "integer"
+        },
+        "fabricTx": {
+          "type": "integer"
+        },
+        "groupBy": {
+          "type": "string"
+        },
+        "ingressRx": {
+          "type": "integer"
+        },
+        "ingressTx": {
+          "type": "integer"
+        },
+        "interval": {
+          "type": "string"
+        },
+        "intervalStart": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "usageRollupList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/usageRollupDetail"
+      }
+    },
     "versionInfo": {
       "description": "Application build information",
       "type": "object",
@@ -2567,6 +3254,12 @@ func init() {
         }
       }
     },
+    "circuitDryRun": {
+      "description": "The outcome of a circuit dry run",
+      "schema": {
+        "$ref": "#/definitions/circuitDryRunEnvelope"
+      }
+    },
     "createResponse": {
       "description": "The create request was successful and the resource has been added at the following location",
       "schema": {
@@ -2657,6 +3350,18 @@ func init() {
         }
       }
     },
+    "listAlertRules": {
+      "description": "A list of alert rules",
+      "schema": {
+        "$ref": "#/definitions/listAlertRulesEnvelope"
+      }
+    },
+    "listAlerts": {
+      "description": "A list of firing alerts",
+      "schema": {
+        "$ref": "#/definitions/listAlertsEnvelope"
+      }
+    },
     "listCircuits": {
       "description": "A list of circuits",
       "schema": {
@@ -2687,6 +3392,12 @@ func init() {
         "$ref": "#/definitions/listTerminatorsEnvelope"
       }
     },
+    "listUsage": {
+      "description": "A list of usage rollups",
+      "schema": {
+        "$ref": "#/definitions/listUsageEnvelope"
+      }
+    },
     "notFoundResponse": {
       "description": "The requested resource does not exist",
       "schema": {
@@ -2765,49 +3476,566 @@ func init() {
             "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
             "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
           },
-          "meta": {
-            "apiEnrollmentVersion": "0.0.1",
-            "apiVersion": "0.0.1"
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "updateResponse": {
+      "description": "The update request was successful and the resource has been altered",
+      "schema": {
+        "$ref": "#/definitions/empty"
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "OpenZiti Fabric API",
+    "title": "Ziti Fabric",
+    "contact": {
+      "name": "OpenZiti",
+      "url": "https://openziti.discourse.group",
+      "email": "help@openziti.org"
+    },
+    "license": {
+      "name": "Apache 2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "0.16.54"
+  },
+  "host": "demo.ziti.dev",
+  "basePath": "/fabric/v1",
+  "paths": {
+    "/alert-rules": {
+      "get": {
+        "description": "Retrieves the alert rules, including rules from the controller configuration. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "List alert rules",
+        "operationId": "listAlertRules",
+        "responses": {
+          "200": {
+            "description": "A list of alert rules",
+            "schema": {
+              "$ref": "#/definitions/listAlertRulesEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create an alert rule. Rules created through the API are persisted and replicated to every controller in a\ncluster. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "Create an alert rule",
+        "operationId": "createAlertRule",
+        "parameters": [
+          {
+            "description": "An alert rule to create",
+            "name": "alertRule",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRuleCreate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "The create request was successful and the resource has been added at the following location",
+            "schema": {
+              "$ref": "#/definitions/createEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/alert-rules/{id}": {
+      "delete": {
+        "description": "Delete an alert rule by id. Rules from the controller configuration can't be deleted. Any alerts firing for\nthe rule are resolved. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "Delete an alert rule",
+        "operationId": "deleteAlertRule",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/alerts": {
+      "get": {
+        "description": "Retrieves the alerts which are currently firing. Requires admin access.\n",
+        "tags": [
+          "Alert"
+        ],
+        "summary": "List firing alerts",
+        "operationId": "listAlerts",
+        "responses": {
+          "200": {
+            "description": "A list of firing alerts",
+            "schema": {
+              "$ref": "#/definitions/listAlertsEnvelope"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/circuit-dry-run": {
+      "post": {
+        "description": "Runs terminator and path selection for a dial of the given service, without creating a circuit. Returns the\ncandidate terminators with their costs, the terminator the service's strategy selects, the path with per hop\ncosts, and why other terminators were rejected. If no ingress router is given, every edge router the identity\ncan use to reach the service is evaluated. Requires admin access.\n",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Evaluate circuit creation without creating a circuit",
+        "operationId": "dryRunCircuit",
+        "parameters": [
+          {
+            "description": "The dial to evaluate",
+            "name": "request",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/circuitDryRunRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The outcome of a circuit dry run",
+            "schema": {
+              "$ref": "#/definitions/circuitDryRunEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
       }
     },
-    "updateResponse": {
-      "description": "The update request was successful and the resource has been altered",
-      "schema": {
-        "$ref": "#/definitions/empty"
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "OpenZiti Fabric API",
-    "title": "Ziti Fabric",
-    "contact": {
-      "name": "OpenZiti",
-      "url": "https://openziti.discourse.group",
-      "email": "help@openziti.org"
-    },
-    "license": {
-      "name": "Apache 2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
-    },
-    "version": "0.16.54"
-  },
-  "host": "demo.ziti.dev",
-  "basePath": "/fabric/v1",
-  "paths": {
     "/circuits": {
       "get": {
         "description": "Retrieves a list of circuit resources; does not supports filtering, sorting, or pagination. Requires admin access.\n",
@@ -6836,10 +8064,286 @@ func init() {
           "in": "path",
           "required": true
         }
-      ]
-    }
-  },
-  "definitions": {
+      ]
+    },
+    "/usage": {
+      "get": {
+        "description": "Retrieves hourly or daily usage totals per identity, service or router. Usage rollups must be enabled in the\ncontroller configuration. Requires admin access.\n",
+        "tags": [
+          "Usage"
+        ],
+        "summary": "List usage rollups",
+        "operationId": "listUsage",
+        "parameters": [
+          {
+            "enum": [
+              "hourly",
+              "daily"
+            ],
+            "type": "string",
+            "default": "hourly",
+            "description": "The rollup interval",
+            "name": "interval",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "identity",
+              "service",
+              "router"
+            ],
+            "type": "string",
+            "default": "service",
+            "description": "The entity type usage is grouped by",
+            "name": "groupBy",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return usage for the given identity, service or router id",
+            "name": "entityId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return intervals which end after the given time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only return intervals which start before the given time",
+            "name": "until",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A list of usage rollups",
+            "schema": {
+              "$ref": "#/definitions/listUsageEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "alertDetail": {
+      "type": "object",
+      "required": [
+        "ruleId",
+        "ruleType",
+        "entityType",
+        "entityId",
+        "value",
+        "threshold",
+        "firedAt",
+        "message"
+      ],
+      "properties": {
+        "entityId": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "firedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "message": {
+          "type": "string"
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "ruleType": {
+          "type": "string"
+        },
+        "threshold": {
+          "type": "number"
+        },
+        "value": {
+          "type": "number"
+        }
+      }
+    },
+    "alertList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertDetail"
+      }
+    },
+    "alertRuleCreate": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "for": {
+          "description": "How long the condition must hold before the alert fires, as a duration such as 5m",
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "minCircuits": {
+          "description": "The minimum number of circuits attempted in the window before a circuitFailureRate rule is evaluated",
+          "type": "integer"
+        },
+        "threshold": {
+          "description": "The link p95 latency in milliseconds for linkLatency rules, or the failure percentage for circuitFailureRate rules",
+          "type": "number"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "routerOffline",
+            "noHealthyTerminators",
+            "linkLatency",
+            "circuitFailureRate"
+          ]
+        },
+        "window": {
+          "description": "The window circuit failure rates are computed over, as a duration such as 5m",
+          "type": "string"
+        }
+      }
+    },
+    "alertRuleDetail": {
+      "type": "object",
+      "required": [
+        "id",
+        "type",
+        "for",
+        "threshold",
+        "window",
+        "minCircuits",
+        "source"
+      ],
+      "properties": {
+        "for": {
+          "description": "How long the condition must hold before the alert fires, as a duration such as 5m",
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "minCircuits": {
+          "type": "integer"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "config",
+            "api"
+          ]
+        },
+        "threshold": {
+          "type": "number"
+        },
+        "type": {
+          "type": "string"
+        },
+        "window": {
+          "description": "The window circuit failure rates are computed over, as a duration such as 5m",
+          "type": "string"
+        }
+      }
+    },
+    "alertRuleList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/alertRuleDetail"
+      }
+    },
     "apiError": {
       "type": "object",
       "properties": {
@@ -6984,6 +8488,187 @@ func init() {
         }
       ]
     },
+    "circuitDryRunDetail": {
+      "type": "object",
+      "required": [
+        "serviceId",
+        "serviceName",
+        "strategy",
+        "ingresses"
+      ],
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "identityId": {
+          "type": "string"
+        },
+        "ingresses": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitDryRunIngress"
+          }
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "serviceName": {
+          "type": "string"
+        },
+        "strategy": {
+          "type": "string"
+        }
+      }
+    },
+    "circuitDryRunEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitDryRunDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "circuitDryRunHop": {
+      "type": "object",
+      "required": [
+        "routerId",
+        "routerCost"
+      ],
+      "properties": {
+        "linkCost": {
+          "type": "integer"
+        },
+        "linkId": {
+          "description": "The link to the next router on the path",
+          "type": "string"
+        },
+        "routerCost": {
+          "type": "integer"
+        },
+        "routerId": {
+          "type": "string"
+        }
+      }
+    },
+    "circuitDryRunIngress": {
+      "type": "object",
+      "required": [
+        "routerId",
+        "terminators"
+      ],
+      "properties": {
+        "error": {
+          "description": "Why a circuit couldn't be created through this router",
+          "type": "string"
+        },
+        "path": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitDryRunHop"
+          }
+        },
+        "pathCost": {
+          "type": "integer"
+        },
+        "routerId": {
+          "type": "string"
+        },
+        "terminatorId": {
+          "description": "The terminator selected by the service's strategy",
+          "type": "string"
+        },
+        "terminators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitDryRunTerminator"
+          }
+        }
+      }
+    },
+    "circuitDryRunRequest": {
+      "type": "object",
+      "required": [
+        "service"
+      ],
+      "properties": {
+        "identityId": {
+          "description": "The id of the dialing identity",
+          "type": "string"
+        },
+        "instanceId": {
+          "description": "The instance id to dial, for addressable terminators",
+          "type": "string"
+        },
+        "routerId": {
+          "description": "The id of the ingress router. If not set, all edge routers usable by the identity are evaluated",
+          "type": "string"
+        },
+        "service": {
+          "description": "The id or name of the service to dial",
+          "type": "string"
+        }
+      }
+    },
+    "circuitDryRunTerminator": {
+      "type": "object",
+      "required": [
+        "id",
+        "routerId",
+        "binding",
+        "address",
+        "precedence",
+        "staticCost",
+        "dynamicCost",
+        "selected"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "binding": {
+          "type": "string"
+        },
+        "dynamicCost": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "pathCost": {
+          "type": "integer"
+        },
+        "precedence": {
+          "type": "string"
+        },
+        "rejected": {
+          "description": "Why the terminator wasn't selected",
+          "type": "string"
+        },
+        "routeCost": {
+          "description": "The cost used to compare terminators, including the path cost and biased by precedence",
+          "type": "integer"
+        },
+        "routerId": {
+          "type": "string"
+        },
+        "selected": {
+          "type": "boolean"
+        },
+        "staticCost": {
+          "type": "integer"
+        }
+      }
+    },
     "circuitList": {
       "type": "array",
       "items": {
@@ -7388,6 +9073,36 @@ func init() {
       },
       "x-omitempty": false
     },
+    "listAlertRulesEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertRuleList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listAlertsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/alertList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listCircuitsEnvelope": {
       "type": "object",
       "required": [
@@ -7463,6 +9178,21 @@ func init() {
         }
       }
     },
+    "listUsageEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/usageRollupList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "meta": {
       "type": "object",
       "properties": {
@@ -7738,6 +9468,13 @@ func init() {
         }
       }
     },
+    "routerSelectors": {
+      "description": "Routers to match, given as #attribute for edge router role attributes, @id for a router id or key=value for a router tag",
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
     "routerUpdate": {
       "type": "object",
       "required": [
@@ -7776,6 +9513,17 @@ func init() {
         "name"
       ],
       "properties": {
+        "avoidRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "egressRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "maxHops": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
         "name": {
           "type": "string"
         },
@@ -7800,6 +9548,15 @@ func init() {
             "terminatorStrategy"
           ],
           "properties": {
+            "avoidRouters": {
+              "$ref": "#/definitions/routerSelectors"
+            },
+            "egressRouters": {
+              "$ref": "#/definitions/routerSelectors"
+            },
+            "maxHops": {
+              "type": "integer"
+            },
             "name": {
               "type": "string"
             },
@@ -7819,6 +9576,17 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
+        "avoidRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "egressRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "maxHops": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
         "name": {
           "type": "string"
         },
@@ -7836,6 +9604,17 @@ func init() {
         "name"
       ],
       "properties": {
+        "avoidRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "egressRouters": {
+          "$ref": "#/definitions/routerSelectors"
+        },
+        "maxHops": {
+          "type": "integer",
+          "minimum": 0,
+          "x-nullable": true
+        },
         "name": {
           "type": "string"
         },
@@ -7946,6 +9725,11 @@ func init() {
             "cost": {
               "$ref": "#/definitions/terminatorCost"
             },
+            "drainDeadline": {
+              "description": "When a draining terminator will be removed, even if it still has circuits",
+              "type": "string",
+              "format": "date-time"
+            },
             "dynamicCost": {
               "$ref": "#/definitions/terminatorCost"
             },
@@ -7992,6 +9776,11 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "drainTimeoutSeconds": {
+          "description": "When setting the precedence to draining, how long the terminator may keep existing circuits before it is removed. Uses the controller default if not set",
+          "type": "integer",
+          "minimum": 0
+        },
         "hostId": {
           "type": "string"
         },
@@ -8014,7 +9803,8 @@ func init() {
       "enum": [
         "default",
         "required",
-        "failed"
+        "failed",
+        "draining"
       ]
     },
     "terminatorPrecedenceMap": {
@@ -8041,6 +9831,11 @@ func init() {
         "cost": {
           "$ref": "#/definitions/terminatorCost"
         },
+        "drainTimeoutSeconds": {
+          "description": "When setting the precedence to draining, how long the terminator may keep existing circuits before it is removed. Uses the controller default if not set",
+          "type": "integer",
+          "minimum": 0
+        },
         "hostId": {
           "type": "string"
         },
@@ -8058,6 +9853,64 @@ func init() {
         }
       }
     },
+    "usageRollupDetail": {
+      "type": "object",
+      "required": [
+        "interval",
+        "groupBy",
+        "entityId",
+        "intervalStart",
+        "ingressRx",
+        "ingressTx",
+        "egressRx",
+        "egressTx",
+        "fabricRx",
+        "fabricTx",
+        "circuits"
+      ],
+      "properties": {
+        "circuits": {
+          "type": "integer"
+        },
+        "egressRx": {
+          "type": "integer"
+        },
+        "egressTx": {
+          "type": "integer"
+        },
+        "entityId": {
+          "type": "string"
+        },
+        "fabricRx": {
+          "type": "integer"
+        },
+        "fabricTx": {
+          "type": "integer"
+        },
+        "groupBy": {
+          "type": "string"
+        },
+        "ingressRx": {
+          "type": "integer"
+        },
+        "ingressTx": {
+          "type": "integer"
+        },
+        "interval": {
+          "type": "string"
+        },
+        "intervalStart": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "usageRollupList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/usageRollupDetail"
+      }
+    },
     "versionInfo": {
       "description": "Application build information",
       "type": "object",
@@ -8167,6 +10020,12 @@ func init() {
         }
       }
     },
+    "circuitDryRun": {
+      "description": "The outcome of a circuit dry run",
+      "schema": {
+        "$ref": "#/definitions/circuitDryRunEnvelope"
+      }
+    },
     "createResponse": {
       "description": "The create request was successful and the resource has been added at the following location",
       "schema": {
@@ -8257,6 +10116,18 @@ func init() {
         }
       }
     },
+    "listAlertRules": {
+      "description": "A list of alert rules",
+      "schema": {
+        "$ref": "#/definitions/listAlertRulesEnvelope"
+      }
+    },
+    "listAlerts": {
+      "description": "A list of firing alerts",
+      "schema": {
+        "$ref": "#/definitions/listAlertsEnvelope"
+      }
+    },
     "listCircuits": {
       "description": "A list of circuits",
       "schema": {
@@ -8287,6 +10158,12 @@ func init() {
         "$ref": "#/definitions/listTerminatorsEnvelope"
       }
     },
+    "listUsage": {
+      "description": "A list of usage rollups",
+      "schema": {
+        "$ref": "#/definitions/listUsageEnvelope"
+      }
+    },
     "notFoundResponse": {
       "description": "The requested resource does not exist",
       "schema": {
@@ -0,0 +1,214 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// CreateAlertRuleCreatedCode is the HTTP code returned for type CreateAlertRuleCreated
+const CreateAlertRuleCreatedCode int = 201
+
+/*CreateAlertRuleCreated The create request was successful and the resource has been added at the following location
+
+swagger:response createAlertRuleCreated
+*/
+type CreateAlertRuleCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.CreateEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateAlertRuleCreated creates CreateAlertRuleCreated with default headers values
+func NewCreateAlertRuleCreated() *CreateAlertRuleCreated {
+
+	return &CreateAlertRuleCreated{}
+}
+
+// WithPayload adds the payload to the create alert rule created response
+func (o *CreateAlertRuleCreated) WithPayload(payload *rest_model.CreateEnvelope) *CreateAlertRuleCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create alert rule created response
+func (o *CreateAlertRuleCreated) SetPayload(payload *rest_model.CreateEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAlertRuleCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateAlertRuleBadRequestCode is the HTTP code returned for type CreateAlertRuleBadRequest
+const CreateAlertRuleBadRequestCode int = 400
+
+/*CreateAlertRuleBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response createAlertRuleBadRequest
+*/
+type CreateAlertRuleBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateAlertRuleBadRequest creates CreateAlertRuleBadRequest with default headers values
+func NewCreateAlertRuleBadRequest() *CreateAlertRuleBadRequest {
+
+	return &CreateAlertRuleBadRequest{}
+}
+
+// WithPayload adds the payload to the create alert rule bad request response
+func (o *CreateAlertRuleBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *CreateAlertRuleBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create alert rule bad request response
+func (o *CreateAlertRuleBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAlertRuleBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateAlertRuleUnauthorizedCode is the HTTP code returned for type CreateAlertRuleUnauthorized
+const CreateAlertRuleUnauthorizedCode int = 401
+
+/*CreateAlertRuleUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response createAlertRuleUnauthorized
+*/
+type CreateAlertRuleUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateAlertRuleUnauthorized creates CreateAlertRuleUnauthorized with default headers values
+func NewCreateAlertRuleUnauthorized() *CreateAlertRuleUnauthorized {
+
+	return &CreateAlertRuleUnauthorized{}
+}
+
+// WithPayload adds the payload to the create alert rule unauthorized response
+func (o *CreateAlertRuleUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *CreateAlertRuleUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create alert rule unauthorized response
+func (o *CreateAlertRuleUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAlertRuleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateAlertRuleTooManyRequestsCode is the HTTP code returned for type CreateAlertRuleTooManyRequests
+const CreateAlertRuleTooManyRequestsCode int = 429
+
+/*CreateAlertRuleTooManyRequests The resource requested is rate limited and the rate limit has been exceeded
+
+swagger:response createAlertRuleTooManyRequests
+*/
+type CreateAlertRuleTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewCreateAlertRuleTooManyRequests creates CreateAlertRuleTooManyRequests with default headers values
+func NewCreateAlertRuleTooManyRequests() *CreateAlertRuleTooManyRequests {
+
+	return &CreateAlertRuleTooManyRequests{}
+}
+
+// WithPayload adds the payload to the create alert rule too many requests response
+func (o *CreateAlertRuleTooManyRequests) WithPayload(payload *rest_model.APIErrorEnvelope) *CreateAlertRuleTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create alert rule too many requests response
+func (o *CreateAlertRuleTooManyRequests) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAlertRuleTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,258 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// DeleteAlertRuleOKCode is the HTTP code returned for type DeleteAlertRuleOK
+const DeleteAlertRuleOKCode int = 200
+
+/*DeleteAlertRuleOK The delete request was successful and the resource has been removed
+
+swagger:response deleteAlertRuleOK
+*/
+type DeleteAlertRuleOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.Empty `json:"body,omitempty"`
+}
+
+// NewDeleteAlertRuleOK creates DeleteAlertRuleOK with default headers values
+func NewDeleteAlertRuleOK() *DeleteAlertRuleOK {
+
+	return &DeleteAlertRuleOK{}
+}
+
+// WithPayload adds the payload to the delete alert rule o k response
+func (o *DeleteAlertRuleOK) WithPayload(payload *rest_model.Empty) *DeleteAlertRuleOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete alert rule o k response
+func (o *DeleteAlertRuleOK) SetPayload(payload *rest_model.Empty) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAlertRuleOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteAlertRuleBadRequestCode is the HTTP code returned for type DeleteAlertRuleBadRequest
+const DeleteAlertRuleBadRequestCode int = 400
+
+/*DeleteAlertRuleBadRequest The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information
+
+swagger:response deleteAlertRuleBadRequest
+*/
+type DeleteAlertRuleBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDeleteAlertRuleBadRequest creates DeleteAlertRuleBadRequest with default headers values
+func NewDeleteAlertRuleBadRequest() *DeleteAlertRuleBadRequest {
+
+	return &DeleteAlertRuleBadRequest{}
+}
+
+// WithPayload adds the payload to the delete alert rule bad request response
+func (o *DeleteAlertRuleBadRequest) WithPayload(payload *rest_model.APIErrorEnvelope) *DeleteAlertRuleBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete alert rule bad request response
+func (o *DeleteAlertRuleBadRequest) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAlertRuleBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteAlertRuleUnauthorizedCode is the HTTP code returned for type DeleteAlertRuleUnauthorized
+const DeleteAlertRuleUnauthorizedCode int = 401
+
+/*DeleteAlertRuleUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response deleteAlertRuleUnauthorized
+*/
+type DeleteAlertRuleUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDeleteAlertRuleUnauthorized creates DeleteAlertRuleUnauthorized with default headers values
+func NewDeleteAlertRuleUnauthorized() *DeleteAlertRuleUnauthorized {
+
+	return &DeleteAlertRuleUnauthorized{}
+}
+
+// WithPayload adds the payload to the delete alert rule unauthorized response
+func (o *DeleteAlertRuleUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *DeleteAlertRuleUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete alert rule unauthorized response
+func (o *DeleteAlertRuleUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAlertRuleUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteAlertRuleNotFoundCode is the HTTP code returned for type DeleteAlertRuleNotFound
+const DeleteAlertRuleNotFoundCode int = 404
+
+/*DeleteAlertRuleNotFound The requested resource does not exist
+
+swagger:response deleteAlertRuleNotFound
+*/
+type DeleteAlertRuleNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDeleteAlertRuleNotFound creates DeleteAlertRuleNotFound with default headers values
+func NewDeleteAlertRuleNotFound() *DeleteAlertRuleNotFound {
+
+	return &DeleteAlertRuleNotFound{}
+}
+
+// WithPayload adds the payload to the delete alert rule not found response
+func (o *DeleteAlertRuleNotFound) WithPayload(payload *rest_model.APIErrorEnvelope) *DeleteAlertRuleNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete alert rule not found response
+func (o *DeleteAlertRuleNotFound) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAlertRuleNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteAlertRuleTooManyRequestsCode is the HTTP code returned for type DeleteAlertRuleTooManyRequests
+const DeleteAlertRuleTooManyRequestsCode int = 429
+
+/*DeleteAlertRuleTooManyRequests The resource requested is rate limited and the rate limit has been exceeded
+
+swagger:response deleteAlertRuleTooManyRequests
+*/
+type DeleteAlertRuleTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewDeleteAlertRuleTooManyRequests creates DeleteAlertRuleTooManyRequests with default headers values
+func NewDeleteAlertRuleTooManyRequests() *DeleteAlertRuleTooManyRequests {
+
+	return &DeleteAlertRuleTooManyRequests{}
+}
+
+// WithPayload adds the payload to the delete alert rule too many requests response
+func (o *DeleteAlertRuleTooManyRequests) WithPayload(payload *rest_model.APIErrorEnvelope) *DeleteAlertRuleTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete alert rule too many requests response
+func (o *DeleteAlertRuleTooManyRequests) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAlertRuleTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
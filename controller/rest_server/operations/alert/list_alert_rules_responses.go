@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// ListAlertRulesOKCode is the HTTP code returned for type ListAlertRulesOK
+const ListAlertRulesOKCode int = 200
+
+/*ListAlertRulesOK A list of alert rules
+
+swagger:response listAlertRulesOK
+*/
+type ListAlertRulesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.ListAlertRulesEnvelope `json:"body,omitempty"`
+}
+
+// NewListAlertRulesOK creates ListAlertRulesOK with default headers values
+func NewListAlertRulesOK() *ListAlertRulesOK {
+
+	return &ListAlertRulesOK{}
+}
+
+// WithPayload adds the payload to the list alert rules o k response
+func (o *ListAlertRulesOK) WithPayload(payload *rest_model.ListAlertRulesEnvelope) *ListAlertRulesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list alert rules o k response
+func (o *ListAlertRulesOK) SetPayload(payload *rest_model.ListAlertRulesEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAlertRulesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListAlertRulesUnauthorizedCode is the HTTP code returned for type ListAlertRulesUnauthorized
+const ListAlertRulesUnauthorizedCode int = 401
+
+/*ListAlertRulesUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response listAlertRulesUnauthorized
+*/
+type ListAlertRulesUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewListAlertRulesUnauthorized creates ListAlertRulesUnauthorized with default headers values
+func NewListAlertRulesUnauthorized() *ListAlertRulesUnauthorized {
+
+	return &ListAlertRulesUnauthorized{}
+}
+
+// WithPayload adds the payload to the list alert rules unauthorized response
+func (o *ListAlertRulesUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *ListAlertRulesUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list alert rules unauthorized response
+func (o *ListAlertRulesUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAlertRulesUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListAlertRulesTooManyRequestsCode is the HTTP code returned for type ListAlertRulesTooManyRequests
+const ListAlertRulesTooManyRequestsCode int = 429
+
+/*ListAlertRulesTooManyRequests The resource requested is rate limited and the rate limit has been exceeded
+
+swagger:response listAlertRulesTooManyRequests
+*/
+type ListAlertRulesTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewListAlertRulesTooManyRequests creates ListAlertRulesTooManyRequests with default headers values
+func NewListAlertRulesTooManyRequests() *ListAlertRulesTooManyRequests {
+
+	return &ListAlertRulesTooManyRequests{}
+}
+
+// WithPayload adds the payload to the list alert rules too many requests response
+func (o *ListAlertRulesTooManyRequests) WithPayload(payload *rest_model.APIErrorEnvelope) *ListAlertRulesTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list alert rules too many requests response
+func (o *ListAlertRulesTooManyRequests) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAlertRulesTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
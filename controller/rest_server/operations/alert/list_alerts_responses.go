@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package alert
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openziti/ziti/controller/rest_model"
+)
+
+// ListAlertsOKCode is the HTTP code returned for type ListAlertsOK
+const ListAlertsOKCode int = 200
+
+/*ListAlertsOK A list of firing alerts
+
+swagger:response listAlertsOK
+*/
+type ListAlertsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.ListAlertsEnvelope `json:"body,omitempty"`
+}
+
+// NewListAlertsOK creates ListAlertsOK with default headers values
+func NewListAlertsOK() *ListAlertsOK {
+
+	return &ListAlertsOK{}
+}
+
+// WithPayload adds the payload to the list alerts o k response
+func (o *ListAlertsOK) WithPayload(payload *rest_model.ListAlertsEnvelope) *ListAlertsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list alerts o k response
+func (o *ListAlertsOK) SetPayload(payload *rest_model.ListAlertsEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAlertsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListAlertsUnauthorizedCode is the HTTP code returned for type ListAlertsUnauthorized
+const ListAlertsUnauthorizedCode int = 401
+
+/*ListAlertsUnauthorized The currently supplied session does not have the correct access rights to request this resource
+
+swagger:response listAlertsUnauthorized
+*/
+type ListAlertsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewListAlertsUnauthorized creates ListAlertsUnauthorized with default headers values
+func NewListAlertsUnauthorized() *ListAlertsUnauthorized {
+
+	return &ListAlertsUnauthorized{}
+}
+
+// WithPayload adds the payload to the list alerts unauthorized response
+func (o *ListAlertsUnauthorized) WithPayload(payload *rest_model.APIErrorEnvelope) *ListAlertsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list alerts unauthorized response
+func (o *ListAlertsUnauthorized) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAlertsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListAlertsTooManyRequestsCode is the HTTP code returned for type ListAlertsTooManyRequests
+const ListAlertsTooManyRequestsCode int = 429
+
+/*ListAlertsTooManyRequests The resource requested is rate limited and the rate limit has been exceeded
+
+swagger:response listAlertsTooManyRequests
+*/
+type ListAlertsTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewListAlertsTooManyRequests creates ListAlertsTooManyRequests with default headers values
+func NewListAlertsTooManyRequests() *ListAlertsTooManyRequests {
+
+	return &ListAlertsTooManyRequests{}
+}
+
+// WithPayload adds the payload to the list alerts too many requests response
+func (o *ListAlertsTooManyRequests) WithPayload(payload *rest_model.APIErrorEnvelope) *ListAlertsTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list alerts too many requests response
+func (o *ListAlertsTooManyRequests) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAlertsTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,290 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package usage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewListUsageParams creates a new ListUsageParams object
+// with the default values initialized.
+func NewListUsageParams() ListUsageParams {
+
+	var (
+		// initialize parameters with default values
+
+		groupByDefault  = string("service")
+		intervalDefault = string("hourly")
+	)
+
+	return ListUsageParams{
+		GroupBy: &groupByDefault,
+
+		Interval: &intervalDefault,
+	}
+}
+
+// ListUsageParams contains all the bound params for the list usage operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listUsage
+type ListUsageParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only return usage for the given identity, service or router id
+	  In: query
+	*/
+	EntityID *string
+	/*The entity type usage is grouped by
+	  In: query
+	  Default: "service"
+	*/
+	GroupBy *string
+	/*The rollup interval
+	  In: query
+	  Default: "hourly"
+	*/
+	Interval *string
+	/*Only return intervals which end after the given time
+	  In: query
+	*/
+	Since *strfmt.DateTime
+	/*Only return intervals which start before the given time
+	  In: query
+	*/
+	Until *strfmt.DateTime
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListUsageParams() beforehand.
+func (o *ListUsageParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qEntityID, qhkEntityID, _ := qs.GetOK("entityId")
+	if err := o.bindEntityID(qEntityID, qhkEntityID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qGroupBy, qhkGroupBy, _ := qs.GetOK("groupBy")
+	if err := o.bindGroupBy(qGroupBy, qhkGroupBy, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qInterval, qhkInterval, _ := qs.GetOK("interval")
+	if err := o.bindInterval(qInterval, qhkInterval, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUntil, qhkUntil, _ := qs.GetOK("until")
+	if err := o.bindUntil(qUntil, qhkUntil, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindEntityID binds and validates parameter EntityID from query.
+func (o *ListUsageParams) bindEntityID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.EntityID = &raw
+
+	return nil
+}
+
+// bindGroupBy binds and validates parameter GroupBy from query.
+func (o *ListUsageParams) bindGroupBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListUsageParams()
+		return nil
+	}
+	o.GroupBy = &raw
+
+	if err := o.validateGroupBy(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateGroupBy carries on validations for parameter GroupBy
+func (o *ListUsageParams) validateGroupBy(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("groupBy", "query", *o.GroupBy, []interface{}{"identity", "service", "router"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindInterval binds and validates parameter Interval from query.
+func (o *ListUsageParams) bindInterval(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListUsageParams()
+		return nil
+	}
+	o.Interval = &raw
+
+	if err := o.validateInterval(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateInterval carries on validations for parameter Interval
+func (o *ListUsageParams) validateInterval(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("interval", "query", *o.Interval, []interface{}{"hourly", "daily"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSince binds and validates parameter Since from query.
+func (o *ListUsageParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("since", "query", "strfmt.DateTime", raw)
+	}
+	o.Since = (value.(*strfmt.DateTime))
+
+	if err := o.validateSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSince carries on validations for parameter Since
+func (o *ListUsageParams) validateSince(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("since", "query", "date-time", o.Since.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindUntil binds and validates parameter Until from query.
+func (o *ListUsageParams) bindUntil(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("until", "query", "strfmt.DateTime", raw)
+	}
+	o.Until = (value.(*strfmt.DateTime))
+
+	if err := o.validateUntil(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateUntil carries on validations for parameter Until
+func (o *ListUsageParams) validateUntil(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("until", "query", "date-time", o.Until.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+//
+// Copyright NetFoundry Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// __          __              _
+// \ \        / /             (_)
+//  \ \  /\  / /_ _ _ __ _ __  _ _ __   __ _
+//   \ \/  \/ / _` | '__| '_ \| | '_ \ / _` |
+//    \  /\  / (_| | |  | | | | | | | | (_| | : This file is generated, do not edit it.
+//     \/  \/ \__,_|_|  |_| |_|_|_| |_|\__, |
+//                                      __/ |
+//                                     |___/
+
+package usage
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// ListUsageURL generates an URL for the list usage operation
+type ListUsageURL struct {
+	EntityID *string
+	GroupBy  *string
+	Interval *string
+	Since    *strfmt.DateTime
+	Until    *strfmt.DateTime
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListUsageURL) WithBasePath(bp string) *ListUsageURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListUsageURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ListUsageURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/usage"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/fabric/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var entityIDQ string
+	if o.EntityID != nil {
+		entityIDQ = *o.EntityID
+	}
+	if entityIDQ != "" {
+		qs.Set("entityId", entityIDQ)
+	}
+
+	var groupByQ string
+	if o.GroupBy != nil {
+		groupByQ = *o.GroupBy
+	}
+	if groupByQ != "" {
+		qs.Set("groupBy", groupByQ)
+	}
+
+	var intervalQ string
+	if o.Interval != nil {
+		intervalQ = *o.Interval
+	}
+	if intervalQ != "" {
+		qs.Set("interval", intervalQ)
+	}
+
+	var sinceQ string
+	if o.Since != nil {
+		sinceQ = o.Since.String()
+	}
+	if sinceQ != "" {
+		qs.Set("since", sinceQ)
+	}
+
+	var untilQ string
+	if o.Until != nil {
+		untilQ = o.Until.String()
+	}
+	if untilQ != "" {
+		qs.Set("until", untilQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ListUsageURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ListUsageURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ListUsageURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ListUsageURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ListUsageURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ListUsageURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
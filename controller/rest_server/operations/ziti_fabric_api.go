@@ -43,6 +43,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openziti/ziti/controller/rest_server/operations/alert"
 	"github.com/openziti/ziti/controller/rest_server/operations/circuit"
 	"github.com/openziti/ziti/controller/rest_server/operations/database"
 	"github.com/openziti/ziti/controller/rest_server/operations/inspect"
@@ -51,6 +52,7 @@ import (
 	"github.com/openziti/ziti/controller/rest_server/operations/router"
 	"github.com/openziti/ziti/controller/rest_server/operations/service"
 	"github.com/openziti/ziti/controller/rest_server/operations/terminator"
+	"github.com/openziti/ziti/controller/rest_server/operations/usage"
 )
 
 // NewZitiFabricAPI creates a new ZitiFabric instance
@@ -78,6 +80,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		DatabaseCheckDataIntegrityHandler: database.CheckDataIntegrityHandlerFunc(func(params database.CheckDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CheckDataIntegrity has not yet been implemented")
 		}),
+		AlertCreateAlertRuleHandler: alert.CreateAlertRuleHandlerFunc(func(params alert.CreateAlertRuleParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.CreateAlertRule has not yet been implemented")
+		}),
 		DatabaseCreateDatabaseSnapshotHandler: database.CreateDatabaseSnapshotHandlerFunc(func(params database.CreateDatabaseSnapshotParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CreateDatabaseSnapshot has not yet been implemented")
 		}),
@@ -96,6 +101,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		DatabaseDataIntegrityResultsHandler: database.DataIntegrityResultsHandlerFunc(func(params database.DataIntegrityResultsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.DataIntegrityResults has not yet been implemented")
 		}),
+		AlertDeleteAlertRuleHandler: alert.DeleteAlertRuleHandlerFunc(func(params alert.DeleteAlertRuleParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.DeleteAlertRule has not yet been implemented")
+		}),
 		CircuitDeleteCircuitHandler: circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.DeleteCircuit has not yet been implemented")
 		}),
@@ -132,6 +140,15 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		InspectInspectHandler: inspect.InspectHandlerFunc(func(params inspect.InspectParams) middleware.Responder {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		}),
+		AlertListAlertRulesHandler: alert.ListAlertRulesHandlerFunc(func(params alert.ListAlertRulesParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlertRules has not yet been implemented")
+		}),
+		AlertListAlertsHandler: alert.ListAlertsHandlerFunc(func(params alert.ListAlertsParams) middleware.Responder {
+			return middleware.NotImplemented("operation alert.ListAlerts has not yet been implemented")
+		}),
+		CircuitDryRunCircuitHandler: circuit.DryRunCircuitHandlerFunc(func(params circuit.DryRunCircuitParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit.DryRunCircuit has not yet been implemented")
+		}),
 		CircuitListCircuitsHandler: circuit.ListCircuitsHandlerFunc(func(params circuit.ListCircuitsParams) middleware.Responder {
 			return middleware.NotImplemented("operation circuit.ListCircuits has not yet been implemented")
 		}),
@@ -153,6 +170,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		TerminatorListTerminatorsHandler: terminator.ListTerminatorsHandlerFunc(func(params terminator.ListTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.ListTerminators has not yet been implemented")
 		}),
+		UsageListUsageHandler: usage.ListUsageHandlerFunc(func(params usage.ListUsageParams) middleware.Responder {
+			return middleware.NotImplemented("operation usage.ListUsage has not yet been implemented")
+		}),
 		LinkPatchLinkHandler: link.PatchLinkHandlerFunc(func(params link.PatchLinkParams) middleware.Responder {
 			return middleware.NotImplemented("operation link.PatchLink has not yet been implemented")
 		}),
@@ -224,6 +244,8 @@ type ZitiFabricAPI struct {
 
 	// DatabaseCheckDataIntegrityHandler sets the operation handler for the check data integrity operation
 	DatabaseCheckDataIntegrityHandler database.CheckDataIntegrityHandler
+	// AlertCreateAlertRuleHandler sets the operation handler for the create alert rule operation
+	AlertCreateAlertRuleHandler alert.CreateAlertRuleHandler
 	// DatabaseCreateDatabaseSnapshotHandler sets the operation handler for the create database snapshot operation
 	DatabaseCreateDatabaseSnapshotHandler database.CreateDatabaseSnapshotHandler
 	// DatabaseCreateDatabaseSnapshotWithPathHandler sets the operation handler for the create database snapshot with path operation
@@ -236,6 +258,8 @@ type ZitiFabricAPI struct {
 	TerminatorCreateTerminatorHandler terminator.CreateTerminatorHandler
 	// DatabaseDataIntegrityResultsHandler sets the operation handler for the data integrity results operation
 	DatabaseDataIntegrityResultsHandler database.DataIntegrityResultsHandler
+	// AlertDeleteAlertRuleHandler sets the operation handler for the delete alert rule operation
+	AlertDeleteAlertRuleHandler alert.DeleteAlertRuleHandler
 	// CircuitDeleteCircuitHandler sets the operation handler for the delete circuit operation
 	CircuitDeleteCircuitHandler circuit.DeleteCircuitHandler
 	// LinkDeleteLinkHandler sets the operation handler for the delete link operation
@@ -260,6 +284,12 @@ type ZitiFabricAPI struct {
 	DatabaseFixDataIntegrityHandler database.FixDataIntegrityHandler
 	// InspectInspectHandler sets the operation handler for the inspect operation
 	InspectInspectHandler inspect.InspectHandler
+	// AlertListAlertRulesHandler sets the operation handler for the list alert rules operation
+	AlertListAlertRulesHandler alert.ListAlertRulesHandler
+	// AlertListAlertsHandler sets the operation handler for the list alerts operation
+	AlertListAlertsHandler alert.ListAlertsHandler
+	// CircuitDryRunCircuitHandler sets the operation handler for the dry run circuit operation
+	CircuitDryRunCircuitHandler circuit.DryRunCircuitHandler
 	// CircuitListCircuitsHandler sets the operation handler for the list circuits operation
 	CircuitListCircuitsHandler circuit.ListCircuitsHandler
 	// LinkListLinksHandler sets the operation handler for the list links operation
@@ -274,6 +304,8 @@ type ZitiFabricAPI struct {
 	ServiceListServicesHandler service.ListServicesHandler
 	// TerminatorListTerminatorsHandler sets the operation handler for the list terminators operation
 	TerminatorListTerminatorsHandler terminator.ListTerminatorsHandler
+	// UsageListUsageHandler sets the operation handler for the list usage operation
+	UsageListUsageHandler usage.ListUsageHandler
 	// LinkPatchLinkHandler sets the operation handler for the patch link operation
 	LinkPatchLinkHandler link.PatchLinkHandler
 	// RouterPatchRouterHandler sets the operation handler for the patch router operation
@@ -376,6 +408,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.DatabaseCheckDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.CheckDataIntegrityHandler")
 	}
+	if o.AlertCreateAlertRuleHandler == nil {
+		unregistered = append(unregistered, "alert.CreateAlertRuleHandler")
+	}
 	if o.DatabaseCreateDatabaseSnapshotHandler == nil {
 		unregistered = append(unregistered, "database.CreateDatabaseSnapshotHandler")
 	}
@@ -394,6 +429,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.DatabaseDataIntegrityResultsHandler == nil {
 		unregistered = append(unregistered, "database.DataIntegrityResultsHandler")
 	}
+	if o.AlertDeleteAlertRuleHandler == nil {
+		unregistered = append(unregistered, "alert.DeleteAlertRuleHandler")
+	}
 	if o.CircuitDeleteCircuitHandler == nil {
 		unregistered = append(unregistered, "circuit.DeleteCircuitHandler")
 	}
@@ -430,6 +468,15 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.InspectInspectHandler == nil {
 		unregistered = append(unregistered, "inspect.InspectHandler")
 	}
+	if o.AlertListAlertRulesHandler == nil {
+		unregistered = append(unregistered, "alert.ListAlertRulesHandler")
+	}
+	if o.AlertListAlertsHandler == nil {
+		unregistered = append(unregistered, "alert.ListAlertsHandler")
+	}
+	if o.CircuitDryRunCircuitHandler == nil {
+		unregistered = append(unregistered, "circuit.DryRunCircuitHandler")
+	}
 	if o.CircuitListCircuitsHandler == nil {
 		unregistered = append(unregistered, "circuit.ListCircuitsHandler")
 	}
@@ -451,6 +498,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.TerminatorListTerminatorsHandler == nil {
 		unregistered = append(unregistered, "terminator.ListTerminatorsHandler")
 	}
+	if o.UsageListUsageHandler == nil {
+		unregistered = append(unregistered, "usage.ListUsageHandler")
+	}
 	if o.LinkPatchLinkHandler == nil {
 		unregistered = append(unregistered, "link.PatchLinkHandler")
 	}
@@ -579,6 +629,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/alert-rules"] = alert.NewCreateAlertRule(o.context, o.AlertCreateAlertRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/database"] = database.NewCreateDatabaseSnapshot(o.context, o.DatabaseCreateDatabaseSnapshotHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -603,6 +657,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/alert-rules/{id}"] = alert.NewDeleteAlertRule(o.context, o.AlertDeleteAlertRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/circuits/{id}"] = circuit.NewDeleteCircuit(o.context, o.CircuitDeleteCircuitHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -651,6 +709,18 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/alert-rules"] = alert.NewListAlertRules(o.context, o.AlertListAlertRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/alerts"] = alert.NewListAlerts(o.context, o.AlertListAlertsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/circuit-dry-run"] = circuit.NewDryRunCircuit(o.context, o.CircuitDryRunCircuitHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/circuits"] = circuit.NewListCircuits(o.context, o.CircuitListCircuitsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -676,6 +746,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/terminators"] = terminator.NewListTerminators(o.context, o.TerminatorListTerminatorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/usage"] = usage.NewListUsage(o.context, o.UsageListUsageHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
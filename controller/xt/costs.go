@@ -23,10 +23,11 @@ import (
 )
 
 const (
-	unknownMinCost = math.MaxUint16 * 12
-	failedMinCost  = math.MaxUint16 * 8
-	defaultMinCost = math.MaxUint16 * 4
-	requireMinCost = 0
+	drainingMinCost = math.MaxUint16 * 16
+	unknownMinCost  = math.MaxUint16 * 12
+	failedMinCost   = math.MaxUint16 * 8
+	defaultMinCost  = math.MaxUint16 * 4
+	requireMinCost  = 0
 )
 
 var globalCosts = &costs{
@@ -63,6 +64,10 @@ func (p *precedence) IsRequired() bool {
 	return p.minCost == requireMinCost
 }
 
+func (p *precedence) IsDraining() bool {
+	return p.minCost == drainingMinCost
+}
+
 func (p *precedence) getMinCost() uint32 {
 	return p.minCost
 }
@@ -94,6 +99,11 @@ var Precedences = struct {
 	// Example: A strategy might move a terminator to Failed if three dials in a row fail
 	Failed Precedence
 
+	// Draining means this terminator should not be used for new circuits. Existing circuits are left in place, and
+	// the terminator is removed once they have all finished, or the drain timeout expires
+	// Example: The hosting application is being taken down for maintenance
+	Draining Precedence
+
 	// Unknown means this terminator was likely recently removed and should not be used
 	unknown Precedence
 }{
@@ -112,6 +122,11 @@ var Precedences = struct {
 		minCost: failedMinCost,
 		maxCost: unknownMinCost - 1,
 	},
+	Draining: &precedence{
+		name:    "draining",
+		minCost: drainingMinCost,
+		maxCost: drainingMinCost + (math.MaxUint16 * 4) - 1,
+	},
 	unknown: &precedence{
 		name:    "unknown",
 		minCost: unknownMinCost,
@@ -126,6 +141,9 @@ func GetPrecedenceForName(name string) Precedence {
 	if Precedences.Failed.String() == name {
 		return Precedences.Failed
 	}
+	if Precedences.Draining.String() == name {
+		return Precedences.Draining
+	}
 	return Precedences.Default
 }
 
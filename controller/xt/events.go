@@ -16,6 +16,8 @@
 
 package xt
 
+import "time"
+
 func NewStrategyChangeEvent(serviceId string, current, added, changed, removed []Terminator) StrategyChangeEvent {
 	return &strategyChangeEvent{
 		serviceId: serviceId,
@@ -72,6 +74,14 @@ func NewDialSucceeded(terminator Terminator) TerminatorEvent {
 	}
 }
 
+func NewDialSucceededWithDuration(terminator Terminator, dialDuration time.Duration) TerminatorEvent {
+	return &defaultEvent{
+		terminator:   terminator,
+		eventType:    eventTypeSucceeded,
+		dialDuration: dialDuration,
+	}
+}
+
 func NewCircuitRemoved(terminator Terminator) TerminatorEvent {
 	return &defaultEvent{
 		terminator: terminator,
@@ -79,23 +89,38 @@ func NewCircuitRemoved(terminator Terminator) TerminatorEvent {
 	}
 }
 
+// NewDialCancelled is sent when a terminator was selected, but no dial was attempted, so strategies which track
+// pending dials can release the selection
+func NewDialCancelled(terminator Terminator) TerminatorEvent {
+	return &defaultEvent{
+		terminator: terminator,
+		eventType:  eventTypeDialCancelled,
+	}
+}
+
 type eventType int
 
 const (
 	eventTypeFailed eventType = iota
 	eventTypeSucceeded
 	eventTypeCircuitRemoved
+	eventTypeDialCancelled
 )
 
 type defaultEvent struct {
-	terminator Terminator
-	eventType  eventType
+	terminator   Terminator
+	eventType    eventType
+	dialDuration time.Duration
 }
 
 func (event *defaultEvent) GetTerminator() Terminator {
 	return event.terminator
 }
 
+func (event *defaultEvent) GetDialDuration() time.Duration {
+	return event.dialDuration
+}
+
 func (event *defaultEvent) Accept(visitor EventVisitor) {
 	if event.eventType == eventTypeFailed {
 		visitor.VisitDialFailed(event)
@@ -103,6 +128,10 @@ func (event *defaultEvent) Accept(visitor EventVisitor) {
 		visitor.VisitDialSucceeded(event)
 	} else if event.eventType == eventTypeCircuitRemoved {
 		visitor.VisitCircuitRemoved(event)
+	} else if event.eventType == eventTypeDialCancelled {
+		if cancelledVisitor, ok := visitor.(DialCancelledVisitor); ok {
+			cancelledVisitor.VisitDialCancelled(event)
+		}
 	}
 }
 
@@ -66,6 +66,41 @@ type Strategy interface {
 	NotifyEvent(event TerminatorEvent)
 }
 
+// DialContext describes the dial a terminator is being selected for
+type DialContext interface {
+	// GetClientId returns the id of the dialing identity, or of the router for router embedded tunnels. It may be empty
+	GetClientId() string
+	// GetPeerData returns the data the dialer sent along with the dial, such as app data
+	GetPeerData() PeerData
+	// GetServiceTags returns the tags of the service being dialed
+	GetServiceTags() map[string]interface{}
+	// GetLocality returns the locality of the dialer
+	GetLocality() Locality
+	// GetTerminatorLocalities returns the localities of the given terminators, keyed by terminator id
+	GetTerminatorLocalities(terminators []CostedTerminator) map[string]Locality
+}
+
+// Locality is where a router, identity or terminator is located. Either field may be empty if it isn't known
+type Locality struct {
+	Region string
+	Zone   string
+}
+
+func (self Locality) IsSameRegion(other Locality) bool {
+	return self.Region != "" && self.Region == other.Region
+}
+
+func (self Locality) IsSameZone(other Locality) bool {
+	return self.IsSameRegion(other) && self.Zone != "" && self.Zone == other.Zone
+}
+
+// DialContextStrategy is implemented by strategies which need to know who is dialing in order to select a
+// terminator. If a strategy implements it, SelectForDial is called instead of Select
+type DialContextStrategy interface {
+	Strategy
+	SelectForDial(dial DialContext, terminators []CostedTerminator) (CostedTerminator, error)
+}
+
 type Precedence interface {
 	fmt.Stringer
 	getMinCost() uint32
@@ -73,12 +108,16 @@ type Precedence interface {
 	IsFailed() bool
 	IsDefault() bool
 	IsRequired() bool
+	IsDraining() bool
 	Unbias(cost uint32) uint32
 	GetBiasedCost(cost uint32) uint32
 }
 
 type TerminatorEvent interface {
 	GetTerminator() Terminator
+	// GetDialDuration returns how long a successful dial took, measured from when the route was sent until the
+	// terminator's router reported success. It returns 0 if the duration wasn't measured
+	GetDialDuration() time.Duration
 	Accept(visitor EventVisitor)
 }
 
@@ -88,6 +127,12 @@ type EventVisitor interface {
 	VisitCircuitRemoved(event TerminatorEvent)
 }
 
+// DialCancelledVisitor may be implemented by event visitors which track selected terminators which haven't been
+// dialed yet. Visitors which don't implement it don't see dial cancelled events
+type DialCancelledVisitor interface {
+	VisitDialCancelled(event TerminatorEvent)
+}
+
 type Stats interface {
 	GetCost() uint32
 	GetPrecedence() Precedence
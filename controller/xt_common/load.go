@@ -0,0 +1,194 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_common
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openziti/ziti/controller/xt"
+)
+
+const (
+	DefaultPendingDialTimeout = 30 * time.Second
+	DefaultFailurePenalty     = 30 * time.Second
+)
+
+// Load is a snapshot of the circuits a terminator is handling. Pending dials are those where the terminator has
+// been selected, but the dial hasn't yet succeeded or failed. Recent failures are dials which failed within the
+// failure penalty period. They count against a terminator so that one which fails fast doesn't attract all new
+// circuits.
+type Load struct {
+	Active         int
+	Pending        int
+	RecentFailures int
+}
+
+func (self Load) Total() int {
+	return self.Active + self.Pending + self.RecentFailures
+}
+
+func NewTerminatorLoads() *TerminatorLoads {
+	return &TerminatorLoads{
+		PendingDialTimeout: DefaultPendingDialTimeout,
+		FailurePenalty:     DefaultFailurePenalty,
+		loads:              map[string]*terminatorLoad{},
+	}
+}
+
+// TerminatorLoads tracks the load on each terminator using strategy events. A circuit is pending from when its
+// terminator is selected until the dial succeeds or fails and is active from when the dial succeeds until the
+// circuit is removed. Pending dials which never get a result, for example because another router in the path
+// failed, are dropped after the pending dial timeout.
+type TerminatorLoads struct {
+	PendingDialTimeout time.Duration
+	FailurePenalty     time.Duration
+	lock               sync.Mutex
+	loads              map[string]*terminatorLoad
+}
+
+type terminatorLoad struct {
+	active   int
+	pending  []time.Time
+	failures []time.Time
+}
+
+// SelectMin picks the terminator with the lowest score and records a pending dial against it. Ties go to the
+// terminator which comes first in the list, which is the one with the lowest route cost. If the list is empty, nil
+// is returned
+func (self *TerminatorLoads) SelectMin(terminators []xt.CostedTerminator, score func(t xt.CostedTerminator, load Load) float64) xt.CostedTerminator {
+	if len(terminators) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var selected xt.CostedTerminator
+	var selectedScore float64
+	for _, t := range terminators {
+		tScore := score(t, self.getLoad(t.GetId(), now))
+		if selected == nil || tScore < selectedScore {
+			selected = t
+			selectedScore = tScore
+		}
+	}
+
+	load := self.getOrCreate(selected.GetId())
+	load.pending = append(load.pending, now)
+
+	return selected
+}
+
+// GetLoad returns the current load for the given terminator
+func (self *TerminatorLoads) GetLoad(terminatorId string) Load {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.getLoad(terminatorId, time.Now())
+}
+
+func (self *TerminatorLoads) Clear(terminatorId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.loads, terminatorId)
+}
+
+func (self *TerminatorLoads) VisitDialFailed(event xt.TerminatorEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	load := self.getOrCreate(event.GetTerminator().GetId())
+	load.completePending()
+	load.failures = append(load.failures, time.Now())
+}
+
+func (self *TerminatorLoads) VisitDialSucceeded(event xt.TerminatorEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	load := self.getOrCreate(event.GetTerminator().GetId())
+	load.completePending()
+	load.active++
+}
+
+func (self *TerminatorLoads) VisitCircuitRemoved(event xt.TerminatorEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if load, found := self.loads[event.GetTerminator().GetId()]; found && load.active > 0 {
+		load.active--
+	}
+}
+
+var _ xt.DialCancelledVisitor = (*TerminatorLoads)(nil)
+
+func (self *TerminatorLoads) VisitDialCancelled(event xt.TerminatorEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if load, found := self.loads[event.GetTerminator().GetId()]; found {
+		load.completePending()
+	}
+}
+
+// getLoad returns the load for the given terminator, after dropping expired pending dials and failures. Must be
+// called with the lock held
+func (self *TerminatorLoads) getLoad(terminatorId string, now time.Time) Load {
+	load, found := self.loads[terminatorId]
+	if !found {
+		return Load{}
+	}
+
+	load.pending = dropBefore(load.pending, now.Add(-self.PendingDialTimeout))
+	load.failures = dropBefore(load.failures, now.Add(-self.FailurePenalty))
+
+	return Load{
+		Active:         load.active,
+		Pending:        len(load.pending),
+		RecentFailures: len(load.failures),
+	}
+}
+
+func (self *TerminatorLoads) getOrCreate(terminatorId string) *terminatorLoad {
+	load, found := self.loads[terminatorId]
+	if !found {
+		load = &terminatorLoad{}
+		self.loads[terminatorId] = load
+	}
+	return load
+}
+
+// completePending removes the oldest pending dial, if there is one
+func (self *terminatorLoad) completePending() {
+	if len(self.pending) > 0 {
+		self.pending = self.pending[1:]
+	}
+}
+
+// dropBefore removes the leading entries of the ordered list which are before the cutoff
+func dropBefore(list []time.Time, cutoff time.Time) []time.Time {
+	idx := 0
+	for idx < len(list) && list[idx].Before(cutoff) {
+		idx++
+	}
+	if idx == len(list) {
+		return nil
+	}
+	return list[idx:]
+}
@@ -0,0 +1,75 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerminatorLoads(t *testing.T) {
+	req := require.New(t)
+
+	loads := NewTerminatorLoads()
+	t1 := NewTestTerminator("t1")
+	t2 := NewTestTerminator("t2")
+	terminators := []xt.CostedTerminator{t1, t2}
+
+	byTotal := func(_ xt.CostedTerminator, load Load) float64 {
+		return float64(load.Total())
+	}
+
+	// pending dials are counted, so a burst alternates between terminators
+	req.Equal(t1, loads.SelectMin(terminators, byTotal))
+	req.Equal(t2, loads.SelectMin(terminators, byTotal))
+	req.Equal(t1, loads.SelectMin(terminators, byTotal))
+	req.Equal(Load{Pending: 2}, loads.GetLoad("t1"))
+
+	xt.NewDialSucceeded(t1).Accept(loads)
+	xt.NewDialSucceeded(t1).Accept(loads)
+	xt.NewDialFailedEvent(t2).Accept(loads)
+	req.Equal(Load{Active: 2}, loads.GetLoad("t1"))
+	req.Equal(Load{RecentFailures: 1}, loads.GetLoad("t2"))
+
+	xt.NewCircuitRemoved(t1).Accept(loads)
+	xt.NewCircuitRemoved(t1).Accept(loads)
+	xt.NewCircuitRemoved(t1).Accept(loads)
+	req.Equal(Load{}, loads.GetLoad("t1"))
+	req.Equal(t1, loads.SelectMin(terminators, byTotal))
+
+	// cancelled dials release their selection
+	req.Equal(Load{Pending: 1}, loads.GetLoad("t1"))
+	xt.NewDialCancelled(t1).Accept(loads)
+	req.Equal(Load{}, loads.GetLoad("t1"))
+	req.Equal(t1, loads.SelectMin(terminators, byTotal))
+
+	// pending dials and failures expire
+	loads.PendingDialTimeout = 0
+	loads.FailurePenalty = 0
+	time.Sleep(time.Millisecond)
+	req.Equal(Load{}, loads.GetLoad("t1"))
+	req.Equal(Load{}, loads.GetLoad("t2"))
+
+	loads.Clear("t1")
+	req.NotContains(loads.loads, "t1")
+
+	// nothing is selected from an empty list
+	req.Nil(loads.SelectMin(nil, byTotal))
+}
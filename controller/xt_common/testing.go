@@ -0,0 +1,55 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_common
+
+import (
+	"time"
+
+	"github.com/openziti/ziti/controller/xt"
+)
+
+// TestTerminator is a minimal terminator for strategy tests. Terminators without a precedence have the default
+// precedence
+type TestTerminator struct {
+	Id         string
+	Precedence xt.Precedence
+	RouteCost  uint32
+}
+
+func NewTestTerminator(id string) *TestTerminator {
+	return &TestTerminator{Id: id}
+}
+
+func (self *TestTerminator) GetId() string { return self.Id }
+
+func (self *TestTerminator) GetPrecedence() xt.Precedence {
+	if self.Precedence == nil {
+		return xt.Precedences.Default
+	}
+	return self.Precedence
+}
+
+func (self *TestTerminator) GetCost() uint16          { return 0 }
+func (self *TestTerminator) GetServiceId() string     { return "s1" }
+func (self *TestTerminator) GetInstanceId() string    { return "" }
+func (self *TestTerminator) GetRouterId() string      { return "r1" }
+func (self *TestTerminator) GetBinding() string       { return "transport" }
+func (self *TestTerminator) GetAddress() string       { return "" }
+func (self *TestTerminator) GetPeerData() xt.PeerData { return nil }
+func (self *TestTerminator) GetCreatedAt() time.Time  { return time.Time{} }
+func (self *TestTerminator) GetHostId() string        { return "" }
+func (self *TestTerminator) GetRouteCost() uint32     { return self.RouteCost }
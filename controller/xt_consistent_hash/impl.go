@@ -0,0 +1,261 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_consistent_hash
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openziti/sdk-golang/ziti/edge"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+)
+
+const (
+	Name = "consistenthash"
+
+	// HashKeyField is the app data field which, if set, is used as the hash key instead of the dialing identity
+	HashKeyField = "hash_key"
+
+	// VirtualNodes is the number of points each terminator is given on the hash ring. More points spread keys
+	// more evenly across terminators
+	VirtualNodes = 100
+)
+
+/**
+The consistenthash strategy maps each dialer onto a terminator using a consistent hash ring, so the same dialer
+keeps landing on the same terminator, without having to round-trip a token. The key is taken from the hash_key
+field of the dial's app data, if present, otherwise the id of the dialing identity is used. When terminators join
+or leave, only the keys which hashed to the affected terminators move, roughly 1/N of them. Only terminators with
+the best available precedence are considered, so if the terminator a key maps to is unavailable, the key moves to
+the next terminator on the ring and returns once the terminator is back. Dials without a key go to the terminator
+with the lowest cost. Failures drive costs the same way as the smartrouting strategy.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+		},
+		rings: map[string]*ring{},
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	lock  sync.Mutex
+	rings map[string]*ring
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	return terminators[0], nil
+}
+
+func (self *strategy) SelectForDial(dial xt.DialContext, terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	key := GetHashKey(dial)
+	if key == "" {
+		return terminators[0], nil
+	}
+
+	terminators = xt.GetRelatedTerminators(terminators)
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	serviceId := terminators[0].GetServiceId()
+	r := self.rings[serviceId]
+	if r == nil {
+		r = newRing()
+		self.rings[serviceId] = r
+	}
+
+	// terminators may be missing if the ring hasn't seen a change event since startup
+	candidates := map[string]xt.CostedTerminator{}
+	var missing []string
+	for _, t := range terminators {
+		candidates[t.GetId()] = t
+		if !r.contains(t.GetId()) {
+			missing = append(missing, t.GetId())
+		}
+	}
+
+	if len(missing) > 0 {
+		r = r.with(missing, nil)
+		self.rings[serviceId] = r
+	}
+
+	if id := r.lookup(key, func(id string) bool { _, found := candidates[id]; return found }); id != "" {
+		return candidates[id], nil
+	}
+
+	return terminators[0], nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+	}
+
+	var ids []string
+	for _, list := range [][]xt.Terminator{event.GetCurrent(), event.GetAdded(), event.GetChanged()} {
+		for _, t := range list {
+			ids = append(ids, t.GetId())
+		}
+	}
+
+	var removed []string
+	for _, t := range event.GetRemoved() {
+		removed = append(removed, t.GetId())
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	r := newRing().with(ids, removed)
+	if len(r.members) == 0 {
+		delete(self.rings, event.GetServiceId())
+	} else {
+		self.rings[event.GetServiceId()] = r
+	}
+
+	return nil
+}
+
+// GetHashKey returns the key used to place the given dial on the ring. The hash_key field of the dial's app data
+// takes priority over the dialing identity
+func GetHashKey(dial xt.DialContext) string {
+	if appData, found := dial.GetPeerData()[edge.AppDataHeader]; found {
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(appData, &fields); err == nil {
+			if key, ok := fields[HashKeyField].(string); ok && key != "" {
+				return key
+			}
+		}
+	}
+	return dial.GetClientId()
+}
+
+type point struct {
+	hash         uint64
+	terminatorId string
+}
+
+// ring is immutable once built, changes produce a new ring
+type ring struct {
+	members map[string]struct{}
+	points  []point
+}
+
+func newRing() *ring {
+	return &ring{
+		members: map[string]struct{}{},
+	}
+}
+
+func (self *ring) contains(terminatorId string) bool {
+	_, found := self.members[terminatorId]
+	return found
+}
+
+// with returns a new ring containing the current members plus the added terminators, less the removed terminators.
+// A terminator's points depend only on its id, so points belonging to other terminators never move
+func (self *ring) with(added []string, removed []string) *ring {
+	result := newRing()
+	for id := range self.members {
+		result.members[id] = struct{}{}
+	}
+	for _, id := range added {
+		result.members[id] = struct{}{}
+	}
+	for _, id := range removed {
+		delete(result.members, id)
+	}
+
+	result.points = make([]point, 0, len(result.members)*VirtualNodes)
+	for id := range result.members {
+		for i := 0; i < VirtualNodes; i++ {
+			result.points = append(result.points, point{
+				hash:         hash(id + "#" + strconv.Itoa(i)),
+				terminatorId: id,
+			})
+		}
+	}
+
+	sort.Slice(result.points, func(i, j int) bool {
+		if result.points[i].hash == result.points[j].hash {
+			return result.points[i].terminatorId < result.points[j].terminatorId
+		}
+		return result.points[i].hash < result.points[j].hash
+	})
+
+	return result
+}
+
+// lookup walks the ring clockwise from the key's position, returning the first terminator accepted by the filter
+func (self *ring) lookup(key string, accept func(terminatorId string) bool) string {
+	if len(self.points) == 0 {
+		return ""
+	}
+
+	keyHash := hash(key)
+	start := sort.Search(len(self.points), func(i int) bool {
+		return self.points[i].hash >= keyHash
+	})
+
+	for i := 0; i < len(self.points); i++ {
+		p := self.points[(start+i)%len(self.points)]
+		if accept(p.terminatorId) {
+			return p.terminatorId
+		}
+	}
+
+	return ""
+}
+
+func hash(val string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(val))
+	// fnv doesn't avalanche well for keys which differ only in the last few bytes, so finish with a mix step
+	result := h.Sum64()
+	result ^= result >> 33
+	result *= 0xff51afd7ed558ccd
+	result ^= result >> 33
+	result *= 0xc4ceb9fe1a85ec53
+	result ^= result >> 33
+	return result
+}
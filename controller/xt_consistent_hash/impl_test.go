@@ -0,0 +1,169 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_consistent_hash
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openziti/sdk-golang/ziti/edge"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	"github.com/stretchr/testify/require"
+)
+
+type testDial struct {
+	clientId string
+	peerData xt.PeerData
+}
+
+func (self *testDial) GetClientId() string {
+	return self.clientId
+}
+
+func (self *testDial) GetPeerData() xt.PeerData {
+	return self.peerData
+}
+
+func (self *testDial) GetServiceTags() map[string]interface{} {
+	return nil
+}
+
+func (self *testDial) GetLocality() xt.Locality {
+	return xt.Locality{}
+}
+
+func (self *testDial) GetTerminatorLocalities([]xt.CostedTerminator) map[string]xt.Locality {
+	return nil
+}
+
+func newTerminators(count int) []xt.CostedTerminator {
+	var result []xt.CostedTerminator
+	for i := 0; i < count; i++ {
+		result = append(result, xt_common.NewTestTerminator(fmt.Sprintf("t%v", i)))
+	}
+	return result
+}
+
+func toTerminators(list []xt.CostedTerminator) []xt.Terminator {
+	var result []xt.Terminator
+	for _, t := range list {
+		result = append(result, t)
+	}
+	return result
+}
+
+func selectAll(t *testing.T, impl *strategy, terminators []xt.CostedTerminator, keys int) map[string]string {
+	result := map[string]string{}
+	for i := 0; i < keys; i++ {
+		clientId := fmt.Sprintf("identity-%v", i)
+		selected, err := impl.SelectForDial(&testDial{clientId: clientId}, terminators)
+		require.NoError(t, err)
+		result[clientId] = selected.GetId()
+	}
+	return result
+}
+
+func countMoved(before, after map[string]string) int {
+	moved := 0
+	for k, v := range before {
+		if after[k] != v {
+			moved++
+		}
+	}
+	return moved
+}
+
+func TestConsistentHashRemapping(t *testing.T) {
+	req := require.New(t)
+
+	const keys = 10000
+	impl := NewFactory().NewStrategy().(*strategy)
+	terminators := newTerminators(10)
+	req.NoError(impl.HandleTerminatorChange(xt.NewStrategyChangeEvent("s1", toTerminators(terminators), nil, nil, nil)))
+
+	before := selectAll(t, impl, terminators, keys)
+	req.Equal(before, selectAll(t, impl, terminators, keys))
+
+	counts := map[string]int{}
+	for _, id := range before {
+		counts[id]++
+	}
+	req.Len(counts, 10)
+	for _, count := range counts {
+		req.Greater(count, keys/20)
+	}
+
+	// a terminator joining takes roughly 1/11 of the keys, all from existing terminators
+	joined := xt_common.NewTestTerminator("t10")
+	terminators = append(terminators, joined)
+	req.NoError(impl.HandleTerminatorChange(xt.NewStrategyChangeEvent("s1", toTerminators(terminators), xt.TList(joined), nil, nil)))
+	afterJoin := selectAll(t, impl, terminators, keys)
+	moved := countMoved(before, afterJoin)
+	req.Greater(moved, keys/22)
+	req.Less(moved, keys/6)
+	for k, v := range before {
+		if afterJoin[k] != v {
+			req.Equal("t10", afterJoin[k])
+		}
+	}
+
+	// a terminator leaving only moves the keys it held
+	left := terminators[3]
+	req.NoError(impl.HandleTerminatorChange(xt.NewStrategyChangeEvent("s1", toTerminators(terminators), nil, nil, xt.TList(left))))
+	terminators = append(terminators[:3:3], terminators[4:]...)
+	afterLeave := selectAll(t, impl, terminators, keys)
+	for k, v := range afterJoin {
+		if v == "t3" {
+			req.NotEqual("t3", afterLeave[k])
+		} else {
+			req.Equal(v, afterLeave[k])
+		}
+	}
+}
+
+func TestConsistentHashKey(t *testing.T) {
+	req := require.New(t)
+
+	impl := NewFactory().NewStrategy().(*strategy)
+	terminators := newTerminators(5)
+
+	req.Equal("identity-1", GetHashKey(&testDial{clientId: "identity-1"}))
+	dial := &testDial{
+		clientId: "identity-1",
+		peerData: xt.PeerData{edge.AppDataHeader: []byte(`{"hash_key":"cart-1234"}`)},
+	}
+	req.Equal("cart-1234", GetHashKey(dial))
+	req.Equal("identity-1", GetHashKey(&testDial{
+		clientId: "identity-1",
+		peerData: xt.PeerData{edge.AppDataHeader: []byte(`{"dst_port":"80"}`)},
+	}))
+
+	// the ring is built on demand if no change event has been seen
+	selected, err := impl.SelectForDial(dial, terminators)
+	req.NoError(err)
+	for i := 0; i < 10; i++ {
+		next, err := impl.SelectForDial(&testDial{clientId: fmt.Sprintf("other-%v", i), peerData: dial.peerData}, terminators)
+		req.NoError(err)
+		req.Equal(selected, next)
+	}
+
+	// without a key the lowest cost terminator is used
+	selected, err = impl.SelectForDial(&testDial{}, terminators)
+	req.NoError(err)
+	req.Equal(terminators[0], selected)
+}
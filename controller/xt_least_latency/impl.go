@@ -0,0 +1,144 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_least_latency
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+)
+
+const (
+	Name = "leastlatency"
+
+	// latencyWeight is the weight given to each new dial time sample in the moving average
+	latencyWeight = 0.2
+
+	// failedDialLatency is the dial time recorded for a failed dial
+	failedDialLatency = 5 * time.Second
+)
+
+/**
+The leastlatency strategy selects the terminator with the lowest measured dial time. Dial time is measured from
+when the controller sends the route until the terminator's router reports the dial succeeded, and is tracked as an
+exponentially weighted moving average. Failed dials are recorded as a slow dial. Terminators which haven't been
+dialed yet are preferred, so that they get measured. To keep bursts from all going to the fastest terminator, the
+average is scaled by the number of dials in progress on each terminator. Only terminators with the best available
+precedence are considered. Failures also drive costs the same way as the smartrouting strategy.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+		},
+		loads: xt_common.NewTerminatorLoads(),
+		latencies: &dialLatencies{
+			averages: map[string]float64{},
+		},
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	loads     *xt_common.TerminatorLoads
+	latencies *dialLatencies
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	return self.loads.SelectMin(terminators, func(t xt.CostedTerminator, load xt_common.Load) float64 {
+		latency, found := self.latencies.get(t.GetId())
+		if !found {
+			return float64(load.Pending)
+		}
+		return latency * float64(1+load.Pending)
+	}), nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+	event.Accept(self.loads)
+	event.Accept(self.latencies)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		self.loads.Clear(t.GetId())
+		self.latencies.clear(t.GetId())
+	}
+	return nil
+}
+
+// dialLatencies tracks the moving average dial time per terminator, in milliseconds
+type dialLatencies struct {
+	xt.DefaultEventVisitor
+	lock     sync.Mutex
+	averages map[string]float64
+}
+
+func (self *dialLatencies) VisitDialFailed(event xt.TerminatorEvent) {
+	self.record(event.GetTerminator().GetId(), failedDialLatency)
+}
+
+func (self *dialLatencies) VisitDialSucceeded(event xt.TerminatorEvent) {
+	if event.GetDialDuration() > 0 {
+		self.record(event.GetTerminator().GetId(), event.GetDialDuration())
+	}
+}
+
+func (self *dialLatencies) record(terminatorId string, dialDuration time.Duration) {
+	sample := float64(dialDuration) / float64(time.Millisecond)
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if current, found := self.averages[terminatorId]; found {
+		self.averages[terminatorId] = current + latencyWeight*(sample-current)
+	} else {
+		self.averages[terminatorId] = sample
+	}
+}
+
+func (self *dialLatencies) get(terminatorId string) (float64, bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	result, found := self.averages[terminatorId]
+	return result, found
+}
+
+func (self *dialLatencies) clear(terminatorId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.averages, terminatorId)
+}
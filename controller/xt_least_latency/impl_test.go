@@ -0,0 +1,70 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_least_latency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeastLatencySelect(t *testing.T) {
+	req := require.New(t)
+
+	impl := NewFactory().NewStrategy().(*strategy)
+	fast := xt_common.NewTestTerminator("fast")
+	slow := xt_common.NewTestTerminator("slow")
+	terminators := []xt.CostedTerminator{slow, fast}
+
+	// unmeasured terminators are tried first
+	selected, err := impl.Select(terminators)
+	req.NoError(err)
+	req.Equal(slow, selected)
+	impl.NotifyEvent(xt.NewDialSucceededWithDuration(slow, 200*time.Millisecond))
+
+	selected, err = impl.Select(terminators)
+	req.NoError(err)
+	req.Equal(fast, selected)
+	impl.NotifyEvent(xt.NewDialSucceededWithDuration(fast, 20*time.Millisecond))
+
+	selected, err = impl.Select(terminators)
+	req.NoError(err)
+	req.Equal(fast, selected)
+
+	// the fast terminator's latency is scaled by its pending dials, so once nine are in progress it scores the
+	// same as the slow terminator, which wins the tie by coming first
+	for i := 0; i < 8; i++ {
+		_, _ = impl.Select(terminators)
+	}
+	selected, err = impl.Select(terminators)
+	req.NoError(err)
+	req.Equal(slow, selected)
+
+	// failures are recorded as slow dials
+	for i := 0; i < 10; i++ {
+		impl.NotifyEvent(xt.NewDialFailedEvent(fast))
+	}
+	latency, _ := impl.latencies.get("fast")
+	req.Greater(latency, float64(200))
+
+	req.NoError(impl.HandleTerminatorChange(xt.NewStrategyChangeEvent("s1", nil, nil, nil, xt.TList(fast))))
+	_, found := impl.latencies.get("fast")
+	req.False(found)
+}
@@ -0,0 +1,84 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_least_outstanding
+
+import (
+	"math"
+	"time"
+
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+)
+
+const (
+	Name = "leastoutstanding"
+)
+
+/**
+The leastoutstanding strategy selects the terminator with the fewest circuits, counting both established circuits
+and dials which are still in progress. Counting in-progress dials keeps bursts of circuits from all landing on the
+same terminator before any of them have completed. Only terminators with the best available precedence are
+considered and ties go to the terminator with the lowest cost. Failed dials count against a terminator for a short
+period, so a terminator which fails quickly doesn't look idle. Failures also drive costs the same way as the
+smartrouting strategy.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+		},
+		loads: xt_common.NewTerminatorLoads(),
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	loads *xt_common.TerminatorLoads
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	return self.loads.SelectMin(terminators, func(_ xt.CostedTerminator, load xt_common.Load) float64 {
+		return float64(load.Total())
+	}), nil
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+	event.Accept(self.loads)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		self.loads.Clear(t.GetId())
+	}
+	return nil
+}
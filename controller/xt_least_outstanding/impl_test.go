@@ -0,0 +1,146 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_least_outstanding
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStrategy() *strategy {
+	return NewFactory().NewStrategy().(*strategy)
+}
+
+func selectTerminator(t *testing.T, s *strategy, terminators ...xt.CostedTerminator) xt.CostedTerminator {
+	selected, err := s.Select(terminators)
+	require.NoError(t, err)
+	return selected
+}
+
+func TestSelectFewestOutstanding(t *testing.T) {
+	req := require.New(t)
+
+	s := newTestStrategy()
+	t1 := xt_common.NewTestTerminator("t1")
+	t2 := xt_common.NewTestTerminator("t2")
+	t3 := xt_common.NewTestTerminator("t3")
+
+	// t1 has two established circuits, t2 has one and t3 has a dial in progress
+	for _, terminator := range []*xt_common.TestTerminator{t1, t1, t2} {
+		req.Equal(terminator, selectTerminator(t, s, terminator))
+		s.NotifyEvent(xt.NewDialSucceeded(terminator))
+	}
+	req.Equal(t3, selectTerminator(t, s, t3))
+
+	req.Equal(xt_common.Load{Active: 2}, s.loads.GetLoad("t1"))
+	req.Equal(xt_common.Load{Active: 1}, s.loads.GetLoad("t2"))
+	req.Equal(xt_common.Load{Pending: 1}, s.loads.GetLoad("t3"))
+
+	// t2 and t3 each have one outstanding circuit, t2 wins the tie as it comes first
+	req.Equal(t2, selectTerminator(t, s, t1, t2, t3))
+	req.Equal(t3, selectTerminator(t, s, t1, t2, t3))
+
+	// everything now has two outstanding circuits, so the first listed, lowest cost, terminator is used
+	req.Equal(t1, selectTerminator(t, s, t1, t2, t3))
+	req.Equal(xt_common.Load{Active: 2, Pending: 1}, s.loads.GetLoad("t1"))
+}
+
+func TestSelectTies(t *testing.T) {
+	req := require.New(t)
+
+	s := newTestStrategy()
+	t1 := xt_common.NewTestTerminator("t1")
+	t2 := xt_common.NewTestTerminator("t2")
+	t3 := xt_common.NewTestTerminator("t3")
+
+	// with no load, ties go to the first terminator in the list, which is the one with the lowest cost
+	req.Equal(t2, selectTerminator(t, s, t2, t1, t3))
+
+	// dials in progress are counted, so a burst is spread across tied terminators in list order
+	req.Equal(t1, selectTerminator(t, s, t2, t1, t3))
+	req.Equal(t3, selectTerminator(t, s, t2, t1, t3))
+	req.Equal(t2, selectTerminator(t, s, t2, t1, t3))
+
+	// only terminators with the best precedence are considered, however idle the others are
+	required := &xt_common.TestTerminator{Id: "required", Precedence: xt.Precedences.Required}
+	req.Equal(required, selectTerminator(t, s, required, t1))
+	req.Equal(required, selectTerminator(t, s, required, t1))
+	req.Equal(xt_common.Load{Pending: 2}, s.loads.GetLoad("required"))
+}
+
+func TestCircuitRemovedDecrements(t *testing.T) {
+	req := require.New(t)
+
+	s := newTestStrategy()
+	t1 := xt_common.NewTestTerminator("t1")
+	t2 := xt_common.NewTestTerminator("t2")
+
+	for _, terminator := range []*xt_common.TestTerminator{t1, t1, t2} {
+		req.Equal(terminator, selectTerminator(t, s, terminator))
+		s.NotifyEvent(xt.NewDialSucceeded(terminator))
+	}
+	req.Equal(t2, selectTerminator(t, s, t1, t2))
+	s.NotifyEvent(xt.NewDialSucceeded(t2))
+
+	s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	req.Equal(xt_common.Load{Active: 1}, s.loads.GetLoad("t1"))
+	req.Equal(t1, selectTerminator(t, s, t2, t1))
+	s.NotifyEvent(xt.NewDialSucceeded(t1))
+
+	// removing more circuits than were established doesn't take the count below zero
+	for i := 0; i < 4; i++ {
+		s.NotifyEvent(xt.NewCircuitRemoved(t1))
+	}
+	req.Equal(xt_common.Load{}, s.loads.GetLoad("t1"))
+	req.Equal(t1, selectTerminator(t, s, t2, t1))
+
+	// removing a terminator drops its counts
+	req.NoError(s.HandleTerminatorChange(xt.NewStrategyChangeEvent("s1", nil, nil, nil, []xt.Terminator{t2})))
+	req.Equal(xt_common.Load{}, s.loads.GetLoad("t2"))
+}
+
+func TestDialFailedDecrements(t *testing.T) {
+	req := require.New(t)
+
+	s := newTestStrategy()
+	t1 := xt_common.NewTestTerminator("t1")
+	t2 := xt_common.NewTestTerminator("t2")
+
+	req.Equal(t1, selectTerminator(t, s, t1, t2))
+	req.Equal(t2, selectTerminator(t, s, t1, t2))
+	req.Equal(xt_common.Load{Pending: 1}, s.loads.GetLoad("t1"))
+
+	// a failed dial is no longer pending, but counts against the terminator for the failure penalty period, so a
+	// terminator which fails fast doesn't attract every new circuit
+	s.NotifyEvent(xt.NewDialFailedEvent(t1))
+	req.Equal(xt_common.Load{RecentFailures: 1}, s.loads.GetLoad("t1"))
+	s.NotifyEvent(xt.NewDialSucceeded(t2))
+	req.Equal(t1, selectTerminator(t, s, t1, t2))
+	s.NotifyEvent(xt.NewDialFailedEvent(t1))
+	req.Equal(xt_common.Load{RecentFailures: 2}, s.loads.GetLoad("t1"))
+	req.Equal(t2, selectTerminator(t, s, t1, t2))
+
+	// once the penalty expires, only the outstanding circuits count
+	s.loads.FailurePenalty = 0
+	time.Sleep(time.Millisecond)
+	req.Equal(xt_common.Load{}, s.loads.GetLoad("t1"))
+	req.Equal(t1, selectTerminator(t, s, t1, t2))
+}
@@ -0,0 +1,257 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_locality
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+)
+
+const (
+	Name = "locality"
+
+	// PolicyTag is the service tag used to select the locality policy
+	PolicyTag = "localityPolicy"
+	// SpilloverLoadTag is the service tag holding the number of circuits a terminator may carry before it's
+	// considered saturated
+	SpilloverLoadTag = "localitySpilloverLoad"
+	// SpilloverFailuresTag is the service tag holding the number of recent dial failures after which a terminator
+	// is considered failed
+	SpilloverFailuresTag = "localitySpilloverFailures"
+
+	PolicyPreferRegion  = "preferRegion"
+	PolicyPreferZone    = "preferZone"
+	PolicyRequireRegion = "requireRegion"
+
+	DefaultPolicy            = PolicyPreferRegion
+	DefaultSpilloverFailures = 3
+)
+
+/**
+The locality strategy prefers terminators which are close to the dialer. Localities come from the region and zone
+tags on identities and routers. The dialer's locality is that of the dialing identity, falling back to the router
+the dial came in on. A terminator's locality is that of its hosting identity, falling back to its router.
+
+The policy is set per service with the localityPolicy tag:
+
+  preferRegion  - use terminators in the dialer's region, failing over to other regions. This is the default
+  preferZone    - use terminators in the dialer's zone, failing over to the rest of the region, then other regions
+  requireRegion - only use terminators in the dialer's region
+
+A locality spills over to the next one when none of its terminators are usable. A terminator is unusable if its
+precedence is failed, if it has had localitySpilloverFailures dial failures recently (default 3), or if it's
+saturated, meaning it's carrying localitySpilloverLoad circuits or more. Saturation is only checked if
+localitySpilloverLoad is set. Within a locality, the terminator with the fewest circuits is selected. If every
+locality has spilled over, the least loaded terminator with the best precedence is used, from the dialer's region
+only if the policy is requireRegion. Dialers without a region are treated as local to every terminator.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	strategy := &strategy{
+		CostVisitor: xt_common.CostVisitor{
+			FailureCosts: xt.NewFailureCosts(math.MaxUint16/4, 20, 2),
+		},
+		loads: xt_common.NewTerminatorLoads(),
+	}
+	strategy.CostVisitor.FailureCosts.CreditOverTime(5, time.Minute)
+	return strategy
+}
+
+type strategy struct {
+	xt_common.CostVisitor
+	loads *xt_common.TerminatorLoads
+}
+
+type policy struct {
+	name              string
+	spilloverLoad     int
+	spilloverFailures int
+}
+
+func getPolicy(tags map[string]interface{}) (*policy, error) {
+	result := &policy{
+		name:              DefaultPolicy,
+		spilloverFailures: DefaultSpilloverFailures,
+	}
+
+	if val, found := tags[PolicyTag]; found {
+		name, ok := val.(string)
+		if !ok || (name != PolicyPreferRegion && name != PolicyPreferZone && name != PolicyRequireRegion) {
+			return nil, errorz.NewFieldError(fmt.Sprintf("%v must be one of %v, %v or %v",
+				PolicyTag, PolicyPreferRegion, PolicyPreferZone, PolicyRequireRegion), "tags."+PolicyTag, val)
+		}
+		result.name = name
+	}
+
+	var err error
+	if result.spilloverLoad, err = getIntTag(tags, SpilloverLoadTag, 0); err != nil {
+		return nil, err
+	}
+	if result.spilloverFailures, err = getIntTag(tags, SpilloverFailuresTag, DefaultSpilloverFailures); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func getIntTag(tags map[string]interface{}, name string, defaultValue int) (int, error) {
+	val, found := tags[name]
+	if !found {
+		return defaultValue, nil
+	}
+
+	var result int
+	switch v := val.(type) {
+	case int:
+		result = v
+	case int64:
+		result = int(v)
+	case float64:
+		result = int(v)
+	case string:
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, errorz.NewFieldError(name+" must be an integer", "tags."+name, val)
+		}
+		result = i
+	default:
+		return 0, errorz.NewFieldError(name+" must be an integer", "tags."+name, val)
+	}
+
+	if result < 0 {
+		return 0, errorz.NewFieldError(name+" must not be negative", "tags."+name, val)
+	}
+	return result, nil
+}
+
+// ValidateTags checks the locality tags of a service. Services are checked when they're created or updated, since
+// invalid tags would otherwise cause every dial of the service to fail
+func ValidateTags(tags map[string]interface{}) error {
+	_, err := getPolicy(tags)
+	return err
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	return self.selectLeastLoaded(terminators), nil
+}
+
+func (self *strategy) SelectForDial(dial xt.DialContext, terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	p, err := getPolicy(dial.GetServiceTags())
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := getTiers(p, dial, terminators)
+	for _, tier := range tiers {
+		var usable []xt.CostedTerminator
+		for _, t := range tier {
+			if self.isUsable(p, t) {
+				usable = append(usable, t)
+			}
+		}
+		if len(usable) > 0 {
+			return self.selectLeastLoaded(usable), nil
+		}
+	}
+
+	if p.name == PolicyRequireRegion {
+		if len(tiers) == 0 || len(tiers[0]) == 0 {
+			return nil, fmt.Errorf("no terminators in region '%v'", dial.GetLocality().Region)
+		}
+		return self.selectLeastLoaded(tiers[0]), nil
+	}
+
+	return self.selectLeastLoaded(terminators), nil
+}
+
+func (self *strategy) isUsable(p *policy, t xt.CostedTerminator) bool {
+	if t.GetPrecedence().IsFailed() {
+		return false
+	}
+	load := self.loads.GetLoad(t.GetId())
+	if p.spilloverFailures > 0 && load.RecentFailures >= p.spilloverFailures {
+		return false
+	}
+	return p.spilloverLoad == 0 || load.Active+load.Pending < p.spilloverLoad
+}
+
+func (self *strategy) selectLeastLoaded(terminators []xt.CostedTerminator) xt.CostedTerminator {
+	terminators = xt.GetRelatedTerminators(terminators)
+	return self.loads.SelectMin(terminators, func(_ xt.CostedTerminator, load xt_common.Load) float64 {
+		return float64(load.Total())
+	})
+}
+
+// getTiers groups the terminators by how close they are to the dialer, closest first. Terminators keep their order,
+// so each tier is still sorted by cost
+func getTiers(p *policy, dial xt.DialContext, terminators []xt.CostedTerminator) [][]xt.CostedTerminator {
+	local := dial.GetLocality()
+	if local.Region == "" {
+		return [][]xt.CostedTerminator{terminators}
+	}
+
+	localities := dial.GetTerminatorLocalities(terminators)
+	var zone, region, other []xt.CostedTerminator
+	for _, t := range terminators {
+		locality := localities[t.GetId()]
+		if p.name == PolicyPreferZone && local.IsSameZone(locality) {
+			zone = append(zone, t)
+		} else if local.IsSameRegion(locality) {
+			region = append(region, t)
+		} else {
+			other = append(other, t)
+		}
+	}
+
+	switch p.name {
+	case PolicyPreferZone:
+		return [][]xt.CostedTerminator{zone, region, other}
+	case PolicyRequireRegion:
+		return [][]xt.CostedTerminator{region}
+	default:
+		return [][]xt.CostedTerminator{region, other}
+	}
+}
+
+func (self *strategy) NotifyEvent(event xt.TerminatorEvent) {
+	event.Accept(&self.CostVisitor)
+	event.Accept(self.loads)
+}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	for _, t := range event.GetRemoved() {
+		self.FailureCosts.Clear(t.GetId())
+		self.loads.Clear(t.GetId())
+	}
+	return nil
+}
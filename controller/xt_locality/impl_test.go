@@ -0,0 +1,150 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_locality
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/controller/xt"
+	"github.com/openziti/ziti/controller/xt_common"
+	"github.com/stretchr/testify/require"
+)
+
+// testTerminator adds a locality to the shared test terminator. Localities come from the dial context, which
+// reads them from the terminator in these tests
+type testTerminator struct {
+	*xt_common.TestTerminator
+	locality xt.Locality
+}
+
+type testDial struct {
+	tags     map[string]interface{}
+	locality xt.Locality
+}
+
+func (self *testDial) GetClientId() string                    { return "client" }
+func (self *testDial) GetPeerData() xt.PeerData               { return nil }
+func (self *testDial) GetServiceTags() map[string]interface{} { return self.tags }
+func (self *testDial) GetLocality() xt.Locality               { return self.locality }
+
+func (self *testDial) GetTerminatorLocalities(terminators []xt.CostedTerminator) map[string]xt.Locality {
+	result := map[string]xt.Locality{}
+	for _, t := range terminators {
+		result[t.GetId()] = t.(*testTerminator).locality
+	}
+	return result
+}
+
+func newTerminator(id, region, zone string) *testTerminator {
+	return &testTerminator{
+		TestTerminator: xt_common.NewTestTerminator(id),
+		locality:       xt.Locality{Region: region, Zone: zone},
+	}
+}
+
+func TestLocalityPreferRegion(t *testing.T) {
+	req := require.New(t)
+
+	impl := NewFactory().NewStrategy().(*strategy)
+	remote := newTerminator("remote", "us-west", "us-west-1a")
+	local1 := newTerminator("local1", "us-east", "us-east-1a")
+	local2 := newTerminator("local2", "us-east", "us-east-1b")
+	terminators := []xt.CostedTerminator{remote, local1, local2}
+
+	dial := &testDial{
+		tags:     map[string]interface{}{SpilloverLoadTag: float64(2)},
+		locality: xt.Locality{Region: "us-east", Zone: "us-east-1b"},
+	}
+
+	// local terminators are preferred, least loaded first, zones are ignored
+	selected, err := impl.SelectForDial(dial, terminators)
+	req.NoError(err)
+	req.Equal(local1, selected)
+	selected, err = impl.SelectForDial(dial, terminators)
+	req.NoError(err)
+	req.Equal(local2, selected)
+	_, _ = impl.SelectForDial(dial, terminators)
+	_, _ = impl.SelectForDial(dial, terminators)
+
+	// once the local terminators are saturated, circuits spill over to the remote region
+	selected, err = impl.SelectForDial(dial, terminators)
+	req.NoError(err)
+	req.Equal(remote, selected)
+
+	// local terminators with too many failures are skipped
+	impl = NewFactory().NewStrategy().(*strategy)
+	for i := 0; i < DefaultSpilloverFailures; i++ {
+		impl.NotifyEvent(xt.NewDialFailedEvent(local1))
+	}
+	local2.Precedence = xt.Precedences.Failed
+	selected, err = impl.SelectForDial(dial, terminators)
+	req.NoError(err)
+	req.Equal(remote, selected)
+
+	// without a region, terminators are selected purely on load
+	selected, err = impl.SelectForDial(&testDial{}, terminators)
+	req.NoError(err)
+	req.Equal(remote, selected)
+}
+
+func TestLocalityPreferZone(t *testing.T) {
+	req := require.New(t)
+
+	impl := NewFactory().NewStrategy().(*strategy)
+	remote := newTerminator("remote", "us-west", "us-west-1a")
+	region := newTerminator("region", "us-east", "us-east-1a")
+	zone := newTerminator("zone", "us-east", "us-east-1b")
+	terminators := []xt.CostedTerminator{remote, region, zone}
+
+	dial := &testDial{
+		tags:     map[string]interface{}{PolicyTag: PolicyPreferZone, SpilloverLoadTag: "1"},
+		locality: xt.Locality{Region: "us-east", Zone: "us-east-1b"},
+	}
+
+	for _, expected := range []xt.CostedTerminator{zone, region, remote} {
+		selected, err := impl.SelectForDial(dial, terminators)
+		req.NoError(err)
+		req.Equal(expected, selected)
+	}
+}
+
+func TestLocalityRequireRegion(t *testing.T) {
+	req := require.New(t)
+
+	impl := NewFactory().NewStrategy().(*strategy)
+	remote := newTerminator("remote", "us-west", "us-west-1a")
+	local := newTerminator("local", "us-east", "us-east-1a")
+
+	dial := &testDial{
+		tags:     map[string]interface{}{PolicyTag: PolicyRequireRegion, SpilloverLoadTag: 1},
+		locality: xt.Locality{Region: "us-east"},
+	}
+
+	// saturated local terminators are still used rather than leaving the region
+	for i := 0; i < 3; i++ {
+		selected, err := impl.SelectForDial(dial, []xt.CostedTerminator{remote, local})
+		req.NoError(err)
+		req.Equal(local, selected)
+	}
+
+	_, err := impl.SelectForDial(dial, []xt.CostedTerminator{remote})
+	req.Error(err)
+
+	dial.tags[PolicyTag] = "nearby"
+	_, err = impl.SelectForDial(dial, []xt.CostedTerminator{remote, local})
+	req.Error(err)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/openziti/transport/v2"
 	"github.com/openziti/ziti/common/config"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/tracing"
 	"github.com/openziti/ziti/router/forwarder"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/pkg/errors"
@@ -127,7 +128,8 @@ type Config struct {
 			Path string
 		}
 	}
-	Ctrl struct {
+	Tracing *tracing.Config
+	Ctrl    struct {
 		InitialEndpoints      []*UpdatableAddress
 		LocalBinding          string
 		DefaultRequestTimeout time.Duration
@@ -473,6 +475,16 @@ func LoadConfig(path string) (*Config, error) {
 		}
 	}
 
+	if value, found := cfgmap["tracing"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			if cfg.Tracing, err = tracing.LoadConfig(submap); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, errors.New("invalid tracing configuration, must be a map")
+		}
+	}
+
 	cfg.Ctrl.DefaultRequestTimeout = 5 * time.Second
 	cfg.Ctrl.Options = channel.DefaultOptions()
 	cfg.Ctrl.Heartbeats = *env.NewDefaultHeartbeatOptions()
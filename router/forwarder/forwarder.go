@@ -27,6 +27,7 @@ import (
 	"github.com/openziti/ziti/router/env"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/openziti/ziti/router/xlink"
+	"github.com/orcaman/concurrent-map/v2"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"time"
@@ -40,6 +41,8 @@ type Forwarder struct {
 	traceController trace.Controller
 	Options         *Options
 	CloseNotify     <-chan struct{}
+	// linkRetransmissions tracks payloads retransmitted over each link, which the controller folds into link costs
+	linkRetransmissions cmap.ConcurrentMap[string, metrics.Meter]
 }
 
 type Destination interface {
@@ -67,6 +70,8 @@ func NewForwarder(metricsRegistry metrics.UsageRegistry, faulter FaultReceiver,
 		traceController: trace.NewController(closeNotify),
 		Options:         options,
 		CloseNotify:     closeNotify,
+
+		linkRetransmissions: cmap.New[metrics.Meter](),
 	}
 	return f
 }
@@ -120,11 +125,20 @@ func (forwarder *Forwarder) HasDestination(address xgress.Address) bool {
 
 func (forwarder *Forwarder) RegisterLink(link xlink.LinkDestination) error {
 	forwarder.destinations.addDestination(xgress.Address(link.Id()), link)
+	if forwarder.metricsRegistry != nil {
+		meter := forwarder.metricsRegistry.Meter("link." + link.Id() + ".retransmissions")
+		if !forwarder.linkRetransmissions.SetIfAbsent(link.Id(), meter) {
+			meter.Dispose() // already registered, release the extra reference
+		}
+	}
 	return nil
 }
 
 func (forwarder *Forwarder) UnregisterLink(link xlink.LinkDestination) {
 	forwarder.destinations.removeDestinationIfMatches(xgress.Address(link.Id()), link)
+	if meter, found := forwarder.linkRetransmissions.Pop(link.Id()); found {
+		meter.Dispose()
+	}
 }
 
 func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
@@ -135,6 +149,11 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 	} else {
 		circuitFt = newForwardTable(ctrlId)
 	}
+	// reroutes from older controllers don't carry the circuit tags, so the class is only changed if it's given
+	if class, found := xgress.GetTrafficClass(route.Tags); found {
+		circuitFt.setTrafficClass(class)
+	}
+	seen := map[string]struct{}{}
 	for _, forward := range route.Forwards {
 		if !forwarder.HasDestination(xgress.Address(forward.DstAddress)) {
 			if forward.DstType == ctrl_pb.DestType_Link {
@@ -146,7 +165,14 @@ func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 			}
 			// It's an ingress destination, which isn't established until after routing has completed
 		}
-		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		// a second forward for the same source is the redundant path for the circuit
+		if _, found := seen[forward.SrcAddress]; found {
+			circuitFt.setDuplicateAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+		} else {
+			seen[forward.SrcAddress] = struct{}{}
+			circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
+			circuitFt.setDuplicateAddress(xgress.Address(forward.SrcAddress), "")
+		}
 	}
 	forwarder.circuits.setForwardTable(circuitId, circuitFt)
 	return nil
@@ -179,15 +205,27 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 	circuitId := payload.GetCircuitId()
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, markActive); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
+			class := forwardTable.getTrafficClass()
+			var err error
 			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				if err := dst.SendPayload(payload); err != nil {
-					return err
+				err = sendPayload(dst, payload, class)
+				if err == nil && !markActive {
+					forwarder.markRetransmission(dstAddr)
 				}
-				log.WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
-				return nil
 			} else {
-				return errors.Errorf("cannot forward payload, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
+				err = errors.Errorf("cannot forward payload, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
 			}
+			// the redundant path is used even if the primary destination is gone, as that's when it's needed
+			if dupAddr, found := forwardTable.getDuplicateAddress(srcAddr); found {
+				if dupErr := forwarder.forwardDuplicatePayload(dupAddr, payload, class); dupErr == nil {
+					err = nil
+				}
+			}
+			if err != nil {
+				return err
+			}
+			log.WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
+			return nil
 		} else {
 			return errors.Errorf("cannot forward payload, no destination address for circuit=%v src=%v", circuitId, srcAddr)
 		}
@@ -196,22 +234,35 @@ func (forwarder *Forwarder) forwardPayload(srcAddr xgress.Address, payload *xgre
 	}
 }
 
+// markRetransmission counts a retransmitted payload against the link it was sent over, if it went over a link
+func (forwarder *Forwarder) markRetransmission(dstAddr xgress.Address) {
+	if meter, found := forwarder.linkRetransmissions.Get(string(dstAddr)); found {
+		meter.Mark(1)
+	}
+}
+
 func (forwarder *Forwarder) ForwardAcknowledgement(srcAddr xgress.Address, acknowledgement *xgress.Acknowledgement) error {
 	log := pfxlog.ContextLogger(string(srcAddr))
 
 	circuitId := acknowledgement.CircuitId
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId, true); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
+			var err error
 			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
-				if err := dst.SendAcknowledgement(acknowledgement); err != nil {
-					return err
-				}
-				log.Debugf("=> %s", string(dstAddr))
-				return nil
-
+				err = dst.SendAcknowledgement(acknowledgement)
 			} else {
-				return errors.Errorf("cannot acknowledge, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
+				err = errors.Errorf("cannot acknowledge, no destination for circuit=%v src=%v dst=%v", circuitId, srcAddr, dstAddr)
+			}
+			if dupAddr, found := forwardTable.getDuplicateAddress(srcAddr); found {
+				if dupErr := forwarder.forwardDuplicateAcknowledgement(dupAddr, acknowledgement); dupErr == nil {
+					err = nil
+				}
 			}
+			if err != nil {
+				return err
+			}
+			log.Debugf("=> %s", string(dstAddr))
+			return nil
 
 		} else {
 			return errors.Errorf("cannot acknowledge, no destination address for circuit=%v src=%v", circuitId, srcAddr)
@@ -222,6 +273,37 @@ func (forwarder *Forwarder) ForwardAcknowledgement(srcAddr xgress.Address, ackno
 	}
 }
 
+// forwardDuplicatePayload sends a copy of the payload, flagged as redundant, over the redundant path of a circuit.
+// The circuit only needs one of the copies to make it, so the caller decides what to do with the error
+func (forwarder *Forwarder) forwardDuplicatePayload(dstAddr xgress.Address, payload *xgress.Payload, class xgress.TrafficClass) error {
+	dst, found := forwarder.destinations.getDestination(dstAddr)
+	if !found {
+		return errors.Errorf("cannot forward duplicate payload, no destination for circuit=%v dst=%v", payload.CircuitId, dstAddr)
+	}
+	duplicate := *payload
+	duplicate.Flags |= uint32(xgress.PayloadFlagRedundant)
+	return sendPayload(dst, &duplicate, class)
+}
+
+// sendPayload sends the payload to the destination. Links schedule payloads by the circuit's traffic class, while
+// xgress destinations take payloads in the order they arrive
+func sendPayload(dst Destination, payload *xgress.Payload, class xgress.TrafficClass) error {
+	if link, ok := dst.(xlink.LinkDestination); ok {
+		return link.SendPayloadWithClass(payload, class)
+	}
+	return dst.SendPayload(payload)
+}
+
+func (forwarder *Forwarder) forwardDuplicateAcknowledgement(dstAddr xgress.Address, acknowledgement *xgress.Acknowledgement) error {
+	dst, found := forwarder.destinations.getDestination(dstAddr)
+	if !found {
+		return errors.Errorf("cannot forward duplicate acknowledgement, no destination for circuit=%v dst=%v", acknowledgement.CircuitId, dstAddr)
+	}
+	duplicate := *acknowledgement
+	duplicate.Flags |= uint32(xgress.PayloadFlagRedundant)
+	return dst.SendAcknowledgement(&duplicate)
+}
+
 func (forwarder *Forwarder) ForwardControl(srcAddr xgress.Address, control *xgress.Control) error {
 	circuitId := control.CircuitId
 	log := pfxlog.ContextLogger(string(srcAddr)).WithField("circuitId", circuitId)
@@ -0,0 +1,190 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"testing"
+
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+type testDestination struct {
+	payloads []*xgress.Payload
+	acks     []*xgress.Acknowledgement
+}
+
+func (self *testDestination) SendPayload(payload *xgress.Payload) error {
+	self.payloads = append(self.payloads, payload)
+	return nil
+}
+
+func (self *testDestination) SendAcknowledgement(acknowledgement *xgress.Acknowledgement) error {
+	self.acks = append(self.acks, acknowledgement)
+	return nil
+}
+
+func (self *testDestination) SendControl(*xgress.Control) error {
+	return nil
+}
+
+func (self *testDestination) InspectCircuit(*inspect.CircuitInspectDetail) {}
+
+func TestRedundantPathForwarding(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	forwarder := NewForwarder(nil, nil, DefaultOptions(), closeNotify)
+
+	primary := &testDestination{}
+	redundant := &testDestination{}
+	forwarder.destinations.addDestination("l1", primary)
+	forwarder.destinations.addDestination("l2", redundant)
+
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c1",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l1", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+			{SrcAddress: "ingress", DstAddress: "l2", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l2", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	}))
+
+	payload := &xgress.Payload{Header: xgress.Header{CircuitId: "c1"}, Sequence: 1}
+	req.NoError(forwarder.ForwardPayload("ingress", payload))
+	req.Len(primary.payloads, 1)
+	req.False(primary.payloads[0].IsRedundantFlagSet())
+	req.Len(redundant.payloads, 1)
+	req.True(redundant.payloads[0].IsRedundantFlagSet())
+	req.False(payload.IsRedundantFlagSet())
+
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", xgress.NewAcknowledgement("c1", xgress.Initiator)))
+	req.Len(primary.acks, 1)
+	req.Len(redundant.acks, 1)
+	req.True(redundant.acks[0].IsRedundantFlagSet())
+
+	// a reroute without the redundant path removes the duplicate destination
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c1",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l1", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	}))
+
+	req.NoError(forwarder.ForwardPayload("ingress", payload))
+	req.Len(primary.payloads, 2)
+	req.Len(redundant.payloads, 1)
+}
+
+func TestRedundantPathForwardingAfterPrimaryLinkFails(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	forwarder := NewForwarder(nil, nil, DefaultOptions(), closeNotify)
+
+	primary := &testLink{id: "l1"}
+	redundant := &testLink{id: "l2"}
+	req.NoError(forwarder.RegisterLink(primary))
+	req.NoError(forwarder.RegisterLink(redundant))
+
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c1",
+		Forwards: []*ctrl_pb.Route_Forward{
+			{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l1", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+			{SrcAddress: "ingress", DstAddress: "l2", DstType: ctrl_pb.DestType_Link},
+			{SrcAddress: "l2", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+		},
+	}))
+
+	// when the primary link goes away, the copy on the redundant path still goes out
+	forwarder.UnregisterLink(primary)
+
+	payload := &xgress.Payload{Header: xgress.Header{CircuitId: "c1"}, Sequence: 1}
+	req.NoError(forwarder.ForwardPayload("ingress", payload))
+	req.Empty(primary.payloads)
+	req.Len(redundant.payloads, 1)
+	req.True(redundant.payloads[0].IsRedundantFlagSet())
+
+	req.NoError(forwarder.ForwardAcknowledgement("ingress", xgress.NewAcknowledgement("c1", xgress.Initiator)))
+	req.Empty(primary.acks)
+	req.Len(redundant.acks, 1)
+
+	// with neither link available, forwarding fails
+	forwarder.UnregisterLink(redundant)
+	req.Error(forwarder.ForwardPayload("ingress", payload))
+	req.Error(forwarder.ForwardAcknowledgement("ingress", xgress.NewAcknowledgement("c1", xgress.Initiator)))
+}
+
+type testLink struct {
+	testDestination
+	id      string
+	classes []xgress.TrafficClass
+}
+
+func (self *testLink) Id() string {
+	return self.id
+}
+
+func (self *testLink) SendPayloadWithClass(payload *xgress.Payload, class xgress.TrafficClass) error {
+	self.classes = append(self.classes, class)
+	return self.SendPayload(payload)
+}
+
+func TestTrafficClassForwarding(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	forwarder := NewForwarder(nil, nil, DefaultOptions(), closeNotify)
+
+	link := &testLink{}
+	forwarder.destinations.addDestination("l1", link)
+
+	forwards := []*ctrl_pb.Route_Forward{
+		{SrcAddress: "ingress", DstAddress: "l1", DstType: ctrl_pb.DestType_Link},
+		{SrcAddress: "l1", DstAddress: "ingress", DstType: ctrl_pb.DestType_Start},
+	}
+
+	// circuits without a traffic class use the default
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{CircuitId: "c1", Forwards: forwards}))
+	payload := &xgress.Payload{Header: xgress.Header{CircuitId: "c1"}, Sequence: 1}
+	req.NoError(forwarder.ForwardPayload("ingress", payload))
+	req.Equal([]xgress.TrafficClass{xgress.TrafficClassDefault}, link.classes)
+
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{
+		CircuitId: "c1",
+		Forwards:  forwards,
+		Tags:      map[string]string{xgress.CircuitTagTrafficClass: "bulk"},
+	}))
+	req.NoError(forwarder.ForwardPayload("ingress", payload))
+	req.NoError(forwarder.RetransmitPayload("ingress", payload))
+
+	// a reroute without tags keeps the class
+	req.NoError(forwarder.Route("ctrl", &ctrl_pb.Route{CircuitId: "c1", Forwards: forwards}))
+	req.NoError(forwarder.ForwardPayload("ingress", payload))
+
+	req.Equal([]xgress.TrafficClass{
+		xgress.TrafficClassDefault, xgress.TrafficClassBulk, xgress.TrafficClassBulk, xgress.TrafficClassBulk,
+	}, link.classes)
+}
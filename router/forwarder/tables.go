@@ -65,24 +65,53 @@ func (st *circuitTable) debug() string {
 	return out
 }
 
-// forwardTable implements a directory of destinations, keyed by source address.
+// forwardTable implements a directory of destinations, keyed by source address. Circuits with a redundant path
+// have a duplicate destination for the xgress addresses at either end, so payloads and acks are sent down both paths.
 type forwardTable struct {
 	ctrlId       string
 	last         int64
+	trafficClass atomic.Int32
 	destinations cmap.ConcurrentMap[string, string]
+	duplicates   cmap.ConcurrentMap[string, string]
 }
 
 func newForwardTable(ctrlId string) *forwardTable {
-	return &forwardTable{
+	result := &forwardTable{
 		ctrlId:       ctrlId,
 		destinations: cmap.New[string](),
+		duplicates:   cmap.New[string](),
 	}
+	result.setTrafficClass(xgress.TrafficClassDefault)
+	return result
+}
+
+func (ft *forwardTable) setTrafficClass(class xgress.TrafficClass) {
+	ft.trafficClass.Store(int32(class))
+}
+
+func (ft *forwardTable) getTrafficClass() xgress.TrafficClass {
+	return xgress.TrafficClass(ft.trafficClass.Load())
 }
 
 func (ft *forwardTable) setForwardAddress(src, dst xgress.Address) {
 	ft.destinations.Set(string(src), string(dst))
 }
 
+func (ft *forwardTable) setDuplicateAddress(src, dst xgress.Address) {
+	if dst == "" {
+		ft.duplicates.Remove(string(src))
+	} else {
+		ft.duplicates.Set(string(src), string(dst))
+	}
+}
+
+func (ft *forwardTable) getDuplicateAddress(src xgress.Address) (xgress.Address, bool) {
+	if dst, found := ft.duplicates.Get(string(src)); found {
+		return xgress.Address(dst), true
+	}
+	return "", false
+}
+
 func (ft *forwardTable) getForwardAddress(src xgress.Address) (xgress.Address, bool) {
 	if dst, found := ft.destinations.Get(string(src)); found {
 		return xgress.Address(dst), true
@@ -95,6 +124,9 @@ func (ft *forwardTable) debug() string {
 	for i := range ft.destinations.IterBuffered() {
 		out += fmt.Sprintf("\t\t@/%s -> @/%s\n", i.Key, i.Val)
 	}
+	for i := range ft.duplicates.IterBuffered() {
+		out += fmt.Sprintf("\t\t@/%s -> @/%s (duplicate)\n", i.Key, i.Val)
+	}
 	return out
 }
 
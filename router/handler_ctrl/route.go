@@ -17,6 +17,7 @@
 package handler_ctrl
 
 import (
+	"context"
 	"github.com/openziti/ziti/router/env"
 	"net"
 	"syscall"
@@ -29,12 +30,14 @@ import (
 	"github.com/openziti/ziti/common/ctrl_msg"
 	"github.com/openziti/ziti/common/logcontext"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
+	"github.com/openziti/ziti/common/tracing"
 	"github.com/openziti/ziti/controller/xt"
 	"github.com/openziti/ziti/router/forwarder"
 	"github.com/openziti/ziti/router/handler_xgress"
 	"github.com/openziti/ziti/router/xgress"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -90,14 +93,24 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 
 	log.Debugf("attempt [#%d] for [s/%s]", route.Attempt, route.CircuitId)
 
+	traceCtx, span := tracing.Tracer().Start(tracing.Extract(route.TraceContext), "router.route",
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			tracing.RouterIdKey.String(rh.id.Token),
+			tracing.CircuitIdKey.String(route.CircuitId),
+			tracing.AttemptKey.Int(int(route.Attempt)),
+		))
+
 	workF := func() {
+		defer span.End()
+
 		if route.Egress != nil {
 			if rh.forwarder.HasDestination(xgress.Address(route.Egress.Address)) {
 				log.Warnf("destination exists for [%s]", route.Egress.Address)
 				rh.completeRoute(msg, int(route.Attempt), route, nil, log)
 				return
 			} else {
-				rh.connectEgress(msg, int(route.Attempt), ch, route, ctx, time.Now().Add(time.Duration(route.Timeout)))
+				rh.connectEgress(traceCtx, msg, int(route.Attempt), ch, route, ctx, time.Now().Add(time.Duration(route.Timeout)))
 				return
 			}
 		} else {
@@ -108,6 +121,7 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 	// if the queue is full, don't wait, we can't hold up the control channel processing
 	if err := rh.pool.QueueOrError(workF); err != nil {
 		log.WithError(err).Error("error queuing route processing to pool")
+		tracing.EndSpan(span, err)
 		// don't send failure back. we can't delegate to another goroutine and if we sent from
 		// here we could block processing of incoming messages
 	}
@@ -148,7 +162,7 @@ func (rh *routeHandler) fail(msg *channel.Message, attempt int, route *ctrl_pb.R
 	}
 }
 
-func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch channel.Channel, route *ctrl_pb.Route, ctx logcontext.Context, deadline time.Time) {
+func (rh *routeHandler) connectEgress(traceCtx context.Context, msg *channel.Message, attempt int, ch channel.Channel, route *ctrl_pb.Route, ctx logcontext.Context, deadline time.Time) {
 	log := pfxlog.ChannelLogger(logcontext.EstablishPath).Wire(ctx).
 		WithField("context", ch.Label()).
 		WithField("circuitId", route.CircuitId).
@@ -171,7 +185,10 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 			}
 
 			params := newDialParams(rh.ch.Id(), route, bindHandler, ctx, deadline)
-			if peerData, err := dialer.Dial(params); err == nil {
+			_, dialSpan := tracing.Tracer().Start(traceCtx, "router.dial", trace.WithAttributes(tracing.BindingKey.String(route.Egress.Binding)))
+			peerData, err := dialer.Dial(params)
+			tracing.EndSpan(dialSpan, err)
+			if err == nil {
 				rh.completeRoute(msg, attempt, route, peerData, log)
 			} else {
 				var errCode byte
@@ -193,14 +193,16 @@ type xgressPeekHandler struct {
 }
 
 func (handler *xgressPeekHandler) Rx(x *xgress.Xgress, payload *xgress.Payload) {
-	msgSize := int64(len(payload.Data))
+	msgSize := int64(payload.GetUncompressedSize())
 	if x.Originator() == xgress.Initiator {
 		handler.usageCounter.Update(x, "ingress.rx", time.Now(), uint64(msgSize))
+		handler.updateCompressionUsage(x, "ingress.rx", payload)
 		handler.ingressRxMsgMeter.Mark(1)
 		handler.ingressRxBytesMeter.Mark(msgSize)
 		handler.ingressRxMsgSizeHistogram.Update(msgSize)
 	} else {
 		handler.usageCounter.Update(x, "egress.rx", time.Now(), uint64(msgSize))
+		handler.updateCompressionUsage(x, "egress.rx", payload)
 		handler.egressRxMsgMeter.Mark(1)
 		handler.egressRxBytesMeter.Mark(msgSize)
 		handler.egressRxMsgSizeHistogram.Update(msgSize)
@@ -208,20 +210,33 @@ func (handler *xgressPeekHandler) Rx(x *xgress.Xgress, payload *xgress.Payload)
 }
 
 func (handler *xgressPeekHandler) Tx(x *xgress.Xgress, payload *xgress.Payload) {
-	msgSize := int64(len(payload.Data))
+	msgSize := int64(payload.GetUncompressedSize())
 	if x.Originator() == xgress.Initiator {
 		handler.usageCounter.Update(x, "ingress.tx", time.Now(), uint64(msgSize))
+		handler.updateCompressionUsage(x, "ingress.tx", payload)
 
 		handler.ingressTxMsgMeter.Mark(1)
 		handler.ingressTxBytesMeter.Mark(msgSize)
 		handler.ingressTxMsgSizeHistogram.Update(msgSize)
 	} else {
 		handler.usageCounter.Update(x, "egress.tx", time.Now(), uint64(msgSize))
+		handler.updateCompressionUsage(x, "egress.tx", payload)
 		handler.egressTxMsgMeter.Mark(1)
 		handler.egressTxBytesMeter.Mark(msgSize)
 		handler.egressTxMsgSizeHistogram.Update(msgSize)
 	}
 }
 
+// updateCompressionUsage reports the size of compressed payloads as sent over the link, and before compression, so the
+// savings from compression can be seen. The regular usage counts are always the uncompressed sizes
+func (handler *xgressPeekHandler) updateCompressionUsage(x *xgress.Xgress, usageType string, payload *xgress.Payload) {
+	if !payload.IsCompressed() {
+		return
+	}
+	now := time.Now()
+	handler.usageCounter.Update(x, usageType+".compressed", now, uint64(len(payload.Data)))
+	handler.usageCounter.Update(x, usageType+".uncompressed", now, uint64(payload.GetUncompressedSize()))
+}
+
 func (handler *xgressPeekHandler) Close(*xgress.Xgress) {
 }
@@ -54,6 +54,7 @@ import (
 	fabricMetrics "github.com/openziti/ziti/common/metrics"
 	"github.com/openziti/ziti/common/pb/ctrl_pb"
 	"github.com/openziti/ziti/common/profiler"
+	"github.com/openziti/ziti/common/tracing"
 	"github.com/openziti/ziti/router/env"
 	"github.com/openziti/ziti/router/forwarder"
 	"github.com/openziti/ziti/router/handler_ctrl"
@@ -261,6 +262,7 @@ func (self *Router) Start() error {
 		return err
 	}
 	self.startProfiling()
+	self.startTracing()
 
 	healthChecker, err := self.initializeHealthChecks()
 	if err != nil {
@@ -369,6 +371,14 @@ func (self *Router) startProfiling() {
 	go newRouterMonitor(self.forwarder, self.shutdownC).Monitor()
 }
 
+func (self *Router) startTracing() {
+	if self.config.Tracing != nil {
+		if err := tracing.Start(self.config.Tracing, "ziti-router", self.config.Id.Token, self.shutdownC); err != nil {
+			logrus.WithError(err).Error("unable to start opentelemetry span export")
+		}
+	}
+}
+
 func (self *Router) initRateLimiterPool() error {
 	rateLimiterPoolConfig := goroutines.PoolConfig{
 		QueueSize:   uint32(self.forwarder.Options.RateLimiter.QueueLength),
@@ -613,6 +623,8 @@ func (self *Router) connectToController(addr transport.Address, bindHandler chan
 	routerMeta := &ctrl_pb.RouterMetadata{
 		Capabilities: []ctrl_pb.RouterCapability{
 			ctrl_pb.RouterCapability_LinkManagement,
+			ctrl_pb.RouterCapability_PayloadCompression,
+			ctrl_pb.RouterCapability_SelectiveAck,
 		},
 	}
 
@@ -0,0 +1,169 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"sync"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// CircuitTagCompression is the circuit tag which tells the xgress instances at each end of a circuit to compress the
+// payloads they send. The controller only sets it when the routers at both ends support payload compression. Since
+// compressed payloads are flagged, decompression doesn't depend on the tag
+const CircuitTagCompression = "compression"
+
+// minCompressSize is the smallest payload worth compressing
+const minCompressSize = 128
+
+type Compression string
+
+const (
+	CompressionNone   Compression = "none"
+	CompressionSnappy Compression = "snappy"
+	CompressionZstd   Compression = "zstd"
+)
+
+// ParseCompression returns the compression with the given name. An empty name is treated as no compression
+func ParseCompression(name string) (Compression, error) {
+	switch Compression(name) {
+	case "", CompressionNone:
+		return CompressionNone, nil
+	case CompressionSnappy:
+		return CompressionSnappy, nil
+	case CompressionZstd:
+		return CompressionZstd, nil
+	}
+	return CompressionNone, errors.Errorf("unsupported compression '%v', must be one of %v, %v or %v",
+		name, CompressionNone, CompressionSnappy, CompressionZstd)
+}
+
+func (self Compression) flag() PayloadFlag {
+	switch self {
+	case CompressionSnappy:
+		return PayloadFlagCompressedSnappy
+	case CompressionZstd:
+		return PayloadFlagCompressedZstd
+	}
+	return 0
+}
+
+var zstdInit sync.Once
+var zstdEncoder *zstd.Encoder
+var zstdDecoder *zstd.Decoder
+
+func initZstd() {
+	zstdInit.Do(func() {
+		var err error
+		if zstdEncoder, err = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest)); err != nil {
+			panic(err)
+		}
+		if zstdDecoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0)); err != nil {
+			panic(err)
+		}
+	})
+}
+
+// compress returns the compressed data, or nil if the compression isn't supported
+func (self Compression) compress(data []byte) []byte {
+	switch self {
+	case CompressionSnappy:
+		return s2.EncodeSnappy(nil, data)
+	case CompressionZstd:
+		initZstd()
+		return zstdEncoder.EncodeAll(data, nil)
+	}
+	return nil
+}
+
+// compressPayload compresses the payload data, if compression is enabled for the circuit and compressing makes the
+// data smaller. Payloads larger than the MTU are sent as is, so decompressed payloads never exceed it
+func (self *Xgress) compressPayload(payload *Payload) {
+	size := len(payload.Data)
+	if self.compression == CompressionNone || size < minCompressSize {
+		return
+	}
+
+	if self.Options.Mtu > 0 && size > int(self.Options.Mtu) {
+		return
+	}
+
+	compressed := self.compression.compress(payload.Data)
+	if compressed == nil || len(compressed) >= size {
+		return
+	}
+
+	payload.Data = compressed
+	payload.Flags |= uint32(self.compression.flag())
+	payload.uncompressedSize = size
+
+	compressionUncompressedBytes.Mark(int64(size))
+	compressionCompressedBytes.Mark(int64(len(compressed)))
+}
+
+// decompressPayload returns the uncompressed payload data. The payload keeps the compressed data, since buffer
+// accounting is done using the size that was sent over the link
+func (self *Xgress) decompressPayload(payload *Payload) ([]byte, error) {
+	if !payload.IsCompressed() {
+		return payload.Data, nil
+	}
+
+	maxSize := int(self.Options.Mtu)
+
+	var data []byte
+	var err error
+
+	if isPayloadFlagSet(payload.Flags, PayloadFlagCompressedSnappy) {
+		size, err := s2.DecodedLen(payload.Data)
+		if err != nil {
+			return nil, err
+		}
+		if maxSize > 0 && size > maxSize {
+			return nil, errors.Errorf("decompressed payload size %v exceeds mtu %v", size, maxSize)
+		}
+		data, err = s2.Decode(nil, payload.Data)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		initZstd()
+		if maxSize > 0 {
+			header := zstd.Header{}
+			if err = header.Decode(payload.Data); err != nil {
+				return nil, err
+			}
+			if header.HasFCS && header.FrameContentSize > uint64(maxSize) {
+				return nil, errors.Errorf("decompressed payload size %v exceeds mtu %v", header.FrameContentSize, maxSize)
+			}
+		}
+		if data, err = zstdDecoder.DecodeAll(payload.Data, nil); err != nil {
+			return nil, err
+		}
+		if maxSize > 0 && len(data) > maxSize {
+			return nil, errors.Errorf("decompressed payload size %v exceeds mtu %v", len(data), maxSize)
+		}
+	}
+
+	payload.uncompressedSize = len(data)
+
+	decompressionCompressedBytes.Mark(int64(len(payload.Data)))
+	decompressionUncompressedBytes.Mark(int64(len(data)))
+
+	return data, nil
+}
@@ -0,0 +1,112 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/openziti/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadCompression(t *testing.T) {
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	InitMetrics(metrics.NewUsageRegistry("test", map[string]string{}, closeNotify))
+
+	req := require.New(t)
+	compressible := bytes.Repeat([]byte(`{"name": "compressible", "value": 1234}`), 100)
+
+	newPayload := func(data []byte) *Payload {
+		return &Payload{
+			Header:   Header{CircuitId: "test"},
+			Sequence: 1,
+			Data:     append([]byte(nil), data...),
+		}
+	}
+
+	for _, compression := range []Compression{CompressionSnappy, CompressionZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			req := require.New(t)
+			sender := NewXgress("test", "ctrl", "test", nil, Initiator, DefaultOptions(), map[string]string{
+				CircuitTagCompression: string(compression),
+			})
+			receiver := NewXgress("test", "ctrl", "test", nil, Terminator, DefaultOptions(), nil)
+
+			payload := newPayload(compressible)
+			sender.compressPayload(payload)
+			req.True(payload.IsCompressed())
+			req.Less(len(payload.Data), len(compressible))
+			req.Equal(len(compressible), payload.GetUncompressedSize())
+
+			// the compression flag has to survive being sent over a link
+			received, err := UnmarshallPayload(payload.Marshall())
+			req.NoError(err)
+			req.True(received.IsCompressed())
+
+			data, err := receiver.decompressPayload(received)
+			req.NoError(err)
+			req.Equal(compressible, data)
+			req.Equal(len(compressible), received.GetUncompressedSize())
+
+			// receivers with a smaller mtu reject payloads which decompress to more than the mtu
+			options := DefaultOptions()
+			options.Mtu = int32(len(compressible) - 1)
+			smallMtuReceiver := NewXgress("test", "ctrl", "test", nil, Terminator, options, nil)
+			_, err = smallMtuReceiver.decompressPayload(received)
+			req.Error(err)
+		})
+	}
+
+	sender := NewXgress("test", "ctrl", "test", nil, Initiator, DefaultOptions(), map[string]string{
+		CircuitTagCompression: string(CompressionZstd),
+	})
+
+	// small payloads aren't compressed
+	payload := newPayload([]byte("small"))
+	sender.compressPayload(payload)
+	req.False(payload.IsCompressed())
+
+	// payloads which don't get smaller are sent as is
+	random := make([]byte, 4096)
+	_, err := rand.Read(random)
+	req.NoError(err)
+	payload = newPayload(random)
+	sender.compressPayload(payload)
+	req.False(payload.IsCompressed())
+	req.Equal(random, payload.Data)
+
+	// payloads larger than the mtu are sent as is
+	options := DefaultOptions()
+	options.Mtu = int32(len(compressible) - 1)
+	smallMtuSender := NewXgress("test", "ctrl", "test", nil, Initiator, options, map[string]string{
+		CircuitTagCompression: string(CompressionZstd),
+	})
+	payload = newPayload(compressible)
+	smallMtuSender.compressPayload(payload)
+	req.False(payload.IsCompressed())
+
+	// circuits without the tag, or with an unknown compression, don't compress
+	for _, tags := range []map[string]string{nil, {CircuitTagCompression: "lzma"}} {
+		uncompressed := NewXgress("test", "ctrl", "test", nil, Initiator, DefaultOptions(), tags)
+		payload = newPayload(compressible)
+		uncompressed.compressPayload(payload)
+		req.False(payload.IsCompressed())
+	}
+}
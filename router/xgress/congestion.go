@@ -0,0 +1,173 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"time"
+
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CongestionControlAimd grows the window as payloads are acknowledged and shrinks it when payloads are
+	// retransmitted. It's tuned using the TxPortal* and Retx* options
+	CongestionControlAimd = "aimd"
+	// CongestionControlBbr sizes the window and paces sends using estimates of the bottleneck bandwidth and the
+	// minimum round trip time, so it doesn't back off on loss alone
+	CongestionControlBbr = "bbr"
+)
+
+func validateCongestionControl(name string) error {
+	switch name {
+	case CongestionControlAimd, CongestionControlBbr:
+		return nil
+	}
+	return errors.Errorf("unsupported congestion control '%v', must be one of %v or %v",
+		name, CongestionControlAimd, CongestionControlBbr)
+}
+
+// CongestionController decides how many bytes a LinkSendBuffer may have outstanding, how fast payloads may be sent
+// and how long to wait for an acknowledgement before retransmitting. Controllers are only used from the send buffer's
+// run loop, so they don't need to be thread safe
+type CongestionController interface {
+	// WindowSize returns the number of unacknowledged bytes which may be outstanding
+	WindowSize() uint32
+	// RetxThreshold returns how many milliseconds to wait for an acknowledgement before retransmitting a payload
+	RetxThreshold() uint32
+	// PacingDelay returns how long to wait before sending the next payload. Controllers which don't pace return 0
+	PacingDelay(now time.Time) time.Duration
+	// PayloadSent is called when a payload is buffered. inFlight includes the payload
+	PayloadSent(size uint32, inFlight uint32, now time.Time)
+	// PayloadAcked is called when a buffered payload is acknowledged. inFlight no longer includes the payload
+	PayloadAcked(size uint32, inFlight uint32, now time.Time)
+	// DuplicateAck is called when an acknowledgement is received for a payload which is no longer buffered
+	DuplicateAck()
+	// PayloadRetransmitted is called when a payload is queued for retransmission
+	PayloadRetransmitted()
+	// RttSample is called with each round trip time measurement, in milliseconds
+	RttSample(rtt uint16, now time.Time)
+	// Inspect adds the controller state to the send buffer inspect detail
+	Inspect(detail *inspect.XgressSendBufferDetail)
+}
+
+// NewCongestionController returns the congestion controller configured in the given options
+func NewCongestionController(options *Options) CongestionController {
+	if options.CongestionControl == CongestionControlBbr {
+		return newBbrController(options)
+	}
+	return newAimdController(options)
+}
+
+type aimdController struct {
+	options        *Options
+	windowSize     uint32
+	accumulator    uint32
+	successfulAcks uint32
+	duplicateAcks  uint32
+	retransmits    uint32
+	retxScale      float64
+	retxThreshold  uint32
+	rto            rtoEstimator
+}
+
+func newAimdController(options *Options) *aimdController {
+	return &aimdController{
+		options:       options,
+		windowSize:    options.TxPortalStartSize,
+		retxThreshold: options.RetxStartMs,
+		retxScale:     options.RetxScale,
+	}
+}
+
+func (self *aimdController) WindowSize() uint32 {
+	return self.windowSize
+}
+
+func (self *aimdController) RetxThreshold() uint32 {
+	return self.retxThreshold
+}
+
+func (self *aimdController) PacingDelay(time.Time) time.Duration {
+	return 0
+}
+
+func (self *aimdController) PayloadSent(uint32, uint32, time.Time) {}
+
+func (self *aimdController) PayloadAcked(size uint32, _ uint32, _ time.Time) {
+	self.accumulator += size
+	self.successfulAcks++
+
+	if self.successfulAcks >= self.options.TxPortalIncreaseThresh {
+		self.successfulAcks = 0
+		delta := uint32(float64(self.accumulator) * self.options.TxPortalIncreaseScale)
+		self.windowSize += delta
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+		self.retxScale -= 0.02
+		if self.retxScale < self.options.RetxScale {
+			self.retxScale = self.options.RetxScale
+		}
+	}
+}
+
+func (self *aimdController) DuplicateAck() {
+	self.duplicateAcks++
+	if self.duplicateAcks >= self.options.TxPortalDupAckThresh {
+		self.duplicateAcks = 0
+		self.retxScale += 0.2
+	}
+}
+
+func (self *aimdController) PayloadRetransmitted() {
+	self.retransmits++
+	if self.retransmits >= self.options.TxPortalRetxThresh {
+		self.accumulator = 0
+		self.retransmits = 0
+		self.scale(self.options.TxPortalRetxScale)
+	}
+}
+
+func (self *aimdController) RttSample(rtt uint16, _ time.Time) {
+	self.rto.sample(time.Duration(rtt) * time.Millisecond)
+	self.retxThreshold = self.rto.rto(self.options, self.retxScale)
+}
+
+func (self *aimdController) scale(factor float64) {
+	self.windowSize = uint32(float64(self.windowSize) * factor)
+	if factor > 1 {
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+	} else if self.windowSize < self.options.TxPortalMinSize {
+		self.windowSize = self.options.TxPortalMinSize
+	}
+}
+
+func (self *aimdController) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.CongestionControl = CongestionControlAimd
+	detail.WindowSize = self.windowSize
+	detail.Accumulator = self.accumulator
+	detail.SuccessfulAcks = self.successfulAcks
+	detail.DuplicateAcks = self.duplicateAcks
+	detail.Retransmits = self.retransmits
+	detail.RetxScale = self.retxScale
+	detail.RetxThreshold = self.retxThreshold
+	detail.Rtt = self.rto.srtt.String()
+	detail.RttVariance = self.rto.rttVar.String()
+}
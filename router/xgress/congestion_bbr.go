@@ -0,0 +1,314 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"time"
+
+	"github.com/openziti/ziti/common/inspect"
+)
+
+const (
+	// bbrHighGain is used while searching for the bottleneck bandwidth, allowing the send rate to double every round
+	bbrHighGain = 2.885
+	// bbrCwndGain leaves room in the window for delayed and aggregated acks
+	bbrCwndGain = 2.0
+	// bbrBandwidthRounds is the number of rounds the bandwidth estimate is taken from
+	bbrBandwidthRounds = 10
+	// bbrFullBandwidthGrowth is the growth per round below which the bandwidth is considered found
+	bbrFullBandwidthGrowth = 1.25
+	// bbrFullBandwidthRounds is the number of rounds without growth after which startup ends
+	bbrFullBandwidthRounds = 3
+	// bbrMinRttWindow is how long a min rtt sample is used for, before the rtt is probed again
+	bbrMinRttWindow = 10 * time.Second
+	// bbrProbeRttDuration is how long the window is kept at its minimum while probing the rtt
+	bbrProbeRttDuration = 200 * time.Millisecond
+	// bbrMinRoundDuration keeps rounds long enough to get usable delivery rate samples on low latency links
+	bbrMinRoundDuration = 10 * time.Millisecond
+	// bbrPacingQuantum is the smallest pacing delay worth waiting for
+	bbrPacingQuantum = time.Millisecond
+)
+
+// bbrPacingGainCycle probes for more bandwidth for one round, then drains any queue it created in the next
+var bbrPacingGainCycle = []float64{1.25, 0.75, 1, 1, 1, 1, 1, 1}
+
+type bbrState string
+
+const (
+	bbrStartup  bbrState = "startup"
+	bbrDrain    bbrState = "drain"
+	bbrProbeBw  bbrState = "probeBw"
+	bbrProbeRtt bbrState = "probeRtt"
+)
+
+// bbrController is modeled on BBR. It estimates the bottleneck bandwidth from the rate at which payloads are acked
+// and the propagation delay from the minimum rtt. Sends are paced at the estimated bandwidth and the window is sized
+// to a multiple of the bandwidth delay product. Loss doesn't shrink the window, so lossy links aren't starved, and
+// the window isn't limited by a fixed growth rate, so links with a high bandwidth delay product can be filled
+type bbrController struct {
+	options       *Options
+	state         bbrState
+	pacingGain    float64
+	cwndGain      float64
+	windowSize    uint32
+	retxThreshold uint32
+
+	delivered       uint64
+	roundStart      time.Time
+	roundDelivered  uint64
+	roundCount      uint64
+	roundAppLimited bool
+	bwSamples       [bbrBandwidthRounds]uint64
+	maxBw           uint64
+
+	rto         rtoEstimator
+	minRtt      time.Duration
+	minRttStamp time.Time
+	probeMinRtt time.Duration
+
+	fullBw       uint64
+	fullBwRounds int
+	fullBwFound  bool
+	cycleIndex   int
+	probeRttDone time.Time
+	nextSendTime time.Time
+
+	duplicateAcks uint32
+	retransmits   uint32
+}
+
+func newBbrController(options *Options) *bbrController {
+	return &bbrController{
+		options:         options,
+		state:           bbrStartup,
+		pacingGain:      bbrHighGain,
+		cwndGain:        bbrHighGain,
+		windowSize:      options.TxPortalStartSize,
+		retxThreshold:   options.RetxStartMs,
+		roundAppLimited: true,
+	}
+}
+
+func (self *bbrController) WindowSize() uint32 {
+	return self.windowSize
+}
+
+func (self *bbrController) RetxThreshold() uint32 {
+	return self.retxThreshold
+}
+
+// pacingRate returns the rate payloads may be sent at, in bytes per second. Until the first bandwidth estimate is
+// available sends are only limited by the window
+func (self *bbrController) pacingRate() uint64 {
+	return uint64(float64(self.maxBw) * self.pacingGain)
+}
+
+// bdp returns the estimated bandwidth delay product, in bytes
+func (self *bbrController) bdp() uint64 {
+	return self.maxBw * uint64(self.minRtt) / uint64(time.Second)
+}
+
+func (self *bbrController) PacingDelay(now time.Time) time.Duration {
+	if self.pacingRate() == 0 {
+		return 0
+	}
+	if delay := self.nextSendTime.Sub(now); delay >= bbrPacingQuantum {
+		return delay
+	}
+	return 0
+}
+
+func (self *bbrController) PayloadSent(size uint32, inFlight uint32, now time.Time) {
+	// if sends are held back by the window or by pacing, the delivery rate this round reflects the path and not
+	// the application
+	if inFlight >= self.windowSize || !self.nextSendTime.Add(bbrPacingQuantum).Before(now) {
+		self.roundAppLimited = false
+	}
+
+	if rate := self.pacingRate(); rate > 0 {
+		if self.nextSendTime.Before(now) {
+			self.nextSendTime = now
+		}
+		self.nextSendTime = self.nextSendTime.Add(time.Duration(uint64(size) * uint64(time.Second) / rate))
+	}
+}
+
+func (self *bbrController) PayloadAcked(size uint32, inFlight uint32, now time.Time) {
+	self.delivered += uint64(size)
+
+	if self.roundStart.IsZero() {
+		self.roundStart = now
+		self.roundDelivered = self.delivered
+	} else if elapsed := now.Sub(self.roundStart); self.minRtt > 0 && elapsed >= max(self.minRtt, bbrMinRoundDuration) {
+		self.endRound(elapsed, inFlight, now)
+	}
+
+	self.checkProbeRtt(inFlight, now)
+	self.updateWindow(size)
+}
+
+func (self *bbrController) endRound(elapsed time.Duration, inFlight uint32, now time.Time) {
+	sample := (self.delivered - self.roundDelivered) * uint64(time.Second) / uint64(elapsed)
+	slot := self.roundCount % bbrBandwidthRounds
+
+	// samples from rounds where the application didn't send enough to fill the pipe understate the bandwidth, so
+	// they only count if they raise the estimate
+	if self.roundAppLimited && sample < self.maxBw {
+		self.bwSamples[slot] = self.maxBw
+	} else {
+		self.bwSamples[slot] = sample
+	}
+
+	self.maxBw = 0
+	for _, bw := range self.bwSamples {
+		self.maxBw = max(self.maxBw, bw)
+	}
+
+	appLimited := self.roundAppLimited
+	self.roundCount++
+	self.roundStart = now
+	self.roundDelivered = self.delivered
+	self.roundAppLimited = true
+
+	switch self.state {
+	case bbrStartup:
+		if self.maxBw >= uint64(float64(self.fullBw)*bbrFullBandwidthGrowth) {
+			self.fullBw = self.maxBw
+			self.fullBwRounds = 0
+		} else if !appLimited {
+			self.fullBwRounds++
+			if self.fullBwRounds >= bbrFullBandwidthRounds {
+				self.fullBwFound = true
+				self.enterState(bbrDrain)
+			}
+		}
+	case bbrProbeBw:
+		self.cycleIndex = (self.cycleIndex + 1) % len(bbrPacingGainCycle)
+		self.pacingGain = bbrPacingGainCycle[self.cycleIndex]
+	}
+
+	if self.state == bbrDrain && uint64(inFlight) <= self.bdp() {
+		self.enterProbeBw()
+	}
+}
+
+func (self *bbrController) enterState(state bbrState) {
+	self.state = state
+	switch state {
+	case bbrStartup:
+		self.pacingGain = bbrHighGain
+		self.cwndGain = bbrHighGain
+	case bbrDrain:
+		self.pacingGain = 1 / bbrHighGain
+		self.cwndGain = bbrHighGain
+	case bbrProbeRtt:
+		self.pacingGain = 1
+		self.cwndGain = 1
+	}
+}
+
+func (self *bbrController) enterProbeBw() {
+	self.state = bbrProbeBw
+	self.cwndGain = bbrCwndGain
+	self.cycleIndex = 0
+	self.pacingGain = bbrPacingGainCycle[self.cycleIndex]
+}
+
+// checkProbeRtt drains the pipe for a short time if the min rtt hasn't been seen in a while, so the estimate follows
+// path changes instead of being inflated by the queues the controller creates itself
+func (self *bbrController) checkProbeRtt(inFlight uint32, now time.Time) {
+	if self.state == bbrProbeRtt {
+		if !now.Before(self.probeRttDone) && inFlight <= self.options.TxPortalMinSize {
+			// the rtt seen with the pipe drained replaces the old min, which may no longer be reachable
+			if self.probeMinRtt > 0 {
+				self.minRtt = self.probeMinRtt
+			}
+			self.minRttStamp = now
+			if self.fullBwFound {
+				self.enterProbeBw()
+			} else {
+				self.enterState(bbrStartup)
+			}
+		}
+		return
+	}
+
+	if self.minRtt > 0 && now.Sub(self.minRttStamp) > bbrMinRttWindow {
+		self.enterState(bbrProbeRtt)
+		self.probeMinRtt = 0
+		self.probeRttDone = now.Add(max(bbrProbeRttDuration, self.minRtt))
+	}
+}
+
+func (self *bbrController) updateWindow(acked uint32) {
+	if self.state == bbrProbeRtt {
+		self.windowSize = self.options.TxPortalMinSize
+		return
+	}
+
+	target := uint64(float64(self.bdp()) * self.cwndGain)
+	window := uint64(self.windowSize)
+	if self.fullBwFound {
+		window = min(window+uint64(acked), target)
+	} else if target == 0 || window < target {
+		window += uint64(acked)
+	}
+
+	window = max(window, uint64(self.options.TxPortalMinSize))
+	window = min(window, uint64(self.options.TxPortalMaxSize))
+	self.windowSize = uint32(window)
+}
+
+func (self *bbrController) DuplicateAck() {
+	self.duplicateAcks++
+}
+
+func (self *bbrController) PayloadRetransmitted() {
+	self.retransmits++
+}
+
+func (self *bbrController) RttSample(rtt uint16, now time.Time) {
+	sample := max(time.Duration(rtt)*time.Millisecond, time.Millisecond)
+
+	self.rto.sample(sample)
+
+	if self.minRtt == 0 || sample <= self.minRtt {
+		self.minRtt = sample
+		self.minRttStamp = now
+	}
+
+	if self.state == bbrProbeRtt && (self.probeMinRtt == 0 || sample < self.probeMinRtt) {
+		self.probeMinRtt = sample
+	}
+
+	self.retxThreshold = self.rto.rto(self.options, self.options.RetxScale)
+}
+
+func (self *bbrController) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.CongestionControl = CongestionControlBbr
+	detail.CongestionState = string(self.state)
+	detail.WindowSize = self.windowSize
+	detail.DuplicateAcks = self.duplicateAcks
+	detail.Retransmits = self.retransmits
+	detail.RetxScale = self.options.RetxScale
+	detail.RetxThreshold = self.retxThreshold
+	detail.Rtt = self.rto.srtt.String()
+	detail.RttVariance = self.rto.rttVar.String()
+	detail.MinRtt = self.minRtt.String()
+	detail.BandwidthEstimate = self.maxBw
+	detail.PacingRate = self.pacingRate()
+}
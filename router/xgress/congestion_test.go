@@ -0,0 +1,169 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/ziti/common/inspect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCongestionControlOptions(t *testing.T) {
+	req := require.New(t)
+
+	options, err := LoadOptions(OptionsData{})
+	req.NoError(err)
+	req.IsType(&aimdController{}, NewCongestionController(options))
+
+	options, err = LoadOptions(OptionsData{"options": map[interface{}]interface{}{"congestionControl": "bbr"}})
+	req.NoError(err)
+	req.IsType(&bbrController{}, NewCongestionController(options))
+
+	_, err = LoadOptions(OptionsData{"options": map[interface{}]interface{}{"congestionControl": "reno"}})
+	req.Error(err)
+}
+
+func TestAimdController(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+	controller := newAimdController(options)
+	now := time.Now()
+
+	req.Equal(options.TxPortalStartSize, controller.WindowSize())
+	req.Equal(options.RetxStartMs, controller.RetxThreshold())
+
+	for i := uint32(0); i < options.TxPortalIncreaseThresh; i++ {
+		controller.PayloadAcked(1000, 0, now)
+	}
+	req.Equal(options.TxPortalStartSize+options.TxPortalIncreaseThresh*1000, controller.WindowSize())
+
+	// the first sample sets the variance to half the rtt
+	controller.RttSample(100, now)
+	req.Equal(uint32(100*options.RetxScale)+4*50+options.RetxAddMs, controller.RetxThreshold())
+
+	for i := uint32(0); i < options.TxPortalRetxThresh; i++ {
+		controller.PayloadRetransmitted()
+	}
+	req.Less(controller.WindowSize(), options.TxPortalStartSize+options.TxPortalIncreaseThresh*1000)
+	req.GreaterOrEqual(controller.WindowSize(), options.TxPortalMinSize)
+	req.Zero(controller.PacingDelay(now))
+}
+
+func TestBbrController(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+	controller := newBbrController(options)
+
+	// simulate a 10MB/s path with a 50ms rtt, delivering a 10KB payload every millisecond
+	const payloadSize = 10_000
+	const bdp = 500_000
+	now := time.Now()
+	rtt := uint16(50)
+
+	step := func(count int) {
+		for i := 0; i < count; i++ {
+			now = now.Add(time.Millisecond)
+			controller.RttSample(rtt, now)
+			controller.PayloadAcked(payloadSize, min(controller.WindowSize(), bdp), now)
+			controller.PayloadSent(payloadSize, controller.WindowSize(), now)
+		}
+	}
+
+	step(3000)
+
+	req.Equal(bbrProbeBw, controller.state)
+	req.InEpsilon(10_000_000, float64(controller.maxBw), 0.1)
+	req.Equal(50*time.Millisecond, controller.minRtt)
+	req.InEpsilon(bbrCwndGain*bdp, float64(controller.WindowSize()), 0.1)
+	req.Greater(controller.pacingRate(), uint64(0))
+	req.LessOrEqual(controller.pacingRate(), uint64(1.25*float64(controller.maxBw)))
+
+	// loss doesn't shrink the window
+	window := controller.WindowSize()
+	for i := 0; i < 1000; i++ {
+		controller.PayloadRetransmitted()
+	}
+	req.Equal(window, controller.WindowSize())
+
+	// once the min rtt is stale, the window drops to its minimum while the rtt is probed
+	rtt = 60
+	step(10_100)
+	req.Equal(bbrProbeRtt, controller.state)
+	req.Equal(options.TxPortalMinSize, controller.WindowSize())
+
+	// after probing, the higher rtt replaces the stale min
+	for i := 0; i < 300; i++ {
+		now = now.Add(time.Millisecond)
+		controller.RttSample(rtt, now)
+		controller.PayloadAcked(payloadSize, options.TxPortalMinSize, now)
+	}
+	req.Equal(bbrProbeBw, controller.state)
+	req.Equal(60*time.Millisecond, controller.minRtt)
+
+	detail := &inspect.XgressSendBufferDetail{}
+	controller.Inspect(detail)
+	req.Equal(CongestionControlBbr, detail.CongestionControl)
+	req.Equal(string(bbrProbeBw), detail.CongestionState)
+	req.Equal(controller.WindowSize(), detail.WindowSize)
+	req.Equal(controller.maxBw, detail.BandwidthEstimate)
+	req.Equal(controller.pacingRate(), detail.PacingRate)
+	req.Equal("60ms", detail.MinRtt)
+}
+
+func TestBbrPacing(t *testing.T) {
+	req := require.New(t)
+	controller := newBbrController(DefaultOptions())
+	now := time.Now()
+
+	// without a bandwidth estimate sends aren't paced
+	controller.PayloadSent(10_000, 10_000, now)
+	req.Zero(controller.PacingDelay(now))
+
+	controller.enterProbeBw()
+	controller.pacingGain = 1
+	controller.maxBw = 1_000_000
+
+	// at 1MB/s, each 10KB payload pushes the next send out by 10ms
+	controller.PayloadSent(10_000, 10_000, now)
+	controller.PayloadSent(10_000, 20_000, now)
+	req.Equal(20*time.Millisecond, controller.PacingDelay(now))
+	req.Equal(10*time.Millisecond, controller.PacingDelay(now.Add(10*time.Millisecond)))
+	req.Zero(controller.PacingDelay(now.Add(20 * time.Millisecond)))
+}
+
+func TestRtoEstimator(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+	estimator := &rtoEstimator{}
+
+	req.Equal(options.RetxStartMs, estimator.rto(options, 1))
+
+	// a steady rtt converges on the rtt plus the minimum variance allowance
+	for i := 0; i < 100; i++ {
+		estimator.sample(100 * time.Millisecond)
+	}
+	req.Equal(uint32(104), estimator.rto(options, 1))
+
+	// jitter raises the timeout, even though the average rtt is the same
+	for i := 0; i < 100; i++ {
+		estimator.sample(time.Duration(50+(i%2)*100) * time.Millisecond)
+	}
+	req.InDelta(100, estimator.srtt.Milliseconds(), 10)
+	req.Greater(estimator.rto(options, 1), uint32(250))
+}
@@ -40,6 +40,10 @@ func (d Decoder) Decode(msg *channel.Message) ([]byte, bool) {
 			meta := channel.NewTraceMessageDecode(DECODER, "Acknowledgement")
 			meta["circuitId"] = ack.CircuitId
 			meta["sequence"] = fmt.Sprintf("len(%d)", len(ack.Sequence))
+			if ack.Sack != nil {
+				meta["cumulativeAck"] = ack.Sack.CumulativeAck
+				meta["sackRanges"] = len(ack.Sack.Ranges)
+			}
 			switch ack.GetOriginator() {
 			case Initiator:
 				meta["originator"] = "i"
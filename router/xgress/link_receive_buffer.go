@@ -32,6 +32,7 @@ type LinkReceiveBuffer struct {
 	maxSequence        int32
 	size               uint32
 	lastBufferSizeSent uint32
+	received           []SequenceRange
 }
 
 func NewLinkReceiveBuffer() *LinkReceiveBuffer {
@@ -47,6 +48,8 @@ func (buffer *LinkReceiveBuffer) Size() uint32 {
 
 func (buffer *LinkReceiveBuffer) ReceiveUnordered(payload *Payload, maxSize uint32) bool {
 	if payload.GetSequence() <= buffer.sequence {
+		// already delivered. Either a retransmit or the copy sent over the redundant path of a circuit
+		duplicatePayloadsMeter.Mark(1)
 		return true
 	}
 
@@ -64,6 +67,9 @@ func (buffer *LinkReceiveBuffer) ReceiveUnordered(payload *Payload, maxSize uint
 		if payload.Sequence > buffer.maxSequence {
 			buffer.maxSequence = payload.Sequence
 		}
+		buffer.markReceived(payload.Sequence)
+	} else {
+		duplicatePayloadsMeter.Mark(1)
 	}
 	return true
 }
@@ -81,6 +87,7 @@ func (buffer *LinkReceiveBuffer) PeekHead() *Payload {
 func (buffer *LinkReceiveBuffer) Remove(payload *Payload) {
 	buffer.tree.Remove(payload.Sequence)
 	buffer.sequence = payload.Sequence
+	buffer.markDelivered(payload.Sequence)
 }
 
 func (buffer *LinkReceiveBuffer) getLastBufferSizeSent() uint32 {
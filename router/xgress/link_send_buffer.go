@@ -37,36 +37,36 @@ type PayloadBufferForwarder interface {
 // https://pkg.go.dev/sync/atomic#pkg-note-BUG
 // https://github.com/golang/go/issues/36606
 type LinkSendBuffer struct {
-	retxScale             float64
 	x                     *Xgress
 	buffer                map[int32]*txPayload
 	newlyBuffered         chan *txPayload
 	newlyReceivedAcks     chan *Acknowledgement
-	windowsSize           uint32
+	controller            CongestionController
 	linkSendBufferSize    uint32
 	linkRecvBufferSize    uint32
-	accumulator           uint32
-	successfulAcks        uint32
-	duplicateAcks         uint32
-	retransmits           uint32
 	closeNotify           chan struct{}
 	closed                atomic.Bool
 	blockedByLocalWindow  bool
 	blockedByRemoteWindow bool
-	retxThreshold         uint32
-	lastRtt               uint16
+	blockedByPacing       bool
+	redundant             bool
+	selectiveAcks         bool
+	cumulativeAck         int32
+	sackDupAcks           uint32
+	fastRetransmits       uint32
 	lastRetransmitTime    int64
 	closeWhenEmpty        atomic.Bool
 	inspectRequests       chan *sendBufferInspectEvent
 }
 
 type txPayload struct {
-	age        int64
-	payload    *Payload
-	retxQueued int32
-	x          *Xgress
-	next       *txPayload
-	prev       *txPayload
+	age               int64
+	payload           *Payload
+	retxQueued        int32
+	x                 *Xgress
+	next              *txPayload
+	prev              *txPayload
+	fastRetransmitted bool
 }
 
 func (self *txPayload) markSent() {
@@ -99,7 +99,8 @@ func (self *txPayload) isRetransmittable() bool {
 }
 
 func NewLinkSendBuffer(x *Xgress) *LinkSendBuffer {
-	logrus.Debugf("txPortalStartSize = %d, txPortalMinSize = %d",
+	logrus.Debugf("congestionControl = %s, txPortalStartSize = %d, txPortalMinSize = %d",
+		x.Options.CongestionControl,
 		x.Options.TxPortalStartSize,
 		x.Options.TxPortalMinSize)
 
@@ -110,10 +111,9 @@ func NewLinkSendBuffer(x *Xgress) *LinkSendBuffer {
 		buffer:            make(map[int32]*txPayload),
 		newlyBuffered:     make(chan *txPayload),
 		newlyReceivedAcks: make(chan *Acknowledgement, 2),
+		controller:        NewCongestionController(x.Options),
+		cumulativeAck:     -1,
 		closeNotify:       make(chan struct{}),
-		windowsSize:       x.Options.TxPortalStartSize,
-		retxThreshold:     x.Options.RetxStartMs,
-		retxScale:         x.Options.RetxScale,
 		inspectRequests:   make(chan *sendBufferInspectEvent, 1),
 	}
 
@@ -161,8 +161,9 @@ func (buffer *LinkSendBuffer) Close() {
 
 func (buffer *LinkSendBuffer) isBlocked() bool {
 	blocked := false
+	windowSize := buffer.controller.WindowSize()
 
-	if buffer.windowsSize < buffer.linkRecvBufferSize {
+	if windowSize < buffer.linkRecvBufferSize {
 		blocked = true
 		if !buffer.blockedByRemoteWindow {
 			buffer.blockedByRemoteWindow = true
@@ -173,7 +174,7 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 		atomic.AddInt64(&buffersBlockedByRemoteWindow, -1)
 	}
 
-	if buffer.windowsSize < buffer.linkSendBufferSize {
+	if windowSize < buffer.linkSendBufferSize {
 		blocked = true
 		if !buffer.blockedByLocalWindow {
 			buffer.blockedByLocalWindow = true
@@ -185,7 +186,7 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 	}
 
 	if blocked {
-		pfxlog.ContextLogger(buffer.x.Label()).Debugf("blocked=%v win_size=%v tx_buffer_size=%v rx_buffer_size=%v", blocked, buffer.windowsSize, buffer.linkSendBufferSize, buffer.linkRecvBufferSize)
+		pfxlog.ContextLogger(buffer.x.Label()).Debugf("blocked=%v win_size=%v tx_buffer_size=%v rx_buffer_size=%v", blocked, windowSize, buffer.linkSendBufferSize, buffer.linkRecvBufferSize)
 	}
 
 	return blocked
@@ -201,6 +202,11 @@ func (buffer *LinkSendBuffer) run() {
 	retransmitTicker := time.NewTicker(100 * time.Millisecond)
 	defer retransmitTicker.Stop()
 
+	pacingTimer := time.NewTimer(time.Hour)
+	pacingTimer.Stop()
+	defer pacingTimer.Stop()
+	var pacing <-chan time.Time
+
 	for {
 		// bias acks, process all pending, since that should not block
 		processingAcks := true
@@ -218,8 +224,16 @@ func (buffer *LinkSendBuffer) run() {
 
 		// don't block when we're closing, since the only thing that should still be coming in is end-of-circuit
 		// if we're blocked, but empty, let one payload in to reduce the chances of a stall
+		buffer.blockedByPacing = false
 		if buffer.isBlocked() && !buffer.closeWhenEmpty.Load() && buffer.linkSendBufferSize != 0 {
 			buffered = nil
+		} else if delay := buffer.controller.PacingDelay(time.Now()); delay > 0 && !buffer.closeWhenEmpty.Load() {
+			buffered = nil
+			buffer.blockedByPacing = true
+			if pacing == nil {
+				pacingTimer.Reset(delay)
+				pacing = pacingTimer.C
+			}
 		} else {
 			buffered = buffer.newlyBuffered
 		}
@@ -241,12 +255,16 @@ func (buffer *LinkSendBuffer) run() {
 			buffer.linkSendBufferSize += uint32(payloadSize)
 			atomic.AddInt64(&outstandingPayloads, 1)
 			atomic.AddInt64(&outstandingPayloadBytes, int64(payloadSize))
+			buffer.controller.PayloadSent(uint32(payloadSize), buffer.linkSendBufferSize, time.Now())
 			log.Tracef("buffering payload %v with size %v. payload buffer size: %v",
 				txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
 
 		case <-retransmitTicker.C:
 			buffer.retransmit()
 
+		case <-pacing:
+			pacing = nil
+
 		case <-buffer.closeNotify:
 			buffer.close()
 			return
@@ -264,42 +282,21 @@ func (buffer *LinkSendBuffer) close() {
 }
 
 func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
-	log := pfxlog.ContextLogger(buffer.x.Label()).WithFields(ack.GetLoggerFields())
+	if ack.IsRedundantFlagSet() {
+		buffer.redundant = true
+	}
+
+	now := time.Now()
 
 	for _, sequence := range ack.Sequence {
 		if txPayload, found := buffer.buffer[sequence]; found {
-			if txPayload.markAcked() { // if it's been queued for retransmission, remove it from the queue
-				retransmitter.queue(txPayload)
-			}
-
-			payloadSize := uint32(len(txPayload.payload.Data))
-			buffer.accumulator += payloadSize
-			buffer.successfulAcks++
-			delete(buffer.buffer, sequence)
-			atomic.AddInt64(&outstandingPayloads, -1)
-			atomic.AddInt64(&outstandingPayloadBytes, -int64(payloadSize))
-			buffer.linkSendBufferSize -= payloadSize
-			log.Debugf("removing payload %v with size %v. payload buffer size: %v",
-				txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
-
-			if buffer.successfulAcks >= buffer.x.Options.TxPortalIncreaseThresh {
-				buffer.successfulAcks = 0
-				delta := uint32(float64(buffer.accumulator) * buffer.x.Options.TxPortalIncreaseScale)
-				buffer.windowsSize += delta
-				if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-					buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-				}
-				buffer.retxScale -= 0.02
-				if buffer.retxScale < buffer.x.Options.RetxScale {
-					buffer.retxScale = buffer.x.Options.RetxScale
-				}
-			}
+			buffer.payloadAcked(txPayload, now)
 		} else { // duplicate ack
 			duplicateAcksMeter.Mark(1)
-			buffer.duplicateAcks++
-			if buffer.duplicateAcks >= buffer.x.Options.TxPortalDupAckThresh {
-				buffer.duplicateAcks = 0
-				buffer.retxScale += 0.2
+			// when the circuit has a redundant path every ack arrives twice, so duplicates don't point to
+			// retransmitting too early
+			if !buffer.redundant {
+				buffer.controller.DuplicateAck()
 			}
 		}
 	}
@@ -307,14 +304,29 @@ func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 	buffer.linkRecvBufferSize = ack.RecvBufferSize
 	if ack.RTT > 0 {
 		rtt := uint16(info.NowInMilliseconds()) - ack.RTT
-		if buffer.lastRtt > 0 {
-			rtt = (rtt + buffer.lastRtt) >> 1
-		}
-		buffer.lastRtt = rtt
-		buffer.retxThreshold = uint32(float64(rtt)*buffer.retxScale) + buffer.x.Options.RetxAddMs
+		buffer.controller.RttSample(rtt, now)
+	}
+
+	if ack.Sack != nil {
+		buffer.receiveSelectiveAck(ack, now)
 	}
 }
 
+func (buffer *LinkSendBuffer) payloadAcked(txPayload *txPayload, now time.Time) {
+	if txPayload.markAcked() { // if it's been queued for retransmission, remove it from the queue
+		retransmitter.queue(txPayload)
+	}
+
+	payloadSize := uint32(len(txPayload.payload.Data))
+	delete(buffer.buffer, txPayload.payload.Sequence)
+	atomic.AddInt64(&outstandingPayloads, -1)
+	atomic.AddInt64(&outstandingPayloadBytes, -int64(payloadSize))
+	buffer.linkSendBufferSize -= payloadSize
+	buffer.controller.PayloadAcked(payloadSize, buffer.linkSendBufferSize, now)
+	pfxlog.ContextLogger(buffer.x.Label()).Debugf("removing payload %v with size %v. payload buffer size: %v",
+		txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
+}
+
 func (buffer *LinkSendBuffer) retransmit() {
 	now := info.NowInMilliseconds()
 	if len(buffer.buffer) > 0 && (now-buffer.lastRetransmitTime) > 64 {
@@ -322,16 +334,11 @@ func (buffer *LinkSendBuffer) retransmit() {
 
 		retransmitted := 0
 		for _, v := range buffer.buffer {
-			if v.isRetransmittable() && uint32(now-v.getAge()) >= buffer.retxThreshold {
+			if v.isRetransmittable() && uint32(now-v.getAge()) >= buffer.controller.RetxThreshold() {
 				v.markQueued()
 				retransmitter.queue(v)
 				retransmitted++
-				buffer.retransmits++
-				if buffer.retransmits >= buffer.x.Options.TxPortalRetxThresh {
-					buffer.accumulator = 0
-					buffer.retransmits = 0
-					buffer.scale(buffer.x.Options.TxPortalRetxScale)
-				}
+				buffer.controller.PayloadRetransmitted()
 			}
 		}
 
@@ -342,35 +349,23 @@ func (buffer *LinkSendBuffer) retransmit() {
 	}
 }
 
-func (buffer *LinkSendBuffer) scale(factor float64) {
-	buffer.windowsSize = uint32(float64(buffer.windowsSize) * factor)
-	if factor > 1 {
-		if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-			buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-		}
-	} else if buffer.windowsSize < buffer.x.Options.TxPortalMinSize {
-		buffer.windowsSize = buffer.x.Options.TxPortalMinSize
-	}
-}
-
 func (buffer *LinkSendBuffer) inspect() *inspect.XgressSendBufferDetail {
 	timeSinceLastRetransmit := time.Duration(info.NowInMilliseconds()-buffer.lastRetransmitTime) * time.Millisecond
 	result := &inspect.XgressSendBufferDetail{
-		WindowSize:            buffer.windowsSize,
 		LinkSendBufferSize:    buffer.linkSendBufferSize,
 		LinkRecvBufferSize:    buffer.linkRecvBufferSize,
-		Accumulator:           buffer.accumulator,
-		SuccessfulAcks:        buffer.successfulAcks,
-		DuplicateAcks:         buffer.duplicateAcks,
-		Retransmits:           buffer.retransmits,
 		Closed:                buffer.closed.Load(),
 		BlockedByLocalWindow:  buffer.blockedByLocalWindow,
 		BlockedByRemoteWindow: buffer.blockedByRemoteWindow,
-		RetxScale:             buffer.retxScale,
-		RetxThreshold:         buffer.retxThreshold,
+		BlockedByPacing:       buffer.blockedByPacing,
+		SelectiveAcks:         buffer.selectiveAcks,
+		CumulativeAck:         buffer.cumulativeAck,
+		SackDupAcks:           buffer.sackDupAcks,
+		FastRetransmits:       buffer.fastRetransmits,
 		TimeSinceLastRetx:     timeSinceLastRetransmit.String(),
 		CloseWhenEmpty:        buffer.closeWhenEmpty.Load(),
 	}
+	buffer.controller.Inspect(result)
 	return result
 }
 
@@ -36,6 +36,8 @@ const (
 	HeaderKeyFlags          = 2258
 	HeaderKeyRecvBufferSize = 2259
 	HeaderKeyRTT            = 2260
+	HeaderKeyCumulativeAck  = 2261
+	HeaderKeySackRanges     = 2262
 
 	ContentTypePayloadType         = 1100
 	ContentTypeAcknowledgementType = 1101
@@ -68,6 +70,12 @@ const (
 	PayloadFlagCircuitEnd   PayloadFlag = 1
 	PayloadFlagOriginator   PayloadFlag = 2
 	PayloadFlagCircuitStart PayloadFlag = 4
+	// PayloadFlagRedundant marks the copy of a payload or ack sent over the redundant path of a circuit
+	PayloadFlagRedundant PayloadFlag = 8
+	// PayloadFlagCompressedSnappy marks payloads whose data has been compressed using snappy
+	PayloadFlagCompressedSnappy PayloadFlag = 16
+	// PayloadFlagCompressedZstd marks payloads whose data has been compressed using zstd
+	PayloadFlagCompressedZstd PayloadFlag = 32
 )
 
 type Header struct {
@@ -92,6 +100,10 @@ func (header *Header) GetOriginator() Originator {
 	return Initiator
 }
 
+func (header *Header) IsRedundantFlagSet() bool {
+	return isPayloadFlagSet(header.Flags, PayloadFlagRedundant)
+}
+
 func (header *Header) unmarshallHeader(msg *channel.Message) error {
 	circuitId, ok := msg.Headers[HeaderKeyCircuitId]
 	if !ok {
@@ -133,6 +145,8 @@ func NewAcknowledgement(circuitId string, originator Originator) *Acknowledgemen
 type Acknowledgement struct {
 	Header
 	Sequence []int32
+	// Sack is only set on acks from xgress instances which send selective acks
+	Sack *SelectiveAck
 }
 
 func (ack *Acknowledgement) GetSequence() []int32 {
@@ -174,6 +188,9 @@ func (ack *Acknowledgement) Marshall() *channel.Message {
 	msg := channel.NewMessage(ContentTypeAcknowledgementType, ack.marshallSequence())
 	msg.PutUint16Header(HeaderKeyRTT, ack.RTT)
 	ack.marshallHeader(msg)
+	if ack.Sack != nil {
+		ack.Sack.marshall(msg)
+	}
 	return msg
 }
 
@@ -186,17 +203,27 @@ func UnmarshallAcknowledgement(msg *channel.Message) (*Acknowledgement, error) {
 	if err := ack.unmarshallSequence(msg.Body); err != nil {
 		return nil, err
 	}
+	sack, err := unmarshallSelectiveAck(msg)
+	if err != nil {
+		return nil, err
+	}
+	ack.Sack = sack
 
 	return ack, nil
 }
 
 func (ack *Acknowledgement) GetLoggerFields() logrus.Fields {
-	return logrus.Fields{
+	result := logrus.Fields{
 		"circuitId":          ack.CircuitId,
 		"linkRecvBufferSize": ack.RecvBufferSize,
 		"seq":                fmt.Sprintf("%+v", ack.Sequence),
 		"RTT":                ack.RTT,
 	}
+	if ack.Sack != nil {
+		result["cumulativeAck"] = ack.Sack.CumulativeAck
+		result["sack"] = fmt.Sprintf("%+v", ack.Sack.Ranges)
+	}
+	return result
 }
 
 type Payload struct {
@@ -204,12 +231,30 @@ type Payload struct {
 	Sequence int32
 	Headers  map[uint8][]byte
 	Data     []byte
+
+	// uncompressedSize is the size of compressed data before compression. It's only known at the xgress instances
+	// which compressed or decompressed the payload
+	uncompressedSize int
 }
 
 func (payload *Payload) GetSequence() int32 {
 	return payload.Sequence
 }
 
+func (payload *Payload) IsCompressed() bool {
+	return isPayloadFlagSet(payload.Flags, PayloadFlagCompressedSnappy) ||
+		isPayloadFlagSet(payload.Flags, PayloadFlagCompressedZstd)
+}
+
+// GetUncompressedSize returns the size of the payload data before compression. For payloads which weren't
+// compressed, or whose uncompressed size isn't known, it's the size of the payload data
+func (payload *Payload) GetUncompressedSize() int {
+	if payload.IsCompressed() && payload.uncompressedSize > 0 {
+		return payload.uncompressedSize
+	}
+	return len(payload.Data)
+}
+
 func (payload *Payload) Marshall() *channel.Message {
 	msg := channel.NewMessage(ContentTypePayloadType, payload.Data)
 	for key, value := range payload.Headers {
@@ -14,6 +14,13 @@ var retransmissionFailures metrics.Meter
 var ackFailures metrics.Meter
 var payloadWriteTimer metrics.Timer
 var duplicateAcksMeter metrics.Meter
+var duplicatePayloadsMeter metrics.Meter
+var compressionUncompressedBytes metrics.Meter
+var compressionCompressedBytes metrics.Meter
+var decompressionCompressedBytes metrics.Meter
+var decompressionUncompressedBytes metrics.Meter
+var decompressionFailures metrics.Meter
+var fastRetransmitsMeter metrics.Meter
 
 var buffersBlockedByLocalWindow int64
 var buffersBlockedByRemoteWindow int64
@@ -29,6 +36,13 @@ func InitMetrics(registry metrics.UsageRegistry) {
 	ackFailures = registry.Meter("xgress.ack_failures")
 	payloadWriteTimer = registry.Timer("xgress.tx_write_time")
 	duplicateAcksMeter = registry.Meter("xgress.ack_duplicates")
+	duplicatePayloadsMeter = registry.Meter("xgress.payload_duplicates")
+	compressionUncompressedBytes = registry.Meter("xgress.compression.uncompressed_bytes")
+	compressionCompressedBytes = registry.Meter("xgress.compression.compressed_bytes")
+	decompressionCompressedBytes = registry.Meter("xgress.decompression.compressed_bytes")
+	decompressionUncompressedBytes = registry.Meter("xgress.decompression.uncompressed_bytes")
+	decompressionFailures = registry.Meter("xgress.decompression_failures")
+	fastRetransmitsMeter = registry.Meter("xgress.fast_retransmits")
 
 	registry.FuncGauge("xgress.blocked_by_local_window", func() int64 {
 		return atomic.LoadInt64(&buffersBlockedByLocalWindow)
@@ -18,6 +18,7 @@ package xgress
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/pkg/errors"
 	"time"
 )
@@ -38,6 +39,9 @@ type Options struct {
 	TxPortalRetxScale      float64
 	TxPortalDupAckThresh   uint32
 	TxPortalDupAckScale    float64
+	FastRetxThresh         uint32
+
+	CongestionControl string
 
 	RxBufferSize uint32
 	RetxStartMs  uint32
@@ -97,6 +101,18 @@ func LoadOptions(data OptionsData) (*Options, error) {
 			options.TxPortalDupAckScale = value.(float64)
 		}
 
+		if value, found := data["fastRetxThresh"]; found {
+			options.FastRetxThresh = uint32(value.(int))
+		}
+
+		if value, found := data["congestionControl"]; found {
+			congestionControl := fmt.Sprint(value)
+			if err := validateCongestionControl(congestionControl); err != nil {
+				return nil, errors.Wrap(err, "invalid 'congestionControl' value")
+			}
+			options.CongestionControl = congestionControl
+		}
+
 		if value, found := data["rxBufferSize"]; found {
 			options.RxBufferSize = uint32(value.(int))
 		}
@@ -157,6 +173,8 @@ func DefaultOptions() *Options {
 		TxPortalRetxScale:      0.75,
 		TxPortalDupAckThresh:   64,
 		TxPortalDupAckScale:    0.9,
+		FastRetxThresh:         3,
+		CongestionControl:      CongestionControlAimd,
 		RxBufferSize:           4 * 1024 * 1024,
 		RetxStartMs:            200,
 		RetxScale:              1.5,
@@ -0,0 +1,55 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import "time"
+
+// rtoMinVariance is the smallest variance allowance in the retransmit timeout. Rtts are measured in whole
+// milliseconds and acks are batched, so a steady rtt doesn't mean acks arrive with no jitter
+const rtoMinVariance = 4 * time.Millisecond
+
+// rtoEstimator tracks the smoothed rtt and rtt variance, following RFC 6298, and derives the retransmit timeout
+// from them. The timeout is the smoothed rtt, scaled by the retransmit scale, plus four times the variance, plus
+// the configured RetxAddMs. Until the first rtt is measured, the timeout is RetxStartMs
+type rtoEstimator struct {
+	srtt   time.Duration
+	rttVar time.Duration
+}
+
+func (self *rtoEstimator) sample(rtt time.Duration) {
+	if self.srtt == 0 {
+		self.srtt = rtt
+		self.rttVar = rtt / 2
+		return
+	}
+
+	delta := self.srtt - rtt
+	if delta < 0 {
+		delta = -delta
+	}
+	self.rttVar = (3*self.rttVar + delta) / 4
+	self.srtt = (7*self.srtt + rtt) / 8
+}
+
+// rto returns the retransmit timeout in milliseconds
+func (self *rtoEstimator) rto(options *Options, scale float64) uint32 {
+	if self.srtt == 0 {
+		return options.RetxStartMs
+	}
+	rto := time.Duration(float64(self.srtt)*scale) + max(4*self.rttVar, rtoMinVariance)
+	return uint32(rto.Milliseconds()) + options.RetxAddMs
+}
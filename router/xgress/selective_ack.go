@@ -0,0 +1,220 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/openziti/channel/v2"
+	"github.com/pkg/errors"
+)
+
+// CircuitTagSelectiveAck is the circuit tag which tells the xgress instances at each end of a circuit to add
+// selective ack information to the acks they send. The controller only sets it when the routers at both ends support
+// selective acks. Senders use the information whenever it's present, so if an older router on the path drops it, the
+// sender falls back to retransmitting on timeouts
+const CircuitTagSelectiveAck = "sack"
+
+// maxSackRanges limits the number of ranges sent with each ack. The lowest ranges are sent, since the gaps between
+// them are what's holding up delivery
+const maxSackRanges = 4
+
+// SequenceRange is an inclusive range of payload sequences
+type SequenceRange struct {
+	Start int32
+	End   int32
+}
+
+// SelectiveAck describes all payloads a receiver has buffered, not just the one being acknowledged, so a sender can
+// recover from lost acks and detect lost payloads without waiting for retransmit timeouts
+type SelectiveAck struct {
+	// CumulativeAck is the highest sequence for which the payload and all payloads before it have been received
+	CumulativeAck int32
+	// Ranges are the ranges of payloads received after the cumulative ack, in ascending order
+	Ranges []SequenceRange
+}
+
+func (self *SelectiveAck) marshall(msg *channel.Message) {
+	msg.PutUint32Header(HeaderKeyCumulativeAck, uint32(self.CumulativeAck))
+	if len(self.Ranges) == 0 {
+		return
+	}
+
+	buf := make([]byte, len(self.Ranges)*8)
+	nextWriteBuf := buf
+	for _, r := range self.Ranges {
+		binary.BigEndian.PutUint32(nextWriteBuf, uint32(r.Start))
+		binary.BigEndian.PutUint32(nextWriteBuf[4:], uint32(r.End))
+		nextWriteBuf = nextWriteBuf[8:]
+	}
+	msg.Headers[HeaderKeySackRanges] = buf
+}
+
+// unmarshallSelectiveAck returns the selective ack information in the message, or nil if the sender didn't include any
+func unmarshallSelectiveAck(msg *channel.Message) (*SelectiveAck, error) {
+	cumulativeAck, found := msg.GetUint32Header(HeaderKeyCumulativeAck)
+	if !found {
+		return nil, nil
+	}
+
+	result := &SelectiveAck{
+		CumulativeAck: int32(cumulativeAck),
+	}
+
+	data := msg.Headers[HeaderKeySackRanges]
+	if len(data)%8 != 0 {
+		return nil, errors.Errorf("received sack ranges with wrong number of bytes: %v", len(data))
+	}
+
+	for len(data) > 0 {
+		r := SequenceRange{
+			Start: int32(binary.BigEndian.Uint32(data)),
+			End:   int32(binary.BigEndian.Uint32(data[4:])),
+		}
+		lowest := result.CumulativeAck
+		if len(result.Ranges) > 0 {
+			lowest = result.Ranges[len(result.Ranges)-1].End
+		}
+		if r.Start > r.End || r.Start <= lowest {
+			return nil, errors.Errorf("received invalid sack range %v-%v with cumulative ack %v", r.Start, r.End, result.CumulativeAck)
+		}
+		result.Ranges = append(result.Ranges, r)
+		data = data[8:]
+	}
+
+	return result, nil
+}
+
+// markReceived adds a newly buffered sequence to the ranges of received payloads
+func (buffer *LinkReceiveBuffer) markReceived(sequence int32) {
+	ranges := buffer.received
+	idx := len(ranges)
+	for idx > 0 && ranges[idx-1].Start > sequence {
+		idx--
+	}
+
+	extendsPrev := idx > 0 && ranges[idx-1].End+1 == sequence
+	extendsNext := idx < len(ranges) && ranges[idx].Start-1 == sequence
+
+	switch {
+	case extendsPrev && extendsNext:
+		ranges[idx-1].End = ranges[idx].End
+		buffer.received = append(ranges[:idx], ranges[idx+1:]...)
+	case extendsPrev:
+		ranges[idx-1].End = sequence
+	case extendsNext:
+		ranges[idx].Start = sequence
+	default:
+		ranges = append(ranges, SequenceRange{})
+		copy(ranges[idx+1:], ranges[idx:])
+		ranges[idx] = SequenceRange{Start: sequence, End: sequence}
+		buffer.received = ranges
+	}
+}
+
+// markDelivered removes a payload which has been taken off the head of the buffer from the received ranges
+func (buffer *LinkReceiveBuffer) markDelivered(sequence int32) {
+	if len(buffer.received) > 0 && buffer.received[0].Start == sequence {
+		buffer.received[0].Start++
+		if buffer.received[0].Start > buffer.received[0].End {
+			buffer.received = buffer.received[1:]
+		}
+	}
+}
+
+func (buffer *LinkReceiveBuffer) selectiveAck() *SelectiveAck {
+	cumulativeAck := buffer.sequence
+	ranges := buffer.received
+	if len(ranges) > 0 && ranges[0].Start == cumulativeAck+1 {
+		cumulativeAck = ranges[0].End
+		ranges = ranges[1:]
+	}
+
+	if len(ranges) > maxSackRanges {
+		ranges = ranges[:maxSackRanges]
+	}
+
+	return &SelectiveAck{
+		CumulativeAck: cumulativeAck,
+		Ranges:        append([]SequenceRange(nil), ranges...),
+	}
+}
+
+func (buffer *LinkSendBuffer) receiveSelectiveAck(ack *Acknowledgement, now time.Time) {
+	sack := ack.Sack
+	buffer.selectiveAcks = true
+
+	if sack.CumulativeAck > buffer.cumulativeAck {
+		// payloads whose own acks were lost are covered by the cumulative ack
+		if int(sack.CumulativeAck-buffer.cumulativeAck) > len(buffer.buffer) {
+			for sequence, txPayload := range buffer.buffer {
+				if sequence <= sack.CumulativeAck {
+					buffer.payloadAcked(txPayload, now)
+				}
+			}
+		} else {
+			for sequence := buffer.cumulativeAck + 1; sequence <= sack.CumulativeAck; sequence++ {
+				if txPayload, found := buffer.buffer[sequence]; found {
+					buffer.payloadAcked(txPayload, now)
+				}
+			}
+		}
+		buffer.cumulativeAck = sack.CumulativeAck
+		buffer.sackDupAcks = 0
+	} else if len(sack.Ranges) > 0 && !ack.IsRedundantFlagSet() {
+		// payloads are arriving, but the cumulative ack isn't moving, so something before them is missing.
+		// The copies of acks sent over a redundant path don't count, since they'd double the count
+		buffer.sackDupAcks++
+	}
+
+	if threshold := buffer.x.Options.FastRetxThresh; threshold > 0 && buffer.sackDupAcks >= threshold {
+		buffer.fastRetransmit(sack, threshold)
+	}
+}
+
+// fastRetransmit retransmits the payloads in the gaps of the selective ack, once at least threshold payloads sent
+// after them have been received. Each payload is only fast retransmitted once. If the retransmission is lost as well,
+// it's retransmitted when its retransmit timeout expires
+func (buffer *LinkSendBuffer) fastRetransmit(sack *SelectiveAck, threshold uint32) {
+	var receivedAfter uint32
+	for i := len(sack.Ranges) - 1; i >= 0; i-- {
+		r := sack.Ranges[i]
+		receivedAfter += uint32(r.End-r.Start) + 1
+		if receivedAfter < threshold {
+			continue
+		}
+
+		gapStart := sack.CumulativeAck + 1
+		if i > 0 {
+			gapStart = sack.Ranges[i-1].End + 1
+		}
+
+		// the gap can't hold more payloads than are buffered, so larger gaps don't need to be searched
+		gapEnd := min(r.Start-1, gapStart+int32(len(buffer.buffer)))
+		for sequence := gapStart; sequence <= gapEnd; sequence++ {
+			if txPayload, found := buffer.buffer[sequence]; found && !txPayload.fastRetransmitted && txPayload.isRetransmittable() {
+				txPayload.fastRetransmitted = true
+				txPayload.markQueued()
+				retransmitter.queue(txPayload)
+				buffer.fastRetransmits++
+				fastRetransmitsMeter.Mark(1)
+				buffer.controller.PayloadRetransmitted()
+			}
+		}
+	}
+}
@@ -0,0 +1,183 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"math"
+	"testing"
+
+	"github.com/openziti/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+// initTestMetrics initializes the metrics, unless an earlier test already did. Xgress instances from earlier tests
+// may still be running, so the metrics can't be replaced
+func initTestMetrics(t *testing.T) {
+	if fastRetransmitsMeter == nil {
+		closeNotify := make(chan struct{})
+		t.Cleanup(func() {
+			close(closeNotify)
+		})
+		InitMetrics(metrics.NewUsageRegistry("test", map[string]string{}, closeNotify))
+	}
+}
+
+func TestSelectiveAckMarshalling(t *testing.T) {
+	req := require.New(t)
+
+	ack := NewAcknowledgement("test", Initiator)
+	ack.Sequence = []int32{7}
+	ack.Sack = &SelectiveAck{
+		CumulativeAck: 3,
+		Ranges:        []SequenceRange{{Start: 5, End: 5}, {Start: 7, End: 9}},
+	}
+
+	received, err := UnmarshallAcknowledgement(ack.Marshall())
+	req.NoError(err)
+	req.Equal(ack.Sequence, received.Sequence)
+	req.Equal(ack.Sack, received.Sack)
+
+	// acks from xgress instances which don't send selective acks are unchanged
+	ack.Sack = nil
+	received, err = UnmarshallAcknowledgement(ack.Marshall())
+	req.NoError(err)
+	req.Nil(received.Sack)
+
+	// a cumulative ack without ranges, before anything has been received
+	ack.Sack = &SelectiveAck{CumulativeAck: -1}
+	received, err = UnmarshallAcknowledgement(ack.Marshall())
+	req.NoError(err)
+	req.Equal(int32(-1), received.Sack.CumulativeAck)
+	req.Empty(received.Sack.Ranges)
+
+	for _, ranges := range [][]SequenceRange{{{Start: 3, End: 4}}, {{Start: 6, End: 5}}, {{Start: 8, End: 9}, {Start: 5, End: 6}}} {
+		ack.Sack = &SelectiveAck{CumulativeAck: 3, Ranges: ranges}
+		_, err = UnmarshallAcknowledgement(ack.Marshall())
+		req.Error(err)
+	}
+}
+
+func TestReceiveBufferSelectiveAck(t *testing.T) {
+	initTestMetrics(t)
+
+	req := require.New(t)
+	buffer := NewLinkReceiveBuffer()
+
+	receive := func(sequences ...int32) {
+		for _, sequence := range sequences {
+			req.True(buffer.ReceiveUnordered(&Payload{Sequence: sequence}, math.MaxUint32))
+		}
+	}
+
+	req.Equal(&SelectiveAck{CumulativeAck: -1}, buffer.selectiveAck())
+
+	receive(0, 1, 3, 6, 5, 9)
+	req.Equal(&SelectiveAck{
+		CumulativeAck: 1,
+		Ranges:        []SequenceRange{{Start: 3, End: 3}, {Start: 5, End: 6}, {Start: 9, End: 9}},
+	}, buffer.selectiveAck())
+
+	// filling a gap merges the ranges on either side
+	receive(4, 2)
+	req.Equal(&SelectiveAck{
+		CumulativeAck: 6,
+		Ranges:        []SequenceRange{{Start: 9, End: 9}},
+	}, buffer.selectiveAck())
+
+	// duplicates don't change anything
+	receive(4)
+	req.Equal(int32(6), buffer.selectiveAck().CumulativeAck)
+
+	// delivering payloads doesn't change what's been received
+	for payload := buffer.PeekHead(); payload != nil; payload = buffer.PeekHead() {
+		buffer.Remove(payload)
+	}
+	req.Equal(int32(6), buffer.sequence)
+	req.Equal(&SelectiveAck{
+		CumulativeAck: 6,
+		Ranges:        []SequenceRange{{Start: 9, End: 9}},
+	}, buffer.selectiveAck())
+
+	// only the lowest ranges are sent
+	receive(11, 13, 15, 17, 19)
+	req.Len(buffer.selectiveAck().Ranges, maxSackRanges)
+	req.Equal(SequenceRange{Start: 9, End: 9}, buffer.selectiveAck().Ranges[0])
+}
+
+func TestFastRetransmit(t *testing.T) {
+	initTestMetrics(t)
+
+	// capture retransmissions instead of sending them
+	prevRetransmitter := retransmitter
+	defer func() {
+		retransmitter = prevRetransmitter
+	}()
+	retransmitter = &Retransmitter{retransmitIngest: make(chan *txPayload, 16)}
+
+	req := require.New(t)
+	x := NewXgress("test", "ctrl", "test", nil, Initiator, DefaultOptions(), nil)
+	buffer := &LinkSendBuffer{
+		x:             x,
+		buffer:        map[int32]*txPayload{},
+		controller:    NewCongestionController(x.Options),
+		cumulativeAck: -1,
+	}
+
+	for i := int32(0); i < 10; i++ {
+		buffer.buffer[i] = &txPayload{payload: &Payload{Sequence: i, Data: make([]byte, 100)}, x: x}
+		buffer.linkSendBufferSize += 100
+	}
+
+	// payload 2 is lost, so each following ack reports the gap
+	sendAck := func(sequence int32, sack *SelectiveAck) {
+		ack := NewAcknowledgement("test", Terminator)
+		ack.Sequence = []int32{sequence}
+		ack.Sack = sack
+		buffer.receiveAcknowledgement(ack)
+	}
+
+	sendAck(0, &SelectiveAck{CumulativeAck: 0})
+	sendAck(1, &SelectiveAck{CumulativeAck: 1})
+	sendAck(3, &SelectiveAck{CumulativeAck: 1, Ranges: []SequenceRange{{Start: 3, End: 3}}})
+	sendAck(4, &SelectiveAck{CumulativeAck: 1, Ranges: []SequenceRange{{Start: 3, End: 4}}})
+	req.Empty(retransmitter.retransmitIngest)
+
+	sendAck(5, &SelectiveAck{CumulativeAck: 1, Ranges: []SequenceRange{{Start: 3, End: 5}}})
+	req.Len(retransmitter.retransmitIngest, 1)
+	retransmitted := <-retransmitter.retransmitIngest
+	req.Equal(int32(2), retransmitted.payload.Sequence)
+	req.Equal(uint32(1), buffer.fastRetransmits)
+	retransmitted.dequeued()
+
+	// a payload is only fast retransmitted once
+	sendAck(6, &SelectiveAck{CumulativeAck: 1, Ranges: []SequenceRange{{Start: 3, End: 6}}})
+	req.Empty(retransmitter.retransmitIngest)
+
+	// the ack for 7 is lost, but the cumulative ack from the next ack covers it
+	sendAck(2, &SelectiveAck{CumulativeAck: 6})
+	sendAck(8, &SelectiveAck{CumulativeAck: 8})
+	req.Equal(int32(8), buffer.cumulativeAck)
+	req.Zero(buffer.sackDupAcks)
+	req.Len(buffer.buffer, 1)
+	req.Contains(buffer.buffer, int32(9))
+	req.Equal(uint32(100), buffer.linkSendBufferSize)
+
+	detail := buffer.inspect()
+	req.True(detail.SelectiveAcks)
+	req.Equal(int32(8), detail.CumulativeAck)
+	req.Equal(uint32(1), detail.FastRetransmits)
+}
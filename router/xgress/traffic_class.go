@@ -0,0 +1,81 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/pkg/errors"
+)
+
+// CircuitTagTrafficClass is the circuit tag which carries the traffic class of a circuit to every router on its path.
+// Links use the class to decide how payloads from circuits sharing the link are scheduled
+const CircuitTagTrafficClass = "trafficClass"
+
+// TrafficClass determines the share of a link's capacity a circuit's payloads get when the link is busy
+type TrafficClass int
+
+const (
+	TrafficClassRealtime TrafficClass = iota
+	TrafficClassInteractive
+	TrafficClassBulk
+
+	// TrafficClassCount is the number of traffic classes
+	TrafficClassCount = 3
+
+	// TrafficClassDefault is used for circuits which don't have a traffic class
+	TrafficClassDefault = TrafficClassInteractive
+)
+
+var trafficClassNames = [TrafficClassCount]string{"realtime", "interactive", "bulk"}
+
+// trafficClassWeights are the relative shares of link capacity each class gets when all classes have payloads queued
+var trafficClassWeights = [TrafficClassCount]float64{8, 4, 1}
+
+func (self TrafficClass) String() string {
+	if self >= 0 && self < TrafficClassCount {
+		return trafficClassNames[self]
+	}
+	return "unknown"
+}
+
+// Weight returns the weight used when scheduling payloads of this class
+func (self TrafficClass) Weight() float64 {
+	return trafficClassWeights[self]
+}
+
+// ParseTrafficClass returns the traffic class with the given name. An empty name is treated as the default class
+func ParseTrafficClass(name string) (TrafficClass, error) {
+	if name == "" {
+		return TrafficClassDefault, nil
+	}
+	for i, className := range trafficClassNames {
+		if className == name {
+			return TrafficClass(i), nil
+		}
+	}
+	return TrafficClassDefault, errors.Errorf("unsupported traffic class '%v', must be one of %v, %v or %v",
+		name, TrafficClassRealtime, TrafficClassInteractive, TrafficClassBulk)
+}
+
+// GetTrafficClass returns the traffic class from the given circuit tags, and whether the tags included a valid class
+func GetTrafficClass(tags map[string]string) (TrafficClass, bool) {
+	name, found := tags[CircuitTagTrafficClass]
+	if !found {
+		return TrafficClassDefault, false
+	}
+	class, err := ParseTrafficClass(name)
+	return class, err == nil
+}
@@ -154,6 +154,8 @@ type Xgress struct {
 	flags                concurrenz.AtomicBitSet
 	timeOfLastRxFromLink int64
 	tags                 map[string]string
+	compression          Compression
+	selectiveAck         bool
 }
 
 func (self *Xgress) GetIntervalId() string {
@@ -178,8 +180,20 @@ func NewXgress(circuitId string, ctrlId string, address Address, peer Connection
 		linkRxBuffer:         NewLinkReceiveBuffer(),
 		timeOfLastRxFromLink: info.NowInMilliseconds(),
 		tags:                 tags,
+		compression:          CompressionNone,
 	}
 	result.payloadBuffer = NewLinkSendBuffer(result)
+
+	result.selectiveAck = tags[CircuitTagSelectiveAck] == "true"
+
+	if val, found := tags[CircuitTagCompression]; found {
+		if compression, err := ParseCompression(val); err != nil {
+			pfxlog.ContextLogger(result.Label()).WithError(err).Warn("not compressing payloads")
+		} else {
+			result.compression = compression
+		}
+	}
+
 	return result
 }
 
@@ -469,13 +483,21 @@ func (self *Xgress) tx() {
 		payloadLogger := log.WithFields(payload.GetLoggerFields())
 		payloadLogger.Debug("sending")
 
+		data, err := self.decompressPayload(payload)
+		if err != nil {
+			decompressionFailures.Mark(1)
+			payloadLogger.WithError(err).Error("unable to decompress payload, closing xgress")
+			self.Close()
+			return
+		}
+
 		for _, peekHandler := range self.peekHandlers {
 			peekHandler.Tx(self, payload)
 		}
 
 		if !payload.IsCircuitStartFlagSet() {
 			start := time.Now()
-			n, err := self.peer.WritePayload(payload.Data, payload.Headers)
+			n, err := self.peer.WritePayload(data, payload.Headers)
 			if err != nil {
 				payloadLogger.Warnf("write failed (%s), closing xgress", err)
 				self.Close()
@@ -553,6 +575,7 @@ func (self *Xgress) rx() {
 			Data:     buffer[0:n],
 			Headers:  headers,
 		}
+		self.compressPayload(payload)
 
 		// if the payload buffer is closed, we can't forward any more data, so might as well exit the rx loop
 		// The txer will still have a chance to flush any already received data
@@ -606,6 +629,9 @@ func (self *Xgress) PayloadReceived(payload *Payload) {
 		ack.RecvBufferSize = self.linkRxBuffer.Size()
 		ack.Sequence = append(ack.Sequence, payload.Sequence)
 		ack.RTT = payload.RTT
+		if self.selectiveAck {
+			ack.Sack = self.linkRxBuffer.selectiveAck()
+		}
 
 		atomic.StoreUint32(&self.linkRxBuffer.lastBufferSizeSent, ack.RecvBufferSize)
 		acker.ack(ack, self.address)
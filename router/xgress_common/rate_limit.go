@@ -0,0 +1,62 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// IngressRateLimitKey limits the rate, in bytes per second, at which an edge router accepts data from the
+	// sdk client which dialed a circuit. It's used as a service tag, an identity app data key and an edge router
+	// option
+	IngressRateLimitKey = "ingressRateLimit"
+	// EgressRateLimitKey limits the rate, in bytes per second, at which an edge router sends data to the sdk
+	// client which dialed a circuit. It's used as a service tag, an identity app data key and an edge router
+	// option
+	EgressRateLimitKey = "egressRateLimit"
+)
+
+// ParseRateLimit parses a rate limit in bytes per second. Limits may be given as numbers or as strings, and 0
+// means no limit
+func ParseRateLimit(val interface{}) (uint64, error) {
+	switch v := val.(type) {
+	case int:
+		if v >= 0 {
+			return uint64(v), nil
+		}
+	case int64:
+		if v >= 0 {
+			return uint64(v), nil
+		}
+	case uint64:
+		return v, nil
+	case float64:
+		if v >= 0 {
+			return uint64(v), nil
+		}
+	default:
+		if result, err := strconv.ParseUint(strings.TrimSpace(fmt.Sprint(val)), 10, 64); err == nil {
+			return result, nil
+		}
+	}
+	return 0, errors.Errorf("invalid rate limit '%v', must be a non-negative number of bytes per second", val)
+}
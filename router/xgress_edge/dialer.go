@@ -116,6 +116,7 @@ func (dialer *dialer) Dial(params xgress.DialParams) (xt.PeerData, error) {
 
 		// On the terminator, which this is, this only starts the txer, which pulls data from the link
 		// Since the opposing xgress doesn't start until this call returns, nothing should be coming this way yet
+		conn.setRateLimits(newConnRateLimits(dialer.options, nil, dialer.factory.rateLimiters, dialer.factory.rateLimitMetrics))
 		x := xgress.NewXgress(circuitId.Token, params.GetCtrlId(), params.GetAddress(), conn, xgress.Terminator, &dialer.options.Options, params.GetCircuitTags())
 		params.GetBindHandler().HandleXgressBind(x)
 		conn.ctrlRx = x
@@ -179,6 +180,7 @@ func (dialer *dialer) Dial(params xgress.DialParams) (xt.PeerData, error) {
 			return nil, errors.Wrapf(err, "failed to create edge xgress conn for terminator address %v", terminatorAddress)
 		}
 
+		conn.setRateLimits(newConnRateLimits(dialer.options, nil, dialer.factory.rateLimiters, dialer.factory.rateLimitMetrics))
 		x := xgress.NewXgress(circuitId.Token, params.GetCtrlId(), params.GetAddress(), conn, xgress.Terminator, &dialer.options.Options, params.GetCircuitTags())
 		params.GetBindHandler().HandleXgressBind(x)
 		conn.ctrlRx = x
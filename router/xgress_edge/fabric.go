@@ -289,11 +289,12 @@ func (self *edgeTerminator) GetAndClearRateLimitCallback() rate.RateLimitControl
 
 type edgeXgressConn struct {
 	edge.MsgChannel
-	mux     edge.MsgMux
-	seq     MsgQueue
-	onClose func()
-	closed  atomic.Bool
-	ctrlRx  xgress.ControlReceiver
+	mux        edge.MsgMux
+	seq        MsgQueue
+	onClose    func()
+	closed     atomic.Bool
+	ctrlRx     xgress.ControlReceiver
+	rateLimits atomic.Pointer[connRateLimits]
 }
 
 func (self *edgeXgressConn) HandleControlMsg(controlType xgress.ControlType, headers channel.Headers, responder xgress.ControlReceiver) error {
@@ -359,6 +360,7 @@ func (self *edgeXgressConn) ReadPayload() ([]byte, map[uint8][]byte, error) {
 	switch msg.ContentType {
 	case edge.ContentTypeData:
 		log.Debugf("received data message with payload size %v", len(msg.Body))
+		self.rateLimits.Load().waitIngress(len(msg.Body), self.seq.CloseNotify())
 		return msg.Body, self.getHeaderMap(msg), nil
 
 	case edge.ContentTypeStateClosed:
@@ -374,6 +376,8 @@ func (self *edgeXgressConn) ReadPayload() ([]byte, map[uint8][]byte, error) {
 }
 
 func (self *edgeXgressConn) WritePayload(p []byte, headers map[uint8][]byte) (n int, err error) {
+	self.rateLimits.Load().waitEgress(len(p), self.seq.CloseNotify())
+
 	var msgUUID []byte
 	var edgeHdrs map[int32][]byte
 
@@ -417,6 +421,15 @@ func (self *edgeXgressConn) HandleMuxClose() error {
 	return nil
 }
 
+// setRateLimits sets the conn's rate limits. If the conn has already closed, the limits are released, so references
+// to shared limiters aren't leaked
+func (self *edgeXgressConn) setRateLimits(limits *connRateLimits) {
+	self.rateLimits.Store(limits)
+	if self.closed.Load() {
+		limits.release()
+	}
+}
+
 func (self *edgeXgressConn) close(notify bool, reason string) {
 	if !self.closed.CompareAndSwap(false, true) {
 		// already closed
@@ -433,6 +446,7 @@ func (self *edgeXgressConn) close(notify bool, reason string) {
 	// to terminate
 	log.Debug("closing channel sequencer, which should cause xgress to close")
 	self.seq.Close()
+	self.rateLimits.Load().release()
 
 	// we must close the sequencer first, otherwise we can deadlock. The channel rxer can be blocked submitting
 	// the sequencer and then notify send will then be stuck writing to a partially closed channel.
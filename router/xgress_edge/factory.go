@@ -32,6 +32,7 @@ import (
 	"github.com/openziti/ziti/router/internal/edgerouter"
 	"github.com/openziti/ziti/router/state"
 	"github.com/openziti/ziti/router/xgress"
+	"github.com/openziti/ziti/router/xgress_common"
 	"github.com/pkg/errors"
 	"strings"
 	"time"
@@ -47,6 +48,8 @@ type Factory struct {
 	versionProvider  versions.VersionProvider
 	certChecker      *CertExpirationChecker
 	metricsRegistry  metrics.Registry
+	rateLimitMetrics *rateLimitMetrics
+	rateLimiters     *sharedRateLimiters
 	env              env.RouterEnv
 }
 
@@ -136,6 +139,8 @@ func NewFactory(routerConfig *router.Config, env env.RouterEnv, stateManager sta
 		metricsRegistry: env.GetMetricsRegistry(),
 		env:             env,
 	}
+	factory.rateLimitMetrics = newRateLimitMetrics(factory.metricsRegistry)
+	factory.rateLimiters = newSharedRateLimiters()
 	return factory
 }
 
@@ -186,6 +191,9 @@ type Options struct {
 	channelOptions          *channel.Options
 	lookupApiSessionTimeout time.Duration
 	lookupSessionTimeout    time.Duration
+	ingressRateLimit        uint64
+	egressRateLimit         uint64
+	rateLimitBurst          uint64
 }
 
 func (options *Options) ToLoggableString() string {
@@ -203,6 +211,8 @@ func (options *Options) ToLoggableString() string {
 	buf.WriteString(fmt.Sprintf("txPortalRetxScale=%v\n", options.TxPortalRetxScale))
 	buf.WriteString(fmt.Sprintf("txPortalDupAckThresh=%v\n", options.TxPortalDupAckThresh))
 	buf.WriteString(fmt.Sprintf("txPortalDupAckScale=%v\n", options.TxPortalDupAckScale))
+	buf.WriteString(fmt.Sprintf("fastRetxThresh=%v\n", options.FastRetxThresh))
+	buf.WriteString(fmt.Sprintf("congestionControl=%v\n", options.CongestionControl))
 	buf.WriteString(fmt.Sprintf("rxBufferSize=%v\n", options.RxBufferSize))
 	buf.WriteString(fmt.Sprintf("retxStartMs=%v\n", options.RetxStartMs))
 	buf.WriteString(fmt.Sprintf("retxScale=%v\n", options.RetxScale))
@@ -212,6 +222,9 @@ func (options *Options) ToLoggableString() string {
 
 	buf.WriteString(fmt.Sprintf("lookupApiSessionTimeout=%v\n", options.lookupApiSessionTimeout))
 	buf.WriteString(fmt.Sprintf("lookupSessionTimeout=%v\n", options.lookupSessionTimeout))
+	buf.WriteString(fmt.Sprintf("ingressRateLimit=%v\n", options.ingressRateLimit))
+	buf.WriteString(fmt.Sprintf("egressRateLimit=%v\n", options.egressRateLimit))
+	buf.WriteString(fmt.Sprintf("rateLimitBurst=%v\n", options.rateLimitBurst))
 
 	buf.WriteString(fmt.Sprintf("channel.outQueueSize=%v\n", options.channelOptions.OutQueueSize))
 	buf.WriteString(fmt.Sprintf("channel.connectTimeout=%v\n", options.channelOptions.ConnectTimeout))
@@ -257,6 +270,24 @@ func (options *Options) load(data xgress.OptionsData) error {
 			}
 			options.lookupApiSessionTimeout = timeout
 		}
+
+		if value, found := data[xgress_common.IngressRateLimitKey]; found {
+			if options.ingressRateLimit, err = xgress_common.ParseRateLimit(value); err != nil {
+				return errors.Wrapf(err, "invalid '%v' value", xgress_common.IngressRateLimitKey)
+			}
+		}
+
+		if value, found := data[xgress_common.EgressRateLimitKey]; found {
+			if options.egressRateLimit, err = xgress_common.ParseRateLimit(value); err != nil {
+				return errors.Wrapf(err, "invalid '%v' value", xgress_common.EgressRateLimitKey)
+			}
+		}
+
+		if value, found := data["rateLimitBurst"]; found {
+			if options.rateLimitBurst, err = xgress_common.ParseRateLimit(value); err != nil {
+				return errors.Wrap(err, "invalid 'rateLimitBurst' value")
+			}
+		}
 	} else {
 		options.channelOptions = channel.DefaultOptions()
 	}
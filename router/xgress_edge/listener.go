@@ -197,6 +197,7 @@ func (self *edgeClientConn) processConnect(manager state.Manager, req *channel.M
 		return
 	}
 
+	conn.setRateLimits(newConnRateLimits(self.listener.options, response.RateLimits, self.listener.factory.rateLimiters, self.listener.factory.rateLimitMetrics))
 	x := xgress.NewXgress(response.CircuitId, ctrlCh.Id(), xgress.Address(response.Address), conn, xgress.Initiator, &self.listener.options.Options, response.Tags)
 	self.listener.bindHandler.HandleXgressBind(x)
 	conn.ctrlRx = x
@@ -229,10 +230,11 @@ func (self *edgeClientConn) sendCreateCircuitRequestV1(req *ctrl_msg.CreateCircu
 	}
 
 	return &ctrl_msg.CreateCircuitResponse{
-		CircuitId: response.CircuitId,
-		Address:   response.Address,
-		PeerData:  response.PeerData,
-		Tags:      response.Tags,
+		CircuitId:  response.CircuitId,
+		Address:    response.Address,
+		PeerData:   response.PeerData,
+		Tags:       response.Tags,
+		RateLimits: response.RateLimits,
 	}, nil
 }
 
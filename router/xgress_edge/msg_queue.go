@@ -13,6 +13,7 @@ type MsgQueue interface {
 	Push(msg *channel.Message) error
 	Pop() *channel.Message
 	Close()
+	CloseNotify() <-chan struct{}
 }
 
 type BoundedMsgQueue interface {
@@ -63,6 +64,10 @@ func (mq *baseMsgQ) Close() {
 	}
 }
 
+func (mq *baseMsgQ) CloseNotify() <-chan struct{} {
+	return mq.closeNotify
+}
+
 func NewSizeLimitedMsgQueue(size int32) *sizeLimitedMsgQ {
 	return &sizeLimitedMsgQ{
 		ch:          make(chan *channel.Message, 16),
@@ -156,3 +161,7 @@ func (mq *sizeLimitedMsgQ) Close() {
 		close(mq.closeNotify)
 	}
 }
+
+func (mq *sizeLimitedMsgQ) CloseNotify() <-chan struct{} {
+	return mq.closeNotify
+}
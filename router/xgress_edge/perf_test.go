@@ -89,6 +89,10 @@ func (link *mirrorLink) SendPayload(payload *xgress.Payload) error {
 	return nil
 }
 
+func (link *mirrorLink) SendPayloadWithClass(payload *xgress.Payload, _ xgress.TrafficClass) error {
+	return link.SendPayload(payload)
+}
+
 func (link *mirrorLink) run() {
 	for ack := range link.acks {
 		err := link.fwd.ForwardAcknowledgement("router1", ack)
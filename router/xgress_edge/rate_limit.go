@@ -0,0 +1,280 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_edge
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+)
+
+// tokenBucket limits a stream of payloads to a rate in bytes per second, allowing bursts of up to burst bytes.
+// Payloads larger than the available tokens, or even the burst, aren't rejected. The bucket goes into debt instead
+// and the caller waits until the debt is repaid. Buckets aren't thread safe, the rateLimiter which holds the bucket
+// locks around it
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate uint64, burst uint64, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(rate),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// reserve takes size tokens from the bucket and returns how long the caller must wait before sending
+func (self *tokenBucket) reserve(size int, now time.Time) time.Duration {
+	if elapsed := now.Sub(self.last); elapsed > 0 {
+		self.tokens = min(self.burst, self.tokens+elapsed.Seconds()*self.rate)
+		self.last = now
+	}
+
+	self.tokens -= float64(size)
+	if self.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-self.tokens / self.rate * float64(time.Second))
+}
+
+type rateLimitMetrics struct {
+	ingressThrottled metrics.Meter
+	ingressDelay     metrics.Timer
+	egressThrottled  metrics.Meter
+	egressDelay      metrics.Timer
+}
+
+func newRateLimitMetrics(registry metrics.Registry) *rateLimitMetrics {
+	return &rateLimitMetrics{
+		ingressThrottled: registry.Meter("edge.rate_limit.ingress_throttled"),
+		ingressDelay:     registry.Timer("edge.rate_limit.ingress_delay"),
+		egressThrottled:  registry.Meter("edge.rate_limit.egress_throttled"),
+		egressDelay:      registry.Timer("edge.rate_limit.egress_delay"),
+	}
+}
+
+// rateLimiter is a token bucket which may be shared by the conns of many circuits
+type rateLimiter struct {
+	lock   sync.Mutex
+	bucket *tokenBucket
+}
+
+func newRateLimiter(rate uint64, burst uint64) *rateLimiter {
+	if rate == 0 {
+		return nil
+	}
+	if burst == 0 {
+		burst = rate
+	}
+	return &rateLimiter{
+		bucket: newTokenBucket(rate, burst, time.Now()),
+	}
+}
+
+func (self *rateLimiter) reserve(size int, now time.Time) time.Duration {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.bucket.reserve(size, now)
+}
+
+func (self *rateLimiter) setRate(rate uint64, burst uint64) {
+	if burst == 0 {
+		burst = rate
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	self.bucket.rate = float64(rate)
+	self.bucket.burst = float64(burst)
+	self.bucket.tokens = min(self.bucket.tokens, self.bucket.burst)
+}
+
+type sharedRateLimiter struct {
+	*rateLimiter
+	refCount int
+}
+
+// sharedRateLimiters holds the rate limiters which are shared by all the circuits of an identity or a service, keyed
+// by the limit and the identity or service id. A limiter is created when the first circuit using it starts, and is
+// dropped when the last one closes
+type sharedRateLimiters struct {
+	lock     sync.Mutex
+	limiters map[string]*sharedRateLimiter
+}
+
+func newSharedRateLimiters() *sharedRateLimiters {
+	return &sharedRateLimiters{
+		limiters: map[string]*sharedRateLimiter{},
+	}
+}
+
+// acquire returns the limiter for the given key, creating it if needed. The controller sends the current limits
+// with each circuit, so if the limit has changed, the existing limiter is updated
+func (self *sharedRateLimiters) acquire(key string, rate uint64, burst uint64) *rateLimiter {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if limiter, found := self.limiters[key]; found {
+		limiter.refCount++
+		limiter.setRate(rate, burst)
+		return limiter.rateLimiter
+	}
+
+	limiter := &sharedRateLimiter{
+		rateLimiter: newRateLimiter(rate, burst),
+		refCount:    1,
+	}
+	self.limiters[key] = limiter
+	return limiter.rateLimiter
+}
+
+func (self *sharedRateLimiters) release(key string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if limiter, found := self.limiters[key]; found {
+		limiter.refCount--
+		if limiter.refCount <= 0 {
+			delete(self.limiters, key)
+		}
+	}
+}
+
+// directionRateLimits holds the limiters which apply to data moving in one direction on an edge xgress conn
+type directionRateLimits struct {
+	limiters  []*rateLimiter
+	throttled metrics.Meter
+	delay     metrics.Timer
+}
+
+// wait blocks until size bytes may be sent, or until the conn is closed. The payload is taken from every limiter
+// and the wait is set by the one furthest in debt. Since the edge conn stops reading from the sdk, or stops taking
+// payloads off the circuit, while it waits, throttling is done by the existing flow control rather than by dropping
+// data
+func (self *directionRateLimits) wait(size int, closeNotify <-chan struct{}) {
+	if self == nil {
+		return
+	}
+
+	now := time.Now()
+	var delay time.Duration
+	for _, limiter := range self.limiters {
+		delay = max(delay, limiter.reserve(size, now))
+	}
+
+	if delay == 0 {
+		return
+	}
+
+	self.throttled.Mark(1)
+	self.delay.Update(delay)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-closeNotify:
+	}
+}
+
+// connRateLimits holds the ingress and egress limits for an edge xgress conn. Ingress is data read from the sdk,
+// egress is data written to the sdk
+type connRateLimits struct {
+	ingress    *directionRateLimits
+	egress     *directionRateLimits
+	shared     *sharedRateLimiters
+	sharedKeys []string
+	released   atomic.Bool
+}
+
+// newConnRateLimits returns the rate limits for an edge xgress conn, or nil if it isn't limited. The edge router
+// defaults apply to each conn separately. The identity and service limits sent by the controller with a dialed
+// circuit are shared by all the circuits of the identity, or of the service, so they only apply on the initiating
+// side
+func newConnRateLimits(options *Options, limits *edge_ctrl_pb.CircuitRateLimits, shared *sharedRateLimiters, m *rateLimitMetrics) *connRateLimits {
+	result := &connRateLimits{
+		shared: shared,
+	}
+
+	var ingress, egress []*rateLimiter
+	if limiter := newRateLimiter(options.ingressRateLimit, options.rateLimitBurst); limiter != nil {
+		ingress = append(ingress, limiter)
+	}
+	if limiter := newRateLimiter(options.egressRateLimit, options.rateLimitBurst); limiter != nil {
+		egress = append(egress, limiter)
+	}
+
+	acquire := func(limiters []*rateLimiter, kind string, id string, rate uint64) []*rateLimiter {
+		if rate == 0 || id == "" {
+			return limiters
+		}
+		key := kind + ":" + id
+		result.sharedKeys = append(result.sharedKeys, key)
+		return append(limiters, shared.acquire(key, rate, options.rateLimitBurst))
+	}
+
+	if limits != nil {
+		ingress = acquire(ingress, "identity.ingress", limits.IdentityId, limits.IdentityIngress)
+		egress = acquire(egress, "identity.egress", limits.IdentityId, limits.IdentityEgress)
+		ingress = acquire(ingress, "service.ingress", limits.ServiceId, limits.ServiceIngress)
+		egress = acquire(egress, "service.egress", limits.ServiceId, limits.ServiceEgress)
+	}
+
+	if len(ingress) == 0 && len(egress) == 0 {
+		return nil
+	}
+
+	if len(ingress) > 0 {
+		result.ingress = &directionRateLimits{limiters: ingress, throttled: m.ingressThrottled, delay: m.ingressDelay}
+	}
+	if len(egress) > 0 {
+		result.egress = &directionRateLimits{limiters: egress, throttled: m.egressThrottled, delay: m.egressDelay}
+	}
+
+	return result
+}
+
+func (self *connRateLimits) waitIngress(size int, closeNotify <-chan struct{}) {
+	if self != nil {
+		self.ingress.wait(size, closeNotify)
+	}
+}
+
+func (self *connRateLimits) waitEgress(size int, closeNotify <-chan struct{}) {
+	if self != nil {
+		self.egress.wait(size, closeNotify)
+	}
+}
+
+// release drops the conn's references to the shared limiters. It's called when the conn closes, and only releases
+// the references the first time it's called
+func (self *connRateLimits) release() {
+	if self != nil && self.released.CompareAndSwap(false, true) {
+		for _, key := range self.sharedKeys {
+			self.shared.release(key)
+		}
+	}
+}
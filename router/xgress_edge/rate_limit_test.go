@@ -0,0 +1,174 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_edge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/common/pb/edge_ctrl_pb"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	req := require.New(t)
+	now := time.Now()
+
+	// 1MB/s with a 100KB burst
+	bucket := newTokenBucket(1_000_000, 100_000, now)
+
+	req.Zero(bucket.reserve(60_000, now))
+	req.Zero(bucket.reserve(40_000, now))
+
+	// once the burst is used up, payloads wait for the tokens to refill
+	req.Equal(10*time.Millisecond, bucket.reserve(10_000, now))
+
+	now = now.Add(10 * time.Millisecond)
+	req.Zero(bucket.reserve(0, now))
+
+	// payloads larger than the burst put the bucket in debt
+	now = now.Add(time.Second)
+	req.Equal(50*time.Millisecond, bucket.reserve(150_000, now))
+	req.Equal(60*time.Millisecond, bucket.reserve(10_000, now))
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	req := require.New(t)
+	m := newRateLimitMetrics(metrics.NewRegistry("test", nil))
+
+	limits := &directionRateLimits{
+		limiters:  []*rateLimiter{newRateLimiter(1_000_000, 10_000)},
+		throttled: m.ingressThrottled,
+		delay:     m.ingressDelay,
+	}
+
+	start := time.Now()
+	limits.wait(10_000, nil)
+	limits.wait(20_000, nil)
+	req.GreaterOrEqual(time.Since(start), 15*time.Millisecond)
+
+	// closing the conn ends the wait
+	closeNotify := make(chan struct{})
+	close(closeNotify)
+	start = time.Now()
+	limits.wait(1_000_000, closeNotify)
+	req.Less(time.Since(start), time.Second)
+
+	// an unlimited conn never waits
+	var connLimits *connRateLimits
+	connLimits.waitIngress(1_000_000, nil)
+	connLimits.waitEgress(1_000_000, nil)
+	connLimits.release()
+}
+
+func TestConnRateLimits(t *testing.T) {
+	req := require.New(t)
+	m := newRateLimitMetrics(metrics.NewRegistry("test", nil))
+	shared := newSharedRateLimiters()
+
+	options := &Options{}
+	req.NoError(options.load(xgress.OptionsData{
+		"options": map[interface{}]interface{}{
+			"ingressRateLimit": 2_000_000,
+			"rateLimitBurst":   "50000",
+		},
+	}))
+	req.Equal(uint64(2_000_000), options.ingressRateLimit)
+	req.Zero(options.egressRateLimit)
+	req.Equal(uint64(50_000), options.rateLimitBurst)
+
+	circuitLimits := &edge_ctrl_pb.CircuitRateLimits{
+		IdentityId:      "id1",
+		IdentityIngress: 1_000_000,
+		ServiceId:       "svc1",
+		ServiceEgress:   3_000_000,
+	}
+
+	// the router default is per conn, the identity and service limits are shared
+	limits := newConnRateLimits(options, circuitLimits, shared, m)
+	req.NotNil(limits)
+	req.Len(limits.ingress.limiters, 2)
+	req.Equal(float64(2_000_000), limits.ingress.limiters[0].bucket.rate)
+	req.Equal(float64(1_000_000), limits.ingress.limiters[1].bucket.rate)
+	req.Equal(float64(50_000), limits.ingress.limiters[1].bucket.burst)
+	req.Len(limits.egress.limiters, 1)
+	req.Equal(float64(3_000_000), limits.egress.limiters[0].bucket.rate)
+
+	// a second circuit for the same identity, but a different service, shares the identity limiter
+	other := newConnRateLimits(options, &edge_ctrl_pb.CircuitRateLimits{
+		IdentityId:      "id1",
+		IdentityIngress: 1_000_000,
+		ServiceId:       "svc2",
+	}, shared, m)
+	req.NotSame(limits.ingress.limiters[0], other.ingress.limiters[0])
+	req.Same(limits.ingress.limiters[1], other.ingress.limiters[1])
+	req.Nil(other.egress)
+	req.Len(shared.limiters, 2)
+
+	// shared limiters are dropped when the last conn using them closes, and releasing twice has no effect
+	limits.release()
+	limits.release()
+	req.Len(shared.limiters, 1)
+
+	// limits set on a conn which has already closed are released straight away
+	conn := &edgeXgressConn{}
+	conn.closed.Store(true)
+	conn.setRateLimits(other)
+	req.Empty(shared.limiters)
+
+	// the hosting side only uses the router defaults
+	limits = newConnRateLimits(options, nil, shared, m)
+	req.NotNil(limits)
+	req.Len(limits.ingress.limiters, 1)
+	req.Nil(limits.egress)
+
+	// without limits, the conn isn't limited
+	req.Nil(newConnRateLimits(&Options{}, nil, shared, m))
+
+	err := options.load(xgress.OptionsData{
+		"options": map[interface{}]interface{}{
+			"egressRateLimit": "fast",
+		},
+	})
+	req.Error(err)
+}
+
+func TestSharedRateLimiters(t *testing.T) {
+	req := require.New(t)
+	shared := newSharedRateLimiters()
+
+	first := shared.acquire("identity.ingress:id1", 1_000_000, 0)
+	req.Equal(float64(1_000_000), first.bucket.burst)
+
+	// the controller sends the current limit with each circuit, so a changed limit updates the shared limiter
+	second := shared.acquire("identity.ingress:id1", 500_000, 0)
+	req.Same(first, second)
+	req.Equal(float64(500_000), first.bucket.rate)
+	req.Equal(float64(500_000), first.bucket.tokens)
+
+	// circuits draw from the same bucket
+	now := time.Now()
+	req.Zero(first.reserve(400_000, now))
+	req.Equal(200*time.Millisecond, second.reserve(200_000, now))
+
+	shared.release("identity.ingress:id1")
+	req.Len(shared.limiters, 1)
+	shared.release("identity.ingress:id1")
+	req.Empty(shared.limiters)
+}
@@ -254,6 +254,8 @@ func (options *Options) ToLoggableString() string {
 	buf.WriteString(fmt.Sprintf("txPortalRetxScale=%v\n", options.TxPortalRetxScale))
 	buf.WriteString(fmt.Sprintf("txPortalDupAckThresh=%v\n", options.TxPortalDupAckThresh))
 	buf.WriteString(fmt.Sprintf("txPortalDupAckScale=%v\n", options.TxPortalDupAckScale))
+	buf.WriteString(fmt.Sprintf("fastRetxThresh=%v\n", options.FastRetxThresh))
+	buf.WriteString(fmt.Sprintf("congestionControl=%v\n", options.CongestionControl))
 	buf.WriteString(fmt.Sprintf("rxBufferSize=%v\n", options.RxBufferSize))
 	buf.WriteString(fmt.Sprintf("retxStartMs=%v\n", options.RetxStartMs))
 	buf.WriteString(fmt.Sprintf("retxScale=%v\n", options.RetxScale))
@@ -115,6 +115,8 @@ type Dialer interface {
 type LinkDestination interface {
 	Id() string
 	SendPayload(payload *xgress.Payload) error
+	// SendPayloadWithClass queues the payload for sending, scheduled according to the circuit's traffic class
+	SendPayloadWithClass(payload *xgress.Payload, class xgress.TrafficClass) error
 	SendAcknowledgement(acknowledgement *xgress.Acknowledgement) error
 	SendControl(control *xgress.Control) error
 	InspectCircuit(circuitDetail *inspect.CircuitInspectDetail)
@@ -0,0 +1,153 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/router/xgress"
+)
+
+type sender interface {
+	TrySend(s channel.Sendable) (bool, error)
+}
+
+type scheduledPayload struct {
+	msg    *channel.Message
+	finish float64
+}
+
+// payloadScheduler orders the payloads sent over a link using weighted fair queueing across traffic classes. When
+// a payload is sent, it's queued by class and a placeholder is put on the channel's send queue. The channel only asks
+// the placeholder for its message when it's about to be written, and the scheduler then hands out the queued payload
+// with the earliest virtual finish time. The order is decided at write time, so payloads from busy bulk circuits
+// don't hold up interactive payloads queued behind them in the channel.
+//
+// Virtual finish times are computed using self-clocked fair queueing. Each payload finishes its size, divided by the
+// class weight, after the later of the last finish time for its class and the finish time of the last payload
+// written. Classes with nothing queued don't build up credit, and a busy class can't starve the others
+type payloadScheduler struct {
+	lock        sync.Mutex
+	queues      [xgress.TrafficClassCount][]*scheduledPayload
+	lastFinish  [xgress.TrafficClassCount]float64
+	virtualTime float64
+	depths      [xgress.TrafficClassCount]atomic.Int64
+	gauges      []metrics.Gauge
+}
+
+func newPayloadScheduler(linkId string, registry metrics.Registry) *payloadScheduler {
+	result := &payloadScheduler{}
+	if registry != nil {
+		for i := range result.depths {
+			depth := &result.depths[i]
+			name := "link.queue_depth." + xgress.TrafficClass(i).String() + ":" + linkId
+			result.gauges = append(result.gauges, registry.FuncGauge(name, depth.Load))
+		}
+	}
+	return result
+}
+
+// send queues the message and puts a placeholder for it on the channel send queue. If the send queue is full, the
+// message isn't queued and false is returned, the same as for channel.TrySend
+func (self *payloadScheduler) send(ch sender, msg *channel.Message, class xgress.TrafficClass) (bool, error) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	// the placeholder is sent while holding the lock, so the channel can't ask for a message before it's queued
+	sent, err := ch.TrySend(&scheduledSendable{scheduler: self})
+	if err != nil || !sent {
+		return sent, err
+	}
+
+	start := max(self.virtualTime, self.lastFinish[class])
+	finish := start + float64(len(msg.Body))/class.Weight()
+	self.lastFinish[class] = finish
+	self.queues[class] = append(self.queues[class], &scheduledPayload{msg: msg, finish: finish})
+	self.depths[class].Add(1)
+
+	return true, nil
+}
+
+// next returns the queued message with the earliest virtual finish time
+func (self *payloadScheduler) next() *channel.Message {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	selected := -1
+	for i, queue := range self.queues {
+		if len(queue) > 0 && (selected < 0 || queue[0].finish < self.queues[selected][0].finish) {
+			selected = i
+		}
+	}
+
+	if selected < 0 {
+		return nil
+	}
+
+	queue := self.queues[selected]
+	payload := queue[0]
+	queue[0] = nil
+	self.queues[selected] = queue[1:]
+	self.depths[selected].Add(-1)
+	self.virtualTime = payload.finish
+
+	return payload.msg
+}
+
+// queueDepths returns the number of payloads waiting to be written, by traffic class
+func (self *payloadScheduler) queueDepths() map[string]int64 {
+	if self == nil {
+		return nil
+	}
+	result := map[string]int64{}
+	for i := range self.depths {
+		result[xgress.TrafficClass(i).String()] = self.depths[i].Load()
+	}
+	return result
+}
+
+func (self *payloadScheduler) dispose() {
+	for _, gauge := range self.gauges {
+		gauge.Dispose()
+	}
+}
+
+// scheduledSendable is the placeholder put on the channel send queue for each scheduled payload
+type scheduledSendable struct {
+	channel.BaseSendable
+	scheduler *payloadScheduler
+	seq       int32
+}
+
+func (self *scheduledSendable) Msg() *channel.Message {
+	msg := self.scheduler.next()
+	if msg != nil {
+		msg.SetSequence(self.seq)
+	}
+	return msg
+}
+
+func (self *scheduledSendable) SetSequence(seq int32) {
+	self.seq = seq
+}
+
+func (self *scheduledSendable) Sequence() int32 {
+	return self.seq
+}
@@ -0,0 +1,137 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xlink_transport
+
+import (
+	"testing"
+
+	"github.com/openziti/channel/v2"
+	"github.com/openziti/metrics"
+	"github.com/openziti/ziti/router/xgress"
+	"github.com/stretchr/testify/require"
+)
+
+// testSender stands in for the channel send queue
+type testSender struct {
+	queue []channel.Sendable
+	limit int
+}
+
+func (self *testSender) TrySend(s channel.Sendable) (bool, error) {
+	if len(self.queue) >= self.limit {
+		return false, nil
+	}
+	s.SetSequence(int32(len(self.queue)))
+	self.queue = append(self.queue, s)
+	return true, nil
+}
+
+// write takes the next placeholder off the send queue and returns the message the channel would write
+func (self *testSender) write() *channel.Message {
+	s := self.queue[0]
+	self.queue = self.queue[1:]
+	return s.Msg()
+}
+
+func newTestMsg(class xgress.TrafficClass) *channel.Message {
+	return channel.NewMessage(xgress.ContentTypePayloadType, []byte{byte(class), 1, 2, 3, 4, 5, 6, 7, 8, 9})
+}
+
+func TestPayloadSchedulerOrdering(t *testing.T) {
+	req := require.New(t)
+	scheduler := newPayloadScheduler("test", nil)
+	ch := &testSender{limit: 100}
+
+	// a backlog of bulk payloads is queued before any interactive or realtime payloads
+	for i := 0; i < 20; i++ {
+		sent, err := scheduler.send(ch, newTestMsg(xgress.TrafficClassBulk), xgress.TrafficClassBulk)
+		req.NoError(err)
+		req.True(sent)
+	}
+	for i := 0; i < 4; i++ {
+		_, _ = scheduler.send(ch, newTestMsg(xgress.TrafficClassInteractive), xgress.TrafficClassInteractive)
+		_, _ = scheduler.send(ch, newTestMsg(xgress.TrafficClassRealtime), xgress.TrafficClassRealtime)
+	}
+
+	depths := scheduler.queueDepths()
+	req.Equal(int64(20), depths["bulk"])
+	req.Equal(int64(4), depths["interactive"])
+	req.Equal(int64(4), depths["realtime"])
+
+	var order []xgress.TrafficClass
+	for len(ch.queue) > 0 {
+		msg := ch.write()
+		req.NotNil(msg)
+		order = append(order, xgress.TrafficClass(msg.Body[0]))
+	}
+
+	// the realtime and interactive payloads aren't stuck behind the bulk backlog
+	req.Equal(xgress.TrafficClassRealtime, order[0])
+	counts := map[xgress.TrafficClass]int{}
+	for _, class := range order[:12] {
+		counts[class]++
+	}
+	req.Equal(4, counts[xgress.TrafficClassRealtime])
+	req.Equal(4, counts[xgress.TrafficClassInteractive])
+	req.Len(order, 28)
+	req.Equal(int64(0), scheduler.queueDepths()["bulk"])
+}
+
+func TestPayloadSchedulerShares(t *testing.T) {
+	req := require.New(t)
+	scheduler := newPayloadScheduler("test", nil)
+	ch := &testSender{limit: 10_000}
+
+	for i := 0; i < 1000; i++ {
+		for class := xgress.TrafficClass(0); class < xgress.TrafficClassCount; class++ {
+			_, _ = scheduler.send(ch, newTestMsg(class), class)
+		}
+	}
+
+	// while every class is busy, each gets a share of writes in proportion to its weight
+	counts := map[xgress.TrafficClass]int{}
+	for i := 0; i < 1300; i++ {
+		counts[xgress.TrafficClass(ch.write().Body[0])]++
+	}
+	req.InDelta(800, counts[xgress.TrafficClassRealtime], 10)
+	req.InDelta(400, counts[xgress.TrafficClassInteractive], 10)
+	req.InDelta(100, counts[xgress.TrafficClassBulk], 10)
+}
+
+func TestPayloadSchedulerFullQueue(t *testing.T) {
+	req := require.New(t)
+	registry := metrics.NewRegistry("test", nil)
+	scheduler := newPayloadScheduler("test", registry)
+	defer scheduler.dispose()
+	ch := &testSender{limit: 1}
+
+	sent, err := scheduler.send(ch, newTestMsg(xgress.TrafficClassBulk), xgress.TrafficClassBulk)
+	req.NoError(err)
+	req.True(sent)
+
+	// payloads which don't fit in the send queue aren't queued by the scheduler either
+	sent, err = scheduler.send(ch, newTestMsg(xgress.TrafficClassRealtime), xgress.TrafficClassRealtime)
+	req.NoError(err)
+	req.False(sent)
+	req.Equal(int64(0), scheduler.queueDepths()["realtime"])
+	req.Equal(int64(1), registry.GetGauge("link.queue_depth.bulk:test").Value())
+
+	msg := ch.write()
+	req.Equal(byte(xgress.TrafficClassBulk), msg.Body[0])
+	req.Equal(int32(0), msg.Sequence())
+	req.Nil(scheduler.next())
+}
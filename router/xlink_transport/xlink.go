@@ -36,6 +36,7 @@ type impl struct {
 	closed          atomic.Bool
 	faultsSent      atomic.Bool
 	droppedMsgMeter metrics.Meter
+	scheduler       *payloadScheduler
 	dialed          bool
 	iteration       uint32
 	dupsRejected    uint32
@@ -56,12 +57,17 @@ func (self *impl) Iteration() uint32 {
 func (self *impl) Init(metricsRegistry metrics.Registry) error {
 	if self.droppedMsgMeter == nil {
 		self.droppedMsgMeter = metricsRegistry.Meter("link.dropped_msgs:" + self.id)
+		self.scheduler = newPayloadScheduler(self.id, metricsRegistry)
 	}
 	return nil
 }
 
 func (self *impl) SendPayload(msg *xgress.Payload) error {
-	sent, err := self.ch.TrySend(msg.Marshall())
+	return self.SendPayloadWithClass(msg, xgress.TrafficClassDefault)
+}
+
+func (self *impl) SendPayloadWithClass(msg *xgress.Payload, class xgress.TrafficClass) error {
+	sent, err := self.scheduler.send(self.ch, msg.Marshall(), class)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -86,6 +92,7 @@ func (self *impl) SendControl(msg *xgress.Control) error {
 
 func (self *impl) Close() error {
 	self.droppedMsgMeter.Dispose()
+	self.scheduler.dispose()
 	return self.ch.Close()
 }
 
@@ -143,6 +150,7 @@ func (self *impl) InspectLink() *inspect.LinkInspectDetail {
 		Dest:        self.DestinationId(),
 		DestVersion: self.DestVersion(),
 		Dialed:      self.dialed,
+		QueueDepths: self.scheduler.queueDepths(),
 	}
 }
 
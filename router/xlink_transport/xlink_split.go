@@ -38,6 +38,7 @@ type splitImpl struct {
 	closed          atomic.Bool
 	faultsSent      atomic.Bool
 	droppedMsgMeter metrics.Meter
+	scheduler       *payloadScheduler
 	dialed          bool
 	iteration       uint32
 	dupsRejected    uint32
@@ -58,12 +59,17 @@ func (self *splitImpl) Iteration() uint32 {
 func (self *splitImpl) Init(metricsRegistry metrics.Registry) error {
 	if self.droppedMsgMeter == nil {
 		self.droppedMsgMeter = metricsRegistry.Meter("link.dropped_msgs:" + self.id)
+		self.scheduler = newPayloadScheduler(self.id, metricsRegistry)
 	}
 	return nil
 }
 
 func (self *splitImpl) SendPayload(msg *xgress.Payload) error {
-	sent, err := self.payloadCh.TrySend(msg.Marshall())
+	return self.SendPayloadWithClass(msg, xgress.TrafficClassDefault)
+}
+
+func (self *splitImpl) SendPayloadWithClass(msg *xgress.Payload, class xgress.TrafficClass) error {
+	sent, err := self.scheduler.send(self.payloadCh, msg.Marshall(), class)
 	if err == nil && !sent {
 		self.droppedMsgMeter.Mark(1)
 	}
@@ -98,6 +104,7 @@ func (self *splitImpl) AreFaultsSent() bool {
 func (self *splitImpl) Close() error {
 	if self.droppedMsgMeter != nil {
 		self.droppedMsgMeter.Dispose()
+		self.scheduler.dispose()
 	}
 	var err, err2 error
 	if self.payloadCh != nil {
@@ -161,6 +168,7 @@ func (self *splitImpl) InspectLink() *inspect.LinkInspectDetail {
 		Dest:        self.DestinationId(),
 		DestVersion: self.DestVersion(),
 		Dialed:      self.dialed,
+		QueueDepths: self.scheduler.queueDepths(),
 	}
 }
 
@@ -32,6 +32,9 @@ type createServiceOptions struct {
 	roleAttributes     []string
 	configs            []string
 	encryption         encryptionVar
+	avoidRouters       []string
+	egressRouters      []string
+	maxHops            int32
 }
 
 // newCreateServiceCmd creates the 'edge controller create service local' command for the given entity type
@@ -60,6 +63,9 @@ func newCreateServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	cmd.Flags().StringSliceVarP(&options.configs, "configs", "c", nil, "Configuration id or names to be associated with the new service")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
 	cmd.Flags().DurationVar(&options.maxIdleTime, "max-idle-time", 0, "Time after which idle circuit will be terminated. Defaults to 0, which indicates no limit on idle circuits")
+	cmd.Flags().StringSliceVar(&options.avoidRouters, "avoid-routers", nil, "Routers circuits for the service must not pass through, given as #attribute, @id or key=value")
+	cmd.Flags().StringSliceVar(&options.egressRouters, "egress-routers", nil, "Routers circuits for the service must egress from, given as #attribute, @id or key=value")
+	cmd.Flags().Int32Var(&options.maxHops, "max-hops", 0, "Maximum number of links in circuit paths for the service. Defaults to 0, which indicates no limit")
 
 	if err := options.encryption.Set("ON"); err != nil {
 		panic(err)
@@ -87,6 +93,9 @@ func runCreateService(o *createServiceOptions) (err error) {
 	api.SetJSONValue(entityData, o.roleAttributes, "roleAttributes")
 	api.SetJSONValue(entityData, configs, "configs")
 	api.SetJSONValue(entityData, o.maxIdleTime.Milliseconds(), "maxIdleTimeMillis")
+	api.SetJSONValue(entityData, o.avoidRouters, "avoidRouters")
+	api.SetJSONValue(entityData, o.egressRouters, "egressRouters")
+	api.SetJSONValue(entityData, o.maxHops, "maxHops")
 
 	o.SetTags(entityData)
 
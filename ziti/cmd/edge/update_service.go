@@ -37,6 +37,9 @@ type updateServiceOptions struct {
 	roleAttributes     []string
 	encryption         encryptionVar
 	configs            []string
+	avoidRouters       []string
+	egressRouters      []string
+	maxHops            int32
 }
 
 func newUpdateServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
@@ -70,6 +73,9 @@ func newUpdateServiceCmd(out io.Writer, errOut io.Writer) *cobra.Command {
 	}
 	cmd.Flags().VarP(&options.encryption, "encryption", "e", "Controls end-to-end encryption for the service")
 	cmd.Flags().StringSliceVarP(&options.configs, "configs", "c", nil, "Configuration id or names to be associated with the new service")
+	cmd.Flags().StringSliceVar(&options.avoidRouters, "avoid-routers", nil, "Routers circuits for the service must not pass through, given as #attribute, @id or key=value")
+	cmd.Flags().StringSliceVar(&options.egressRouters, "egress-routers", nil, "Routers circuits for the service must egress from, given as #attribute, @id or key=value")
+	cmd.Flags().Int32Var(&options.maxHops, "max-hops", 0, "Maximum number of links in circuit paths for the service. 0 indicates no limit")
 
 	options.AddCommonFlags(cmd)
 
@@ -119,6 +125,21 @@ func runUpdateService(o *updateServiceOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("avoid-routers") {
+		api.SetJSONValue(entityData, o.avoidRouters, "avoidRouters")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("egress-routers") {
+		api.SetJSONValue(entityData, o.egressRouters, "egressRouters")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("max-hops") {
+		api.SetJSONValue(entityData, o.maxHops, "maxHops")
+		change = true
+	}
+
 	if o.TagsProvided() {
 		o.SetTags(entityData)
 		change = true
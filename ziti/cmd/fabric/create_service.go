@@ -26,6 +26,9 @@ import (
 type createServiceOptions struct {
 	api.Options
 	terminatorStrategy string
+	avoidRouters       []string
+	egressRouters      []string
+	maxHops            int32
 	tags               map[string]string
 }
 
@@ -50,6 +53,9 @@ func newCreateServiceCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.Flags().SetInterspersed(true)
 	cmd.Flags().StringToStringVarP(&options.tags, "tags", "t", nil, "Add tags to service definition")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
+	cmd.Flags().StringSliceVar(&options.avoidRouters, "avoid-routers", nil, "Routers circuits for the service must not pass through, given as #attribute, @id or key=value")
+	cmd.Flags().StringSliceVar(&options.egressRouters, "egress-routers", nil, "Routers circuits for the service must egress from, given as #attribute, @id or key=value")
+	cmd.Flags().Int32Var(&options.maxHops, "max-hops", 0, "Maximum number of links in circuit paths for the service. Defaults to 0, which indicates no limit")
 	options.AddCommonFlags(cmd)
 
 	return cmd
@@ -63,6 +69,15 @@ func (o *createServiceOptions) createService(_ *cobra.Command, args []string) (e
 	if o.terminatorStrategy != "" {
 		api.SetJSONValue(entityData, o.terminatorStrategy, "terminatorStrategy")
 	}
+	if len(o.avoidRouters) > 0 {
+		api.SetJSONValue(entityData, o.avoidRouters, "avoidRouters")
+	}
+	if len(o.egressRouters) > 0 {
+		api.SetJSONValue(entityData, o.egressRouters, "egressRouters")
+	}
+	if o.maxHops > 0 {
+		api.SetJSONValue(entityData, o.maxHops, "maxHops")
+	}
 
 	api.SetJSONValue(entityData, o.tags, "tags")
 
@@ -0,0 +1,145 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package fabric
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	fabric_rest_client "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/circuit"
+	"github.com/openziti/ziti/controller/rest_model"
+	"github.com/openziti/ziti/ziti/cmd/api"
+	"github.com/openziti/ziti/ziti/cmd/common"
+	cmdhelper "github.com/openziti/ziti/ziti/cmd/helpers"
+	"github.com/spf13/cobra"
+)
+
+type dryRunOptions struct {
+	api.Options
+	identityId string
+	routerId   string
+	instanceId string
+}
+
+func newDryRunCmd(p common.OptionsProvider) *cobra.Command {
+	options := &dryRunOptions{
+		Options: api.Options{CommonOptions: p()},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "dry-run <service id or name>",
+		Short: "shows which terminator and path would be used if the service were dialed, without creating a circuit",
+		Example: "ziti fabric dry-run echo --identity 8Kq7Ah4xN\n" +
+			"ziti fabric dry-run echo --router er1 --instance-id web01",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			cmdhelper.CheckErr(runDryRun(options))
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.identityId, "identity", "i", "", "Id of the dialing identity. If no router is given, all edge routers the identity can use for the service are evaluated")
+	cmd.Flags().StringVarP(&options.routerId, "router", "r", "", "Id of the ingress router")
+	cmd.Flags().StringVar(&options.instanceId, "instance-id", "", "Instance id to dial, for addressable terminators")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func runDryRun(o *dryRunOptions) error {
+	return WithFabricClient(&o.Options, func(client *fabric_rest_client.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		result, err := client.Circuit.DryRunCircuit(&circuit.DryRunCircuitParams{
+			Request: &rest_model.CircuitDryRunRequest{
+				Service:    &o.Args[0],
+				IdentityID: o.identityId,
+				RouterID:   o.routerId,
+				InstanceID: o.instanceId,
+			},
+			Context: ctx,
+		})
+		return outputResult(result, err, &o.Options, outputDryRun)
+	})
+}
+
+func outputDryRun(o *api.Options, result *circuit.DryRunCircuitOK) error {
+	detail := result.Payload.Data
+	fmt.Printf("service: %v (%v), strategy: %v\n", valOrDefault(detail.ServiceName), valOrDefault(detail.ServiceID), valOrDefault(detail.Strategy))
+	if detail.Error != "" {
+		fmt.Printf("error: %v\n", detail.Error)
+	}
+
+	for _, ingress := range detail.Ingresses {
+		fmt.Printf("\ningress router: %v\n", valOrDefault(ingress.RouterID))
+		if ingress.Error != "" {
+			fmt.Printf("error: %v\n", ingress.Error)
+		} else {
+			fmt.Printf("selected terminator: %v\n", ingress.TerminatorID)
+			fmt.Printf("path: %v (cost: %v)\n", formatDryRunPath(ingress.Path), ingress.PathCost)
+		}
+
+		if len(ingress.Terminators) == 0 {
+			continue
+		}
+
+		t := table.NewWriter()
+		t.SetStyle(table.StyleRounded)
+		var columnConfigs []table.ColumnConfig
+		for i := 5; i <= 8; i++ {
+			columnConfigs = append(columnConfigs, table.ColumnConfig{Number: i, Align: text.AlignRight})
+		}
+		t.SetColumnConfigs(columnConfigs)
+		t.AppendHeader(table.Row{"ID", "Router", "Instance", "Precedence", "Static Cost", "Dynamic Cost", "Path Cost", "Route Cost", "Selected", "Rejected"})
+
+		for _, terminator := range ingress.Terminators {
+			t.AppendRow(table.Row{
+				valOrDefault(terminator.ID),
+				valOrDefault(terminator.RouterID),
+				terminator.InstanceID,
+				valOrDefault(terminator.Precedence),
+				valOrDefault(terminator.StaticCost),
+				valOrDefault(terminator.DynamicCost),
+				terminator.PathCost,
+				terminator.RouteCost,
+				valOrDefault(terminator.Selected),
+				terminator.Rejected,
+			})
+		}
+
+		api.RenderTable(o, t, nil)
+	}
+
+	return nil
+}
+
+// formatDryRunPath renders a path as routers, with their costs, joined by links, with their costs
+func formatDryRunPath(path []*rest_model.CircuitDryRunHop) string {
+	buf := &strings.Builder{}
+	for _, hop := range path {
+		buf.WriteString(fmt.Sprintf("r/%v (%v)", valOrDefault(hop.RouterID), valOrDefault(hop.RouterCost)))
+		if hop.LinkID != "" {
+			buf.WriteString(fmt.Sprintf(" -[l/%v (%v)]-> ", hop.LinkID, hop.LinkCost))
+		}
+	}
+	return buf.String()
+}
@@ -20,11 +20,13 @@ import (
 	"fmt"
 	"github.com/openziti/foundation/v2/stringz"
 	fabric_rest_client "github.com/openziti/ziti/controller/rest_client"
+	"github.com/openziti/ziti/controller/rest_client/alert"
 	"github.com/openziti/ziti/controller/rest_client/circuit"
 	"github.com/openziti/ziti/controller/rest_client/link"
 	"github.com/openziti/ziti/controller/rest_client/router"
 	"github.com/openziti/ziti/controller/rest_client/service"
 	"github.com/openziti/ziti/controller/rest_client/terminator"
+	"github.com/openziti/ziti/controller/rest_client/usage"
 	"github.com/openziti/ziti/controller/rest_model"
 	"github.com/openziti/ziti/ziti/cmd/api"
 	"github.com/openziti/ziti/ziti/cmd/common"
@@ -32,6 +34,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
 	"github.com/spf13/cobra"
@@ -53,11 +56,14 @@ func newListCmd(p common.OptionsProvider) *cobra.Command {
 		return &api.Options{CommonOptions: p()}
 	}
 
+	listCmd.AddCommand(newListCmdForEntityType("alerts", runListAlerts, newOptions()))
+	listCmd.AddCommand(newListCmdForEntityType("alert-rules", runListAlertRules, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("circuits", runListCircuits, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("links", runListLinks, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("routers", runListRouters, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("services", runListServices, newOptions()))
 	listCmd.AddCommand(newListCmdForEntityType("terminators", runListTerminators, newOptions()))
+	listCmd.AddCommand(newListUsageCmd(newOptions()))
 
 	return listCmd
 }
@@ -299,6 +305,186 @@ func outputRouters(o *api.Options, result *router.ListRoutersOK) error {
 	return nil
 }
 
+type listUsageOptions struct {
+	*api.Options
+	interval string
+	groupBy  string
+	entityId string
+	since    string
+	until    string
+}
+
+func newListUsageCmd(options *api.Options) *cobra.Command {
+	usageOptions := &listUsageOptions{Options: options}
+
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "lists hourly or daily usage rollups per identity, service or router",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Cmd = cmd
+			options.Args = args
+			err := runListUsage(usageOptions)
+			cmdhelper.CheckErr(err)
+		},
+	}
+
+	cmd.Flags().StringVar(&usageOptions.interval, "interval", "hourly", "Rollup interval. Valid values: [hourly, daily]")
+	cmd.Flags().StringVar(&usageOptions.groupBy, "group-by", "service", "Entity type to group usage by. Valid values: [identity, service, router]")
+	cmd.Flags().StringVar(&usageOptions.entityId, "entity-id", "", "Only show usage for the given identity, service or router id")
+	cmd.Flags().StringVar(&usageOptions.since, "since", "", "Only show intervals ending after the given time, either RFC3339 or a duration before now, such as 24h")
+	cmd.Flags().StringVar(&usageOptions.until, "until", "", "Only show intervals starting before the given time, either RFC3339 or a duration before now, such as 1h")
+	cmd.Flags().BoolVar(&options.OutputCSV, "csv", false, "Output CSV instead of a formatted table")
+	options.AddCommonFlags(cmd)
+
+	return cmd
+}
+
+func parseUsageTime(name, val string) (*strfmt.DateTime, error) {
+	if val == "" {
+		return nil, nil
+	}
+	if d, err := time.ParseDuration(val); err == nil {
+		result := strfmt.DateTime(time.Now().Add(-d))
+		return &result, nil
+	}
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v '%v', must be an RFC3339 time or a duration", name, val)
+	}
+	result := strfmt.DateTime(t)
+	return &result, nil
+}
+
+func runListUsage(o *listUsageOptions) error {
+	since, err := parseUsageTime("since", o.since)
+	if err != nil {
+		return err
+	}
+
+	until, err := parseUsageTime("until", o.until)
+	if err != nil {
+		return err
+	}
+
+	return WithFabricClient(o.Options, func(client *fabric_rest_client.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+
+		params := &usage.ListUsageParams{
+			Interval: &o.interval,
+			GroupBy:  &o.groupBy,
+			Since:    since,
+			Until:    until,
+			Context:  ctx,
+		}
+
+		if o.entityId != "" {
+			params.EntityID = &o.entityId
+		}
+
+		result, err := client.Usage.ListUsage(params)
+		return outputResult(result, err, o.Options, outputUsage)
+	})
+}
+
+func outputUsage(o *api.Options, result *usage.ListUsageOK) error {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	var columnConfigs []table.ColumnConfig
+	for i := 3; i <= 9; i++ {
+		columnConfigs = append(columnConfigs, table.ColumnConfig{Number: i, Align: text.AlignRight})
+	}
+	t.SetColumnConfigs(columnConfigs)
+	t.AppendHeader(table.Row{"Interval Start", "Entity", "Ingress Rx", "Ingress Tx", "Egress Rx", "Egress Tx", "Fabric Rx", "Fabric Tx", "Circuits"})
+
+	for _, entity := range result.Payload.Data {
+		t.AppendRow(table.Row{
+			time.Time(*entity.IntervalStart).UTC().Format(time.DateTime),
+			valOrDefault(entity.EntityID),
+			valOrDefault(entity.IngressRx),
+			valOrDefault(entity.IngressTx),
+			valOrDefault(entity.EgressRx),
+			valOrDefault(entity.EgressTx),
+			valOrDefault(entity.FabricRx),
+			valOrDefault(entity.FabricTx),
+			valOrDefault(entity.Circuits),
+		})
+	}
+
+	api.RenderTable(o, t, nil)
+
+	return nil
+}
+
+func runListAlerts(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabric_rest_client.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+		result, err := client.Alert.ListAlerts(&alert.ListAlertsParams{
+			Context: ctx,
+		})
+		return outputResult(result, err, o, outputAlerts)
+	})
+}
+
+func outputAlerts(o *api.Options, result *alert.ListAlertsOK) error {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Rule", "Entity Type", "Entity", "Fired At", "Message"})
+
+	for _, entity := range result.Payload.Data {
+		t.AppendRow(table.Row{
+			valOrDefault(entity.RuleID),
+			valOrDefault(entity.EntityType),
+			valOrDefault(entity.EntityID),
+			time.Time(*entity.FiredAt).UTC().Format(time.DateTime),
+			valOrDefault(entity.Message),
+		})
+	}
+
+	api.RenderTable(o, t, nil)
+
+	return nil
+}
+
+func runListAlertRules(o *api.Options) error {
+	return WithFabricClient(o, func(client *fabric_rest_client.ZitiFabric) error {
+		ctx, cancelF := o.GetContext()
+		defer cancelF()
+		result, err := client.Alert.ListAlertRules(&alert.ListAlertRulesParams{
+			Context: ctx,
+		})
+		return outputResult(result, err, o, outputAlertRules)
+	})
+}
+
+func outputAlertRules(o *api.Options, result *alert.ListAlertRulesOK) error {
+	t := table.NewWriter()
+	t.SetStyle(table.StyleRounded)
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 4, Align: text.AlignRight},
+		{Number: 6, Align: text.AlignRight},
+	})
+	t.AppendHeader(table.Row{"ID", "Type", "For", "Threshold", "Window", "Min Circuits", "Source"})
+
+	for _, entity := range result.Payload.Data {
+		t.AppendRow(table.Row{
+			valOrDefault(entity.ID),
+			valOrDefault(entity.Type),
+			valOrDefault(entity.For),
+			valOrDefault(entity.Threshold),
+			valOrDefault(entity.Window),
+			valOrDefault(entity.MinCircuits),
+			valOrDefault(entity.Source),
+		})
+	}
+
+	api.RenderTable(o, t, nil)
+
+	return nil
+}
+
 func getPaging(meta *rest_model.Meta) *api.Paging {
 	return &api.Paging{
 		Limit:  *meta.Pagination.Limit,
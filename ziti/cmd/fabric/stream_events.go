@@ -27,6 +27,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -49,6 +51,10 @@ type streamEventsAction struct {
 	metricsFilter        string
 	entityCountsInterval time.Duration
 	usageVersion         uint8
+
+	fromSequence uint64
+	fromTime     string
+	cursorFile   string
 }
 
 func NewStreamEventsCmd(p common.OptionsProvider) *cobra.Command {
@@ -83,6 +89,9 @@ func NewStreamEventsCmd(p common.OptionsProvider) *cobra.Command {
 	streamEventsCmd.Flags().StringVar(&action.metricsSourceFilter, "metrics-source-filter", "", "Specify which sources to stream metrics from")
 	streamEventsCmd.Flags().StringVar(&action.metricsFilter, "metrics-filter", "", "Specify which metrics to stream")
 	streamEventsCmd.Flags().Uint8Var(&action.usageVersion, "usage-version", 3, "Specify which version of usage data to stream. Valid versions: [2,3]")
+	streamEventsCmd.Flags().Uint64Var(&action.fromSequence, "from-sequence", 0, "Replay journaled events starting with the given sequence number")
+	streamEventsCmd.Flags().StringVar(&action.fromTime, "from-time", "", "Replay journaled events starting at the given time (RFC3339)")
+	streamEventsCmd.Flags().StringVar(&action.cursorFile, "cursor-file", "", "Resume from, and record, the sequence number of the last journaled event received in the given file")
 	return streamEventsCmd
 }
 
@@ -196,6 +205,10 @@ func (self *streamEventsAction) streamEvents(cmd *cobra.Command, _ []string) err
 
 	streamEventsRequest["subscriptions"] = subscriptions
 
+	if err := self.setJournalPosition(cmd, streamEventsRequest); err != nil {
+		return err
+	}
+
 	closeNotify := make(chan struct{})
 
 	bindHandler := func(binding channel.Binding) error {
@@ -244,6 +257,52 @@ func (self *streamEventsAction) streamEvents(cmd *cobra.Command, _ []string) err
 	return nil
 }
 
+func (self *streamEventsAction) setJournalPosition(cmd *cobra.Command, streamEventsRequest map[string]interface{}) error {
+	if cmd.Flags().Changed("from-sequence") && cmd.Flags().Changed("from-time") {
+		return errors.New("only one of --from-sequence and --from-time may be specified")
+	}
+
+	if cmd.Flags().Changed("from-time") {
+		fromTime, err := time.Parse(time.RFC3339, self.fromTime)
+		if err != nil {
+			return errors.Wrapf(err, "invalid --from-time '%v'", self.fromTime)
+		}
+		streamEventsRequest["fromTimestamp"] = fromTime
+		return nil
+	}
+
+	if cmd.Flags().Changed("from-sequence") {
+		streamEventsRequest["fromSequence"] = self.fromSequence
+		return nil
+	}
+
+	if self.cursorFile != "" {
+		data, err := os.ReadFile(self.cursorFile)
+		if os.IsNotExist(err) {
+			streamEventsRequest["fromSequence"] = uint64(0)
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "unable to read cursor file '%v'", self.cursorFile)
+		}
+		lastSequence, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid sequence number in cursor file '%v'", self.cursorFile)
+		}
+		streamEventsRequest["fromSequence"] = lastSequence + 1
+	}
+
+	return nil
+}
+
 func (self *streamEventsAction) HandleReceive(msg *channel.Message, _ channel.Channel) {
 	fmt.Println(string(msg.Body))
+
+	if self.cursorFile != "" {
+		if sequence, found := msg.GetUint64Header(int32(mgmt_pb.Header_EventSequenceHeader)); found {
+			if err := os.WriteFile(self.cursorFile, []byte(strconv.FormatUint(sequence, 10)), 0600); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "unable to update cursor file '%v': %v\n", self.cursorFile, err)
+			}
+		}
+	}
 }
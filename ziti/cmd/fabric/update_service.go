@@ -32,6 +32,9 @@ type updateServiceOptions struct {
 	api.Options
 	name               string
 	terminatorStrategy string
+	avoidRouters       []string
+	egressRouters      []string
+	maxHops            int32
 	tags               map[string]string
 }
 
@@ -57,6 +60,9 @@ func newUpdateServiceCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.Flags().SetInterspersed(true)
 	cmd.Flags().StringVarP(&options.name, "name", "n", "", "Set the name of the service")
 	cmd.Flags().StringVar(&options.terminatorStrategy, "terminator-strategy", "", "Specifies the terminator strategy for the service")
+	cmd.Flags().StringSliceVar(&options.avoidRouters, "avoid-routers", nil, "Routers circuits for the service must not pass through, given as #attribute, @id or key=value")
+	cmd.Flags().StringSliceVar(&options.egressRouters, "egress-routers", nil, "Routers circuits for the service must egress from, given as #attribute, @id or key=value")
+	cmd.Flags().Int32Var(&options.maxHops, "max-hops", 0, "Maximum number of links in circuit paths for the service. 0 indicates no limit")
 	cmd.Flags().StringToStringVar(&options.tags, "tags", nil, "Custom management tags")
 	options.AddCommonFlags(cmd)
 
@@ -82,6 +88,21 @@ func runUpdateService(o *updateServiceOptions) error {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("avoid-routers") {
+		api.SetJSONValue(entityData, o.avoidRouters, "avoidRouters")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("egress-routers") {
+		api.SetJSONValue(entityData, o.egressRouters, "egressRouters")
+		change = true
+	}
+
+	if o.Cmd.Flags().Changed("max-hops") {
+		api.SetJSONValue(entityData, o.maxHops, "maxHops")
+		change = true
+	}
+
 	if o.Cmd.Flags().Changed("tags") {
 		api.SetJSONValue(entityData, o.tags, "tags")
 		change = true
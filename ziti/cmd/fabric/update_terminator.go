@@ -28,16 +28,18 @@ import (
 	errors2 "github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"math"
+	"time"
 )
 
 type updateTerminatorOptions struct {
 	api.Options
-	router     string
-	address    string
-	binding    string
-	cost       int32
-	precedence string
-	tags       map[string]string
+	router       string
+	address      string
+	binding      string
+	cost         int32
+	precedence   string
+	drainTimeout time.Duration
+	tags         map[string]string
 }
 
 func newUpdateTerminatorCmd(p common.OptionsProvider) *cobra.Command {
@@ -64,7 +66,8 @@ func newUpdateTerminatorCmd(p common.OptionsProvider) *cobra.Command {
 	cmd.Flags().StringVar(&options.address, "address", "", "Set the terminator address")
 	cmd.Flags().StringVar(&options.binding, "binding", "", "Set the terminator binding")
 	cmd.Flags().Int32VarP(&options.cost, "cost", "c", 0, "Set the terminator cost")
-	cmd.Flags().StringVarP(&options.precedence, "precedence", "p", "", "Set the terminator precedence ('default', 'required' or 'failed')")
+	cmd.Flags().StringVarP(&options.precedence, "precedence", "p", "", "Set the terminator precedence ('default', 'required', 'failed' or 'draining')")
+	cmd.Flags().DurationVar(&options.drainTimeout, "drain-timeout", 0, "When setting the precedence to draining, how long to wait for existing circuits to finish before removing the terminator. Defaults to the controller's terminator drain timeout")
 	cmd.Flags().StringToStringVar(&options.tags, "tags", nil, "Custom management tags")
 	options.AddCommonFlags(cmd)
 
@@ -105,7 +108,7 @@ func runUpdateTerminator(o *updateTerminatorOptions) (err error) {
 	}
 
 	if o.Cmd.Flags().Changed("precedence") {
-		validValues := []string{"default", "required", "failed"}
+		validValues := []string{"default", "required", "failed", "draining"}
 		if !stringz.Contains(validValues, o.precedence) {
 			return errors2.Errorf("Invalid precedence %v. Must be one of %+v", o.precedence, validValues)
 		}
@@ -113,6 +116,16 @@ func runUpdateTerminator(o *updateTerminatorOptions) (err error) {
 		change = true
 	}
 
+	if o.Cmd.Flags().Changed("drain-timeout") {
+		if o.precedence != "draining" {
+			return errors.New("drain-timeout may only be given when setting the precedence to draining")
+		}
+		if o.drainTimeout < 0 {
+			return errors2.Errorf("Invalid drain timeout %v. Must not be negative", o.drainTimeout)
+		}
+		api.SetJSONValue(entityData, int64(o.drainTimeout.Seconds()), "drainTimeoutSeconds")
+	}
+
 	if o.Cmd.Flags().Changed("tags") {
 		api.SetJSONValue(entityData, o.tags, "tags")
 		change = true